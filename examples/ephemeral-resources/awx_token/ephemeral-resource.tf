@@ -0,0 +1,18 @@
+# A read-only personal access token, deleted at the end of the run.
+ephemeral "awx_token" "automation" {
+  description = "Terraform run"
+  scope       = "read"
+}
+
+# A token of an application.
+ephemeral "awx_token" "ci" {
+  application_id = 3
+  description    = "CI pipeline"
+}
+
+# Configure a provider with the token, without storing it in the state.
+provider "awx" {
+  alias    = "automation"
+  hostname = "https://awx.example.com"
+  token    = ephemeral.awx_token.automation.token
+}
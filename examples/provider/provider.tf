@@ -19,3 +19,48 @@ provider "awx_with_token" {
   hostname = "https://awx.example.com"
   token    = "token"
 }
+
+// Example configuration for the AWX provider exchanging a username and password
+// for a personal access token revoked when the provider shuts down
+provider "awx_with_personal_token" {
+  hostname             = "https://awx.example.com"
+  username             = "admin"
+  password             = "password"
+  auth_method          = "personal_token"
+  personal_token_scope = "write"
+}
+
+// Example configuration for the AWX provider on deployments with basic auth disabled
+provider "awx_with_session" {
+  hostname    = "https://awx.example.com"
+  username    = "admin"
+  password    = "password"
+  auth_method = "session"
+}
+
+// Example configuration for the AWX provider behind a mutual TLS ingress and an egress proxy
+provider "awx_with_mtls" {
+  hostname        = "https://awx.example.com"
+  token           = "token"
+  ca_pem          = "/etc/ssl/awx/ca.pem"
+  client_cert_pem = file("/etc/ssl/awx/client.pem")
+  client_key_pem  = file("/etc/ssl/awx/client-key.pem")
+  proxy_url       = "http://proxy.example.com:3128"
+  no_proxy        = "localhost,.internal.example.com"
+}
+
+// Example configuration for Ansible Automation Platform 2.5, with a token issued by the platform gateway
+provider "awx_with_gateway" {
+  hostname      = "https://aap.example.com"
+  token         = "gateway-token"
+  api_base_path = "/api/controller/v2/"
+}
+
+// Example configuration for the AWX provider throttling its requests, to stay within the uwsgi
+// worker limits of AWX when running with a high -parallelism
+provider "awx_with_rate_limit" {
+  hostname                = "https://awx.example.com"
+  token                   = "token"
+  max_concurrent_requests = 4
+  requests_per_second     = 10
+}
@@ -1,2 +1,5 @@
 # Order can be imported by specifying the numeric identifier.
 terraform import awx_credential.example 500
+
+# Or by its AWX named URL: the name followed by the names of the objects it belongs to, separated by '++'.
+terraform import awx_credential.example 'Deploy key++Machine+ssh++Default'
@@ -12,3 +12,18 @@ resource "awx_credential" "example" {
     vault_id       = "password"
   }
 }
+
+# With Terraform 1.11 or later, the secret inputs can be kept out of the state. Bump
+# inputs_wo_version to apply new values.
+resource "awx_credential" "write_only" {
+  name               = "write-only"
+  organization_id    = awx_organization.example.id
+  credential_type_id = 3 # ansible vault
+  inputs = {
+    vault_id = "password"
+  }
+  inputs_wo = jsonencode({
+    vault_password = var.vault_password
+  })
+  inputs_wo_version = 1
+}
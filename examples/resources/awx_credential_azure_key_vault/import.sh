@@ -1,2 +1,5 @@
 # Order can be imported by specifying the numeric identifier.
 terraform import awx_credential_azure_key_vault.example 510
+
+# Or by its AWX named URL: the name followed by the names of the objects it belongs to, separated by '++'.
+terraform import awx_credential_azure_key_vault.example 'Key Vault++Microsoft Azure Key Vault+external++Default'
@@ -1,2 +1,5 @@
 # Order can be imported by specifying the numeric identifier.
 terraform import awx_credential_galaxy.example 520
+
+# Or by its AWX named URL: the name followed by the names of the objects it belongs to, separated by '++'.
+terraform import awx_credential_galaxy.example 'Automation Hub++Ansible Galaxy/Automation Hub API Token+galaxy++Default'
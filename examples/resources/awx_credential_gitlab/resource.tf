@@ -8,3 +8,12 @@ resource "awx_credential_gitlab" "example" {
   description     = "test"
   token           = "My_TOKEN"
 }
+
+# With Terraform 1.11 or later, the token can be kept out of the state. Bump
+# token_wo_version to apply a new token.
+resource "awx_credential_gitlab" "write_only" {
+  organization_id  = awx_organization.example.id
+  name             = "awx-scm-credential-write-only"
+  token_wo         = var.gitlab_token
+  token_wo_version = 1
+}
@@ -1,2 +1,5 @@
 # Order can be imported by specifying the numeric identifier.
 terraform import awx_credential_google_compute_engine.example 540
+
+# Or by its AWX named URL: the name followed by the names of the objects it belongs to, separated by '++'.
+terraform import awx_credential_google_compute_engine.example 'GCE++Google Compute Engine+cloud++Default'
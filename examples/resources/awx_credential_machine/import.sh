@@ -1,3 +1,6 @@
 # Order can be imported by specifying the numeric identifier.
 terraform import awx_credential_machine.example_1 560
 terraform import awx_credential_machine.example_2 561
+
+# Or by its AWX named URL: the name followed by the names of the objects it belongs to, separated by '++'.
+terraform import awx_credential_machine.example_2 'Deploy key++Machine+ssh++Default'
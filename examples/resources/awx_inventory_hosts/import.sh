@@ -0,0 +1,5 @@
+# The hosts of an inventory can be imported by specifying the numeric identifier of the inventory.
+terraform import awx_inventory_hosts.example 620
+
+# Or by the AWX named URL of the inventory: its name followed by the name of its organization.
+terraform import awx_inventory_hosts.example 'Fleet++Default'
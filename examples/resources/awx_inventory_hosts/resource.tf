@@ -0,0 +1,28 @@
+data "awx_organization" "default" {
+  name = "Default"
+}
+
+resource "awx_inventory" "example" {
+  name            = "Fleet"
+  organization_id = data.awx_organization.default.id
+}
+
+locals {
+  fleet = { for i in range(1, 501) : format("node-%03d.example.com", i) => cidrhost("10.20.0.0/16", i) }
+}
+
+resource "awx_inventory_hosts" "example" {
+  inventory_id = awx_inventory.example.id
+
+  dynamic "host" {
+    for_each = local.fleet
+    content {
+      name      = host.key
+      variables = yamlencode({ ansible_host = host.value })
+    }
+  }
+}
+
+output "node_001_id" {
+  value = awx_inventory_hosts.example.host_ids["node-001.example.com"]
+}
@@ -59,12 +59,12 @@ func dataSourceCredentialAzure() *schema.Resource {
 	}
 }
 
-func dataSourceCredentialAzureRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceCredentialAzureRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	client := m.(*awx.AWX)
 	id, _ := d.Get("credential_id").(int)
-	cred, err := client.CredentialsService.GetCredentialsByID(id, map[string]string{})
+	cred, err := client.CredentialsService.GetCredentialsByID(ctx, id, map[string]string{})
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
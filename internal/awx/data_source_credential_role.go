@@ -2,9 +2,9 @@ package awx
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
@@ -38,13 +38,13 @@ func dataSourceCredentialMachineRole() *schema.Resource {
 	}
 }
 
-func dataSourceCredentialMachineRoleRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceCredentialMachineRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 	params := make(map[string]string)
 
 	credID := d.Get("credential_id").(int)
-	credentialID, err := client.CredentialsService.GetCredentialsByID(credID, params)
+	credentialID, err := client.CredentialsService.GetCredentialsByID(ctx, credID, params)
 	if err != nil {
 		return utils.DiagFetch("Machine Credential Role", credID, err)
 	}
@@ -62,7 +62,7 @@ func dataSourceCredentialMachineRoleRead(_ context.Context, d *schema.ResourceDa
 		id := roleID.(int)
 		for _, v := range rolesList {
 			if v != nil && id == v.ID {
-				d = setCredentialRoleData(d, v)
+				d = setCredentialRoleData(ctx, d, v)
 				return diags
 			}
 		}
@@ -73,7 +73,7 @@ func dataSourceCredentialMachineRoleRead(_ context.Context, d *schema.ResourceDa
 
 		for _, v := range rolesList {
 			if v != nil && name == v.Name {
-				d = setCredentialRoleData(d, v)
+				d = setCredentialRoleData(ctx, d, v)
 				return diags
 			}
 		}
@@ -82,9 +82,9 @@ func dataSourceCredentialMachineRoleRead(_ context.Context, d *schema.ResourceDa
 	return utils.DiagNotFound("Machine Credential Role", credID, nil)
 }
 
-func setCredentialRoleData(d *schema.ResourceData, r *awx.ApplyRole) *schema.ResourceData {
+func setCredentialRoleData(ctx context.Context, d *schema.ResourceData, r *awx.ApplyRole) *schema.ResourceData {
 	if err := d.Set("name", r.Name); err != nil {
-		fmt.Println("Error setting name", err)
+		tflog.Warn(ctx, "Error setting name", map[string]interface{}{"error": err})
 	}
 	d.SetId(strconv.Itoa(r.ID))
 	return d
@@ -52,12 +52,12 @@ func dataSourceCredentialTypeByID() *schema.Resource {
 	}
 }
 
-func dataSourceCredentialTypeByIDRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceCredentialTypeByIDRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	client := m.(*awx.AWX)
 	id := d.Get("id").(int)
-	credType, err := client.CredentialTypeService.GetCredentialTypeByID(id, map[string]string{})
+	credType, err := client.CredentialTypeService.GetCredentialTypeByID(ctx, id, map[string]string{})
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
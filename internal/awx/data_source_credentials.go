@@ -55,11 +55,11 @@ func dataSourceCredentials() *schema.Resource {
 	}
 }
 
-func dataSourceCredentialsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceCredentialsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 
-	creds, err := client.CredentialsService.ListCredentials(map[string]string{})
+	creds, err := client.CredentialsService.ListCredentials(ctx, map[string]string{})
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
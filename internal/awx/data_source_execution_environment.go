@@ -34,7 +34,7 @@ func dataSourceExecutionEnvironment() *schema.Resource {
 	}
 }
 
-func dataSourceExecutionEnvironmentsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceExecutionEnvironmentsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 	params := make(map[string]string)
@@ -46,7 +46,7 @@ func dataSourceExecutionEnvironmentsRead(_ context.Context, d *schema.ResourceDa
 		params["id"] = strconv.Itoa(groupID.(int))
 	}
 
-	executionEnvironments, _, err := client.ExecutionEnvironmentsService.ListExecutionEnvironments(params)
+	executionEnvironments, _, err := client.ExecutionEnvironmentsService.ListExecutionEnvironments(ctx, params)
 	if err != nil {
 		return utils.DiagFetch(diagEETitle, params, err)
 	}
@@ -66,6 +66,6 @@ func dataSourceExecutionEnvironmentsRead(_ context.Context, d *schema.ResourceDa
 	}
 
 	ee := executionEnvironments[0]
-	d = setExecutionEnvironmentsResourceData(d, ee)
+	d = setExecutionEnvironmentsResourceData(ctx, d, ee)
 	return diags
 }
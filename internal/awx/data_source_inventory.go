@@ -40,7 +40,7 @@ func dataSourceInventory() *schema.Resource {
 	}
 }
 
-func dataSourceInventoriesRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceInventoriesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 	params := make(map[string]string)
@@ -55,7 +55,7 @@ func dataSourceInventoriesRead(_ context.Context, d *schema.ResourceData, m inte
 	if organizationID, okIOrgID := d.GetOk("organization_id"); okIOrgID {
 		params["organization"] = strconv.Itoa(organizationID.(int))
 	}
-	inventories, _, err := client.InventoriesService.ListInventories(params)
+	inventories, _, err := client.InventoriesService.ListInventories(ctx, params)
 	if err != nil {
 		return utils.DiagFetch(diagInventoryTitle, params, err)
 	}
@@ -76,6 +76,6 @@ func dataSourceInventoriesRead(_ context.Context, d *schema.ResourceData, m inte
 	}
 
 	inventory := inventories[0]
-	d = setInventoryResourceData(d, inventory)
+	d = setInventoryResourceData(ctx, d, inventory)
 	return diags
 }
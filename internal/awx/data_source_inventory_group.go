@@ -39,7 +39,7 @@ func dataSourceInventoryGroup() *schema.Resource {
 	}
 }
 
-func dataSourceInventoryGroupRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceInventoryGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 	params := make(map[string]string)
@@ -52,7 +52,7 @@ func dataSourceInventoryGroupRead(_ context.Context, d *schema.ResourceData, m i
 	}
 
 	inventoryID := d.Get("inventory_id").(int)
-	groups, _, err := client.InventoryGroupService.ListInventoryGroups(inventoryID, params)
+	groups, _, err := client.InventoryGroupService.ListInventoryGroups(ctx, inventoryID, params)
 	if err != nil {
 		return utils.DiagFetch(diagInventoryGroupTitle, params, err)
 	}
@@ -72,6 +72,6 @@ func dataSourceInventoryGroupRead(_ context.Context, d *schema.ResourceData, m i
 	}
 
 	group := groups[0]
-	d = setInventoryGroupResourceData(d, group)
+	d = setInventoryGroupResourceData(ctx, d, group)
 	return diags
 }
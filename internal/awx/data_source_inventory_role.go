@@ -2,9 +2,9 @@ package awx
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
@@ -40,13 +40,13 @@ func dataSourceInventoryRole() *schema.Resource {
 	}
 }
 
-func dataSourceInventoryRoleRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceInventoryRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 	params := make(map[string]string)
 
 	invID := d.Get("inventory_id").(int)
-	inventory, err := client.InventoriesService.GetInventoryByID(invID, params)
+	inventory, err := client.InventoriesService.GetInventoryByID(ctx, invID, params)
 	if err != nil {
 		return utils.DiagFetch(diagInventoryRole, invID, err)
 	}
@@ -64,7 +64,7 @@ func dataSourceInventoryRoleRead(_ context.Context, d *schema.ResourceData, m in
 		id := roleID.(int)
 		for _, v := range rolesList {
 			if v != nil && id == v.ID {
-				d = setInventoryRoleData(d, v)
+				d = setInventoryRoleData(ctx, d, v)
 				return diags
 			}
 		}
@@ -75,7 +75,7 @@ func dataSourceInventoryRoleRead(_ context.Context, d *schema.ResourceData, m in
 
 		for _, v := range rolesList {
 			if v != nil && name == v.Name {
-				d = setInventoryRoleData(d, v)
+				d = setInventoryRoleData(ctx, d, v)
 				return diags
 			}
 		}
@@ -84,9 +84,9 @@ func dataSourceInventoryRoleRead(_ context.Context, d *schema.ResourceData, m in
 	return utils.DiagNotFound(diagInventoryRole, invID, nil)
 }
 
-func setInventoryRoleData(d *schema.ResourceData, r *awx.ApplyRole) *schema.ResourceData {
+func setInventoryRoleData(ctx context.Context, d *schema.ResourceData, r *awx.ApplyRole) *schema.ResourceData {
 	if err := d.Set("name", r.Name); err != nil {
-		fmt.Println("Error setting name", err)
+		tflog.Warn(ctx, "Error setting name", map[string]interface{}{"error": err})
 	}
 	d.SetId(strconv.Itoa(r.ID))
 	return d
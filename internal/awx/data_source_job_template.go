@@ -4,8 +4,6 @@ import (
 	"context"
 	"strconv"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
@@ -36,7 +34,7 @@ func dataSourceJobTemplate() *schema.Resource {
 	}
 }
 
-func dataSourceJobTemplateRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceJobTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 	params := make(map[string]string)
@@ -48,22 +46,20 @@ func dataSourceJobTemplateRead(_ context.Context, d *schema.ResourceData, m inte
 		params["id"] = strconv.Itoa(groupID.(int))
 	}
 
-	jobTemplate, _, err := client.JobTemplateService.ListJobTemplates(params)
+	jobTemplate, _, err := client.JobTemplateService.ListJobTemplates(ctx, params)
 
 	if err != nil {
 		return utils.DiagFetch(diagElementJobTemplate, params, err)
 	}
 
 	for _, template := range jobTemplate {
-		log.Printf("loop %v", template.Name)
 		if template.Name == params["name"] {
-			d = setJobTemplateResourceData(d, template)
+			d = setJobTemplateResourceData(ctx, d, template)
 			return diags
 		}
 	}
 
 	if _, okGroupID := d.GetOk("id"); okGroupID {
-		log.Printf("byid %v", len(jobTemplate))
 		if len(jobTemplate) > 1 {
 			return utils.Diagf(
 				"Get: find more than one Element",
@@ -78,7 +74,7 @@ func dataSourceJobTemplateRead(_ context.Context, d *schema.ResourceData, m inte
 				params,
 			)
 		}
-		d = setJobTemplateResourceData(d, jobTemplate[0])
+		d = setJobTemplateResourceData(ctx, d, jobTemplate[0])
 		return diags
 	}
 	return utils.Diagf(
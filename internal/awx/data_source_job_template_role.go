@@ -2,9 +2,9 @@ package awx
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
@@ -40,13 +40,13 @@ func dataSourceJobTemplateRole() *schema.Resource {
 	}
 }
 
-func dataSourceJobTemplateRoleRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceJobTemplateRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 	params := make(map[string]string)
 
 	templateID := d.Get("job_template_id").(int)
-	jobTemplate, err := client.JobTemplateService.GetJobTemplateByID(templateID, params)
+	jobTemplate, err := client.JobTemplateService.GetJobTemplateByID(ctx, templateID, params)
 	if err != nil {
 		return utils.DiagFetch(diagJobTemplateRole, templateID, err)
 	}
@@ -64,7 +64,7 @@ func dataSourceJobTemplateRoleRead(_ context.Context, d *schema.ResourceData, m
 		id := roleID.(int)
 		for _, v := range rolesList {
 			if v != nil && id == v.ID {
-				d = setJobTemplateRoleData(d, v)
+				d = setJobTemplateRoleData(ctx, d, v)
 				return diags
 			}
 		}
@@ -75,7 +75,7 @@ func dataSourceJobTemplateRoleRead(_ context.Context, d *schema.ResourceData, m
 
 		for _, v := range rolesList {
 			if v != nil && name == v.Name {
-				d = setJobTemplateRoleData(d, v)
+				d = setJobTemplateRoleData(ctx, d, v)
 				return diags
 			}
 		}
@@ -84,9 +84,9 @@ func dataSourceJobTemplateRoleRead(_ context.Context, d *schema.ResourceData, m
 	return utils.DiagNotFound(diagJobTemplateRole, templateID, nil)
 }
 
-func setJobTemplateRoleData(d *schema.ResourceData, r *awx.ApplyRole) *schema.ResourceData {
+func setJobTemplateRoleData(ctx context.Context, d *schema.ResourceData, r *awx.ApplyRole) *schema.ResourceData {
 	if err := d.Set("name", r.Name); err != nil {
-		fmt.Println("Error setting name", err)
+		tflog.Warn(ctx, "Error setting name", map[string]interface{}{"error": err})
 	}
 	d.SetId(strconv.Itoa(r.ID))
 	return d
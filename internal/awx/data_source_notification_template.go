@@ -32,7 +32,7 @@ func dataSourceNotificationTemplate() *schema.Resource {
 	}
 }
 
-func dataSourceNotificationTemplatesRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceNotificationTemplatesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 	params := make(map[string]string)
@@ -44,7 +44,7 @@ func dataSourceNotificationTemplatesRead(_ context.Context, d *schema.ResourceDa
 		params["id"] = strconv.Itoa(groupID.(int))
 	}
 
-	notificationTemplates, _, err := client.NotificationTemplatesService.List(params)
+	notificationTemplates, _, err := client.NotificationTemplatesService.List(ctx, params)
 	if err != nil {
 		return utils.DiagFetch(diagInventoryTitle, params, err)
 	}
@@ -64,6 +64,6 @@ func dataSourceNotificationTemplatesRead(_ context.Context, d *schema.ResourceDa
 	}
 
 	notificationTemplate := notificationTemplates[0]
-	d = setNotificationTemplateResourceData(d, notificationTemplate)
+	d = setNotificationTemplateResourceData(ctx, d, notificationTemplate)
 	return diags
 }
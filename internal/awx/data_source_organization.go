@@ -34,7 +34,7 @@ func dataSourceOrganization() *schema.Resource {
 	}
 }
 
-func dataSourceOrganizationRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceOrganizationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 	params := make(map[string]string)
@@ -46,7 +46,7 @@ func dataSourceOrganizationRead(_ context.Context, d *schema.ResourceData, m int
 		params["id"] = strconv.Itoa(groupID.(int))
 	}
 
-	organizations, err := client.OrganizationsService.ListOrganizations(params)
+	organizations, err := client.OrganizationsService.ListOrganizations(ctx, params)
 	if err != nil {
 		return utils.DiagFetch(diagOrganizationTitle, params, err)
 	}
@@ -66,6 +66,6 @@ func dataSourceOrganizationRead(_ context.Context, d *schema.ResourceData, m int
 	}
 
 	organization := organizations[0]
-	d = setOrganizationsResourceData(d, organization)
+	d = setOrganizationsResourceData(ctx, d, organization)
 	return diags
 }
@@ -2,9 +2,9 @@ package awx
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
@@ -40,14 +40,14 @@ func dataSourceOrganizationRole() *schema.Resource {
 	}
 }
 
-func dataSourceOrganizationRolesRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceOrganizationRolesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 	params := make(map[string]string)
 
 	orgID := d.Get("organization_id").(int)
 
-	organization, err := client.OrganizationsService.GetOrganizationsByID(orgID, params)
+	organization, err := client.OrganizationsService.GetOrganizationsByID(ctx, orgID, params)
 	if err != nil {
 		return utils.DiagFetch(diagOrganizationRole, orgID, err)
 	}
@@ -75,7 +75,7 @@ func dataSourceOrganizationRolesRead(_ context.Context, d *schema.ResourceData,
 		id := roleID.(int)
 		for _, v := range rolesList {
 			if v != nil && id == v.ID {
-				d = setOrganizationRoleData(d, v)
+				d = setOrganizationRoleData(ctx, d, v)
 				return diags
 			}
 		}
@@ -86,7 +86,7 @@ func dataSourceOrganizationRolesRead(_ context.Context, d *schema.ResourceData,
 
 		for _, v := range rolesList {
 			if v != nil && name == v.Name {
-				d = setOrganizationRoleData(d, v)
+				d = setOrganizationRoleData(ctx, d, v)
 				return diags
 			}
 		}
@@ -95,9 +95,9 @@ func dataSourceOrganizationRolesRead(_ context.Context, d *schema.ResourceData,
 	return utils.DiagNotFound(diagOrganizationRole, orgID, nil)
 }
 
-func setOrganizationRoleData(d *schema.ResourceData, r *awx.ApplyRole) *schema.ResourceData {
+func setOrganizationRoleData(ctx context.Context, d *schema.ResourceData, r *awx.ApplyRole) *schema.ResourceData {
 	if err := d.Set("name", r.Name); err != nil {
-		fmt.Println("Error setting name", err)
+		tflog.Warn(ctx, "Error setting name", map[string]interface{}{"error": err})
 	}
 	d.SetId(strconv.Itoa(r.ID))
 	return d
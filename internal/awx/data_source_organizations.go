@@ -36,13 +36,13 @@ func dataSourceOrganizations() *schema.Resource {
 	}
 }
 
-func dataSourceOrganizationsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceOrganizationsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 
 	parsedOrgs := make([]map[string]interface{}, 0)
 
-	orgs, err := client.OrganizationsService.ListOrganizations(map[string]string{})
+	orgs, err := client.OrganizationsService.ListOrganizations(ctx, map[string]string{})
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -34,7 +34,7 @@ func dataSourceProject() *schema.Resource {
 	}
 }
 
-func dataSourceProjectsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceProjectsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 	params := make(map[string]string)
@@ -46,7 +46,7 @@ func dataSourceProjectsRead(_ context.Context, d *schema.ResourceData, m interfa
 		params["id"] = strconv.Itoa(groupID.(int))
 	}
 
-	projects, _, err := client.ProjectService.ListProjects(params)
+	projects, _, err := client.ProjectService.ListProjects(ctx, params)
 	if err != nil {
 		return utils.DiagFetch(diagProjectTitle, params, err)
 	}
@@ -66,6 +66,9 @@ func dataSourceProjectsRead(_ context.Context, d *schema.ResourceData, m interfa
 	}
 
 	project := projects[0]
-	d = setProjectResourceData(d, project)
+	if err := d.Set("name", project.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(strconv.Itoa(project.ID))
 	return diags
 }
@@ -2,9 +2,9 @@ package awx
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
@@ -40,14 +40,14 @@ func dataSourceProjectRole() *schema.Resource {
 	}
 }
 
-func dataSourceProjectRolesRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceProjectRolesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 	params := make(map[string]string)
 
 	projID := d.Get("project_id").(int)
 
-	Project, err := client.ProjectService.GetProjectByID(projID, params)
+	Project, err := client.ProjectService.GetProjectByID(ctx, projID, params)
 	if err != nil {
 		return utils.DiagFetch(diagProjectRole, projID, err)
 	}
@@ -63,7 +63,7 @@ func dataSourceProjectRolesRead(_ context.Context, d *schema.ResourceData, m int
 		id := roleID.(int)
 		for _, v := range rolesList {
 			if v != nil && id == v.ID {
-				d = setProjectRoleData(d, v)
+				d = setProjectRoleData(ctx, d, v)
 				return diags
 			}
 		}
@@ -74,7 +74,7 @@ func dataSourceProjectRolesRead(_ context.Context, d *schema.ResourceData, m int
 
 		for _, v := range rolesList {
 			if v != nil && name == v.Name {
-				d = setProjectRoleData(d, v)
+				d = setProjectRoleData(ctx, d, v)
 				return diags
 			}
 		}
@@ -83,9 +83,9 @@ func dataSourceProjectRolesRead(_ context.Context, d *schema.ResourceData, m int
 	return utils.DiagNotFound(diagProjectRole, params, nil)
 }
 
-func setProjectRoleData(d *schema.ResourceData, r *awx.ApplyRole) *schema.ResourceData {
+func setProjectRoleData(ctx context.Context, d *schema.ResourceData, r *awx.ApplyRole) *schema.ResourceData {
 	if err := d.Set("name", r.Name); err != nil {
-		fmt.Println("Error setting name", err)
+		tflog.Warn(ctx, "Error setting name", map[string]interface{}{"error": err})
 	}
 	d.SetId(strconv.Itoa(r.ID))
 	return d
@@ -34,7 +34,7 @@ func dataSourceSchedule() *schema.Resource {
 	}
 }
 
-func dataSourceSchedulesRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceSchedulesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 	params := make(map[string]string)
@@ -46,7 +46,7 @@ func dataSourceSchedulesRead(_ context.Context, d *schema.ResourceData, m interf
 		params["id"] = strconv.Itoa(groupID.(int))
 	}
 
-	schedules, _, err := client.ScheduleService.List(params)
+	schedules, _, err := client.ScheduleService.List(ctx, params)
 	if err != nil {
 		return utils.DiagFetch(diagScheduleTitle, params, err)
 	}
@@ -66,6 +66,6 @@ func dataSourceSchedulesRead(_ context.Context, d *schema.ResourceData, m interf
 	}
 
 	schedule := schedules[0]
-	d = setScheduleResourceData(d, schedule)
+	d = setScheduleResourceData(ctx, d, schedule)
 	return diags
 }
@@ -33,7 +33,7 @@ func dataSourceTeam() *schema.Resource {
 	}
 }
 
-func dataSourceTeamsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceTeamsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 	params := make(map[string]string)
@@ -45,7 +45,7 @@ func dataSourceTeamsRead(_ context.Context, d *schema.ResourceData, m interface{
 		params["id"] = strconv.Itoa(teamID.(int))
 	}
 
-	teams, _, err := client.TeamService.ListTeams(params)
+	teams, _, err := client.TeamService.ListTeams(ctx, params)
 	if err != nil {
 		return utils.DiagFetch(diagTeamTitle, params, err)
 	}
@@ -66,11 +66,11 @@ func dataSourceTeamsRead(_ context.Context, d *schema.ResourceData, m interface{
 		)
 	}
 
-	entitlements, _, err := client.TeamService.ListTeamRoleEntitlements(teams[0].ID, make(map[string]string))
+	entitlements, _, err := client.TeamService.ListTeamRoleEntitlements(ctx, teams[0].ID, make(map[string]string))
 	if err != nil {
 		return utils.DiagFetch(diagTeamTitle, teams[0].ID, err)
 	}
 
-	d = setTeamResourceData(d, teams[0], entitlements)
+	d = setTeamResourceData(ctx, d, teams[0], entitlements)
 	return diags
 }
@@ -34,7 +34,7 @@ func dataSourceWorkflowJobTemplate() *schema.Resource {
 	}
 }
 
-func dataSourceWorkflowJobTemplateRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceWorkflowJobTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 	params := make(map[string]string)
@@ -46,14 +46,14 @@ func dataSourceWorkflowJobTemplateRead(_ context.Context, d *schema.ResourceData
 		params["id"] = strconv.Itoa(groupID.(int))
 	}
 
-	workflowJobTemplate, _, err := client.WorkflowJobTemplateService.ListWorkflowJobTemplates(params)
+	workflowJobTemplate, _, err := client.WorkflowJobTemplateService.ListWorkflowJobTemplates(ctx, params)
 	if err != nil {
 		return utils.DiagFetch(diagWorkflowJobTemplateTitle, params, err)
 	}
 	if groupName, okName := d.GetOk("name"); okName {
 		for _, template := range workflowJobTemplate {
 			if template.Name == groupName {
-				d = setWorkflowJobTemplateResourceData(d, template)
+				d = setWorkflowJobTemplateResourceData(ctx, d, template)
 				return diags
 			}
 		}
@@ -73,7 +73,7 @@ func dataSourceWorkflowJobTemplateRead(_ context.Context, d *schema.ResourceData
 				params,
 			)
 		}
-		d = setWorkflowJobTemplateResourceData(d, workflowJobTemplate[0])
+		d = setWorkflowJobTemplateResourceData(ctx, d, workflowJobTemplate[0])
 		return diags
 	}
 	return utils.Diagf(
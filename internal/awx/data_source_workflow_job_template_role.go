@@ -2,9 +2,9 @@ package awx
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
@@ -40,13 +40,13 @@ func dataSourceWorkflowJobTemplateRole() *schema.Resource {
 	}
 }
 
-func dataSourceWorkflowJobTemplateRoleRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceWorkflowJobTemplateRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 	params := make(map[string]string)
 
 	templateID := d.Get("workflow_job_template_id").(int)
-	workflowJobTemplate, err := client.WorkflowJobTemplateService.GetWorkflowJobTemplateByID(templateID, params)
+	workflowJobTemplate, err := client.WorkflowJobTemplateService.GetWorkflowJobTemplateByID(ctx, templateID, params)
 	if err != nil {
 		return utils.DiagFetch(diagWorkflowJobTemplateRole, templateID, err)
 	}
@@ -64,7 +64,7 @@ func dataSourceWorkflowJobTemplateRoleRead(_ context.Context, d *schema.Resource
 		id := roleID.(int)
 		for _, v := range rolesList {
 			if v != nil && id == v.ID {
-				d = setWorkflowJobTemplateRoleData(d, v)
+				d = setWorkflowJobTemplateRoleData(ctx, d, v)
 				return diags
 			}
 		}
@@ -75,7 +75,7 @@ func dataSourceWorkflowJobTemplateRoleRead(_ context.Context, d *schema.Resource
 
 		for _, v := range rolesList {
 			if v != nil && name == v.Name {
-				d = setWorkflowJobTemplateRoleData(d, v)
+				d = setWorkflowJobTemplateRoleData(ctx, d, v)
 				return diags
 			}
 		}
@@ -84,9 +84,9 @@ func dataSourceWorkflowJobTemplateRoleRead(_ context.Context, d *schema.Resource
 	return utils.DiagNotFound(diagWorkflowJobTemplateRole, templateID, nil)
 }
 
-func setWorkflowJobTemplateRoleData(d *schema.ResourceData, r *awx.ApplyRole) *schema.ResourceData {
+func setWorkflowJobTemplateRoleData(ctx context.Context, d *schema.ResourceData, r *awx.ApplyRole) *schema.ResourceData {
 	if err := d.Set("name", r.Name); err != nil {
-		fmt.Println("Error setting name", err)
+		tflog.Warn(ctx, "Error setting name", map[string]interface{}{"error": err})
 	}
 	d.SetId(strconv.Itoa(r.ID))
 	return d
@@ -1,10 +1,29 @@
 package awx
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
 )
 
+// ansiEscape matches the color codes of the playbook output.
+//
+//nolint:gochecknoglobals
+var ansiEscape = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// stripANSI removes the color codes from playbook output, which diagnostics and logs cannot render.
+func stripANSI(output string) string {
+	return ansiEscape.ReplaceAllString(output, "")
+}
+
 // The AWX API returns '$encrypted$' in place of the password/ssh_key_data. We do not want to write that placeholder to the
 // Terraform state file as it would break diffing and cause the SCM credential to be recreated on every apply.
 func setSanitizedEncryptedValue(d *schema.ResourceData, fieldName string, value interface{}) error {
@@ -28,3 +47,135 @@ func setSanitizedEncryptedValue(d *schema.ResourceData, fieldName string, value
 func setSanitizedEncryptedCredential(d *schema.ResourceData, fieldName string, cred *awx.Credential) error {
 	return setSanitizedEncryptedValue(d, fieldName, cred.Inputs[fieldName])
 }
+
+// removeFromStateIfNotFound drops the resource from the state when err reports that the AWX object was
+// deleted out of band, so that Terraform proposes to re-create it instead of failing the refresh.
+func removeFromStateIfNotFound(ctx context.Context, d *schema.ResourceData, err error) bool {
+	if !awx.IsNotFound(err) {
+		return false
+	}
+	tflog.Warn(ctx, "AWX object no longer exists, removing it from state", map[string]interface{}{"id": d.Id()})
+	d.SetId("")
+	return true
+}
+
+// importStateIDOrNamedURL imports the object of collection, e.g. "job_templates", from either its numeric ID
+// or its AWX named URL, such as "Deploy++Default" for the job template "Deploy" of the organization "Default".
+func importStateIDOrNamedURL(collection string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		if _, err := strconv.Atoi(d.Id()); err == nil {
+			return []*schema.ResourceData{d}, nil
+		}
+
+		client := m.(*awx.AWX)
+		id, err := client.NamedURLService.ResolveID(ctx, collection, d.Id())
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve the %s named URL %q: %w", collection, d.Id(), err)
+		}
+		d.SetId(strconv.Itoa(id))
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// connectedServerInfo returns the description of the AWX server, connecting a deferred client first
+// for its version to be known.
+func connectedServerInfo(ctx context.Context, client *awx.AWX) (*awx.ServerInfo, diag.Diagnostics) {
+	if err := client.Connect(ctx); err != nil {
+		return nil, diag.Errorf("unable to connect to the AWX server: %s", err)
+	}
+	return client.ServerInfo(), nil
+}
+
+// attributeGetter reads the attributes of a resource, from its configuration (*schema.ResourceData)
+// or from its plan (*schema.ResourceDiff).
+type attributeGetter interface {
+	GetOk(key string) (interface{}, bool)
+}
+
+// featureCheck returns the errors of the attributes of d that the AWX server described by info
+// does not support.
+type featureCheck func(d attributeGetter, info *awx.ServerInfo) diag.Diagnostics
+
+// customizeDiffFeatures rejects at plan time the attributes the AWX server does not support. The
+// check is skipped when the server version is not known yet, as when the connection is deferred:
+// Create and Update run it again once connected.
+func customizeDiffFeatures(check featureCheck) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+		client, ok := m.(*awx.AWX)
+		if !ok {
+			return nil
+		}
+		info := client.ServerInfo()
+		if info.Version == "" {
+			return nil
+		}
+		var errs []error
+		for _, diagnostic := range check(d, info) {
+			if diagnostic.Severity == diag.Error {
+				errs = append(errs, fmt.Errorf("%s: %s", diagnostic.Summary, diagnostic.Detail))
+			}
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// checkFeatures runs check against the AWX server, connecting a deferred client first.
+func checkFeatures(ctx context.Context, d *schema.ResourceData, client *awx.AWX, check featureCheck) diag.Diagnostics {
+	info, diags := connectedServerInfo(ctx, client)
+	if diags.HasError() {
+		return diags
+	}
+	return check(d, info)
+}
+
+// checkFeatureFields returns an error for every field set in the configuration that relies on a feature
+// the AWX server described by info does not provide, rather than letting the API reject or silently ignore it.
+func checkFeatureFields(d attributeGetter, info *awx.ServerInfo, feature awx.Feature, fields ...string) diag.Diagnostics {
+	if info.Supports(feature) {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	for _, field := range fields {
+		if _, ok := d.GetOk(field); !ok {
+			continue
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%s is not supported by the AWX server", field),
+			Detail: fmt.Sprintf(
+				"%s relies on %s, which requires %s. The provider is connected to %s: remove the attribute or upgrade the server.",
+				field, feature.Name, feature.Requirement(), info,
+			),
+			AttributePath: cty.GetAttrPath(field),
+		})
+	}
+	return diags
+}
+
+// optionalID returns the request value of a foreign key attribute, where 0 stands for no object.
+func optionalID(id int) *awx.Nullable[int] {
+	if id == 0 {
+		return awx.Null[int]()
+	}
+	return awx.NewNullable(id)
+}
+
+// optionalIDFromString is optionalID for foreign keys stored as strings, where the empty string
+// stands for no object.
+func optionalIDFromString(id string) *awx.Nullable[int] {
+	value, err := strconv.Atoi(id)
+	if err != nil {
+		return awx.Null[int]()
+	}
+	return optionalID(value)
+}
+
+// optionalString returns the request value of a nullable string attribute, where the empty
+// string is sent as null.
+func optionalString(value string) *awx.Nullable[string] {
+	if value == "" {
+		return awx.Null[string]()
+	}
+	return awx.NewNullable(value)
+}
@@ -0,0 +1,82 @@
+package awx
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/goawx/awxtest"
+)
+
+func Test_checkFeatures(t *testing.T) {
+	cases := []struct {
+		name    string
+		version string
+		config  map[string]interface{}
+		errors  int
+	}{
+		{name: "supported", version: "23.0.0", config: map[string]interface{}{"ask_labels_on_launch": true, "job_tags": "deploy"}},
+		{name: "unsupported", version: "21.0.0", config: map[string]interface{}{"ask_labels_on_launch": true, "job_tags": "deploy"}, errors: 2},
+		{name: "unsupported but unset", version: "21.0.0", config: map[string]interface{}{"ask_limit_on_launch": true}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := awxtest.NewServer(awxtest.WithVersion(tc.version))
+			defer srv.Close()
+			// The deferred client only knows the server version once connected.
+			client := awx.NewAWXDeferred(srv.URL, &awx.BasicAuth{Username: srv.Username, Password: srv.Password}, nil)
+
+			tc.config["name"] = "release"
+			d := schema.TestResourceDataRaw(t, resourceWorkflowJobTemplate().Schema, tc.config)
+			diags := checkFeatures(context.Background(), d, client, workflowJobTemplateFeatures)
+			if len(diags) != tc.errors {
+				t.Errorf("Expecting %d errors, got %v", tc.errors, diags)
+			}
+			if info := client.ServerInfo(); info.Version != tc.version {
+				t.Errorf("Expecting the client to be connected to version %s, got %s", tc.version, info)
+			}
+		})
+	}
+}
+
+func Test_customizeDiffFeatures(t *testing.T) {
+	config := map[string]interface{}{"name": "release", "ask_labels_on_launch": true}
+	cases := []struct {
+		name     string
+		version  string
+		deferred bool
+		fails    bool
+	}{
+		{name: "supported", version: "23.0.0"},
+		{name: "unsupported", version: "21.0.0", fails: true},
+		{name: "unknown version", version: "21.0.0", deferred: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := awxtest.NewServer(awxtest.WithVersion(tc.version))
+			defer srv.Close()
+			ctx := context.Background()
+			auth := &awx.BasicAuth{Username: srv.Username, Password: srv.Password}
+			client := awx.NewAWXDeferred(srv.URL, auth, nil)
+			if !tc.deferred {
+				if err := client.Connect(ctx); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			_, err := resourceWorkflowJobTemplate().SimpleDiff(ctx, &terraform.InstanceState{}, terraform.NewResourceConfigRaw(config), client)
+			if fails := err != nil; fails != tc.fails {
+				t.Errorf("Expecting the plan to fail: %t, got %v", tc.fails, err)
+			}
+			if err != nil && !strings.Contains(err.Error(), "ask_labels_on_launch is not supported") {
+				t.Errorf("Unexpected error %v", err)
+			}
+			if tc.deferred && client.ServerInfo().Version != "" {
+				t.Error("Expecting the plan not to connect a deferred client")
+			}
+		})
+	}
+}
@@ -2,54 +2,127 @@ package awx
 
 import (
 	"context"
-	"crypto/tls"
-	"crypto/x509"
 	"fmt"
 	"net/http"
-	"os"
+	"regexp"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
 // Provider returns a schema.Provider for AWX.
 func Provider() *schema.Provider { //nolint:funlen
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"hostname": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("AWX_HOSTNAME", "http://localhost"),
+				DefaultFunc: schema.MultiEnvDefaultFunc(hostnameEnvVars, nil),
+				Description: "URL of AWX. Read from `AWX_HOSTNAME`, `CONTROLLER_HOST`, `TOWER_HOST` or the `host` " +
+					"setting of the config file when unset, and defaults to `http://localhost`.",
 			},
 			"insecure": {
-				Type:        schema.TypeBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Disable SSL verification of API calls. Read from `CONTROLLER_VERIFY_SSL`, " +
+					"`TOWER_VERIFY_SSL` or the `verify_ssl` setting of the config file when unset, which enable the " +
+					"verification when true.",
+			},
+			"config_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AWX_CONFIG_FILE", ""),
+				Description: "Path of a tower-cli style config file providing the connection settings left unset. " +
+					"When unset, `/etc/tower/tower_cli.cfg`, `~/.tower_cli.cfg` and `.tower_cli.cfg` are read when " +
+					"they exist, the later files taking precedence. Can also be set with `AWX_CONFIG_FILE`.",
+			},
+			"profile": {
+				Type:        schema.TypeString,
 				Optional:    true,
-				Default:     false,
-				Description: "Disable SSL verification of API calls",
+				DefaultFunc: schema.EnvDefaultFunc("AWX_PROFILE", defaultConfigProfile),
+				Description: "Section of the config file to read the connection settings from, `general` by default. " +
+					"Can also be set with `AWX_PROFILE`.",
 			},
 			"ca_pem": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "",
-				Description: "Path to a CA Certificate in PEM format to be used to verify the server",
+				Description: "CA Certificate in PEM format to be used to verify the server, either as a file path or as inline content",
+			},
+			"client_cert_pem": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				RequiredWith: []string{"client_key_pem"},
+				Description:  "Client certificate in PEM format presented for mutual TLS, either as a file path or as inline content",
+			},
+			"client_key_pem": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				Default:      "",
+				RequiredWith: []string{"client_cert_pem"},
+				Description:  "Private key of `client_cert_pem` in PEM format, either as a file path or as inline content",
+			},
+			"tls_server_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Server name used to verify the certificate presented by AWX, when it differs from `hostname`",
+			},
+			"proxy_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+				Description: "URL of the proxy used to reach AWX. " +
+					"When unset, the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are honoured",
+			},
+			"no_proxy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				RequiredWith: []string{"proxy_url"},
+				Description:  "Comma separated list of hosts, domains and CIDRs reached without going through `proxy_url`",
+			},
+			"api_base_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/api/.*/$`), "must start with /api/ and end with /"),
+				Description: "Path under which the controller API is served, e.g. `/api/v2/` for AWX or " +
+					"`/api/controller/v2/` for Ansible Automation Platform 2.5 behind the platform gateway. " +
+					"Detected from the API root when unset.",
 			},
 			"username": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("AWX_USERNAME", "admin"),
+				DefaultFunc: schema.MultiEnvDefaultFunc(usernameEnvVars, nil),
+				Description: "Read from `AWX_USERNAME`, `CONTROLLER_USERNAME`, `TOWER_USERNAME` or the `username` " +
+					"setting of the config file when unset, and defaults to `admin`.",
 			},
 			"password": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Sensitive:   true,
-				DefaultFunc: schema.EnvDefaultFunc("AWX_PASSWORD", "password"),
+				DefaultFunc: schema.MultiEnvDefaultFunc(passwordEnvVars, nil),
+				Description: "Read from `AWX_PASSWORD`, `CONTROLLER_PASSWORD`, `TOWER_PASSWORD` or the `password` " +
+					"setting of the config file when unset, and defaults to `password`.",
 			},
 			"token": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Sensitive:   true,
-				DefaultFunc: schema.EnvDefaultFunc("AWX_TOKEN", ""),
+				DefaultFunc: schema.MultiEnvDefaultFunc(tokenEnvVars, nil),
+				Description: "OAuth2 token sent as a bearer token, issued by AWX or by the platform gateway on " +
+					"Ansible Automation Platform 2.5. Read from `AWX_TOKEN`, `CONTROLLER_OAUTH_TOKEN`, " +
+					"`TOWER_OAUTH_TOKEN` or the `oauth_token` setting of the config file when unset.",
 			},
 			"http_headers": {
 				Type:        schema.TypeMap,
@@ -58,6 +131,83 @@ func Provider() *schema.Provider { //nolint:funlen
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "Optional. HTTP headers mapping keys to values used for accessing the AWX Api.",
 			},
+			"auth_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      authMethodBasic,
+				ValidateFunc: validation.StringInSlice([]string{authMethodBasic, authMethodPersonalToken, authMethodSession}, false),
+				Description: "How username and password are used to authenticate when no token is set. " +
+					"`basic` sends them with every request, `personal_token` exchanges them for an OAuth2 personal " +
+					"access token that is revoked when the provider shuts down, `session` logs in through `/api/login/` " +
+					"and uses the session cookie, for deployments with basic auth disabled. The personal access token " +
+					"expires as set by the `ACCESS_TOKEN_EXPIRE_SECONDS` AWX setting otherwise, e.g. when the provider is killed.",
+			},
+			"personal_token_scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "write",
+				ValidateFunc: validation.StringInSlice([]string{"read", "write"}, false),
+				Description:  "Scope of the personal access token created when `auth_method` is `personal_token`. One of `read` or `write`.",
+			},
+			"personal_token_description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Terraform AWX provider",
+				Description: "Description of the personal access token created when `auth_method` is `personal_token`.",
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      awx.DefaultMaxRetries,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of retries for transient AWX API failures (429, 502, 503, 504 and connection errors). Set to 0 to disable retries.",
+			},
+			"retry_wait_min": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(awx.DefaultRetryWaitMin / time.Second),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Minimum time in seconds to wait before retrying a failed AWX API call. The wait doubles on every attempt.",
+			},
+			"retry_wait_max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(awx.DefaultRetryWaitMax / time.Second),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum time in seconds to wait between two attempts, including waits requested by a Retry-After header.",
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description: "Maximum number of AWX API requests in flight at once, shared by all the resources. " +
+					"Set to 0, the default, for no limit.",
+			},
+			"requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description: "Maximum number of AWX API requests sent every second, retries included. " +
+					"Set to 0, the default, for no limit.",
+			},
+			"skip_connectivity_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Do not connect to AWX when the provider is configured, but on the first API call of a " +
+					"resource or data source, so that a plan creating AWX in the same run does not need it yet. " +
+					"The connection is always deferred while the connection settings are unknown.",
+			},
+			"lookup_cache": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Cache the objects read from AWX for the duration of the run, so that data sources " +
+					"looking up the same objects do not list them again. A write to a collection drops its cached " +
+					"objects. Changes made to AWX outside of the provider during the run are not seen.",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"awx_credential_azure_key_vault":                            resourceCredentialAzureKeyVault(),
@@ -77,6 +227,7 @@ func Provider() *schema.Provider { //nolint:funlen
 			"awx_inventory_group":                                       resourceInventoryGroup(),
 			"awx_inventory_source":                                      resourceInventorySource(),
 			"awx_inventory":                                             resourceInventory(),
+			"awx_inventory_hosts":                                       resourceInventoryHosts(),
 			"awx_inventory_instance_groups":                             resourceInventoryInstanceGroups(),
 			"awx_job_template_credential":                               resourceJobTemplateCredentials(),
 			"awx_job_template_instance_groups":                          resourceJobTemplateInstanceGroups(),
@@ -92,7 +243,6 @@ func Provider() *schema.Provider { //nolint:funlen
 			"awx_organization":                                          resourceOrganization(),
 			"awx_organization_galaxy_credential":                        resourceOrganizationsGalaxyCredentials(),
 			"awx_organization_instance_groups":                          resourceOrganizationsInstanceGroups(),
-			"awx_project":                                               resourceProject(),
 			"awx_schedule":                                              resourceSchedule(),
 			"awx_settings_ldap_team_map":                                resourceSettingsLDAPTeamMap(),
 			"awx_setting":                                               resourceSetting(),
@@ -136,7 +286,57 @@ func Provider() *schema.Provider { //nolint:funlen
 			"awx_workflow_job_template_role": dataSourceWorkflowJobTemplateRole(),
 			"awx_team":                       dataSourceTeam(),
 		},
-		ConfigureContextFunc: providerConfigure,
+	}
+	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		return providerConfigure(ctx, p, d)
+	}
+	return p
+}
+
+const (
+	authMethodBasic         = "basic"
+	authMethodPersonalToken = "personal_token"
+	authMethodSession       = "session"
+)
+
+// configuredClients keeps track of the AWX client configured by each provider instance, so that the
+// personal access tokens and sessions they own can be released once Terraform stops the plugin.
+//
+//nolint:gochecknoglobals
+var (
+	configuredClientsMu sync.Mutex
+	configuredClients   = make(map[*schema.Provider]*awx.AWX)
+)
+
+// trackClient records c as the client configured by p, releasing the credentials of the client p
+// configured before, if any. Only the clients owning server-side credentials are kept.
+func trackClient(ctx context.Context, p *schema.Provider, c *awx.AWX, ownsCredentials bool) {
+	configuredClientsMu.Lock()
+	previous := configuredClients[p]
+	if ownsCredentials {
+		configuredClients[p] = c
+	} else {
+		delete(configuredClients, p)
+	}
+	configuredClientsMu.Unlock()
+
+	if previous != nil && previous != c {
+		if err := previous.Close(ctx); err != nil {
+			tflog.Warn(ctx, "Unable to release AWX credentials", map[string]interface{}{"error": err.Error()})
+		}
+	}
+}
+
+// Shutdown releases the server-side credentials held by the configured AWX clients.
+// It is meant to be called once the plugin server has stopped.
+func Shutdown(ctx context.Context) {
+	configuredClientsMu.Lock()
+	defer configuredClientsMu.Unlock()
+	for p, c := range configuredClients {
+		if err := c.Close(ctx); err != nil {
+			tflog.Warn(ctx, "Unable to release AWX credentials", map[string]interface{}{"error": err.Error()})
+		}
+		delete(configuredClients, p)
 	}
 }
 
@@ -152,12 +352,32 @@ func (hrt HeadersRoundTripper) RoundTrip(r *http.Request) (*http.Response, error
 	return hrt.r.RoundTrip(r)
 }
 
-func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	hostname := d.Get("hostname").(string)
-	username := d.Get("username").(string)
-	password := d.Get("password").(string)
-	token := d.Get("token").(string)
-	caPem := d.Get("ca_pem").(string)
+// Unwrap returns the transport the headers are added to, which the AWX WebSocket channel is dialed
+// with.
+func (hrt HeadersRoundTripper) Unwrap() http.RoundTripper {
+	return hrt.r
+}
+
+// Headers returns the headers added to the requests, also sent on the WebSocket handshake.
+func (hrt HeadersRoundTripper) Headers() http.Header {
+	headers := make(http.Header, len(hrt.headers))
+	for header, value := range hrt.headers {
+		headers.Set(header, value)
+	}
+	return headers
+}
+
+func providerConfigure(ctx context.Context, p *schema.Provider, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	settings, err := resolveConnectionSettings(d)
+	if err != nil {
+		return nil, utils.Diagf("Invalid AWX connection settings", "%s", err)
+	}
+	hostname := settings.hostname
+	username := settings.username
+	password := settings.password
+	token := settings.token
+	retryWaitMin := time.Duration(d.Get("retry_wait_min").(int)) * time.Second
+	retryWaitMax := time.Duration(d.Get("retry_wait_max").(int)) * time.Second
 
 	headers := map[string]string{}
 	if httpHeaders, ok := d.GetOk("http_headers"); ok {
@@ -166,52 +386,104 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 		}
 	}
 
-	// Warning or errors can be collected in a slice type
-	var diags diag.Diagnostics
-
-	customTransport := http.DefaultTransport.(*http.Transport).Clone()
-	if d.Get("insecure").(bool) {
-		//nolint:gosec
-		customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-	} else if caPem != "" {
-		certPool := x509.NewCertPool()
-		if caCertPem, err := os.ReadFile(caPem); err != nil {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "Unable to read file",
-				Detail:   fmt.Sprintf("Unable to read certificate file located at %s.", caPem),
-			})
-			return nil, diags
-		} else if ok := certPool.AppendCertsFromPEM(caCertPem); !ok {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "Unable to parse certificate.",
-				Detail:   "Unable to parse certificate. Check that the certificate is in a valid PEM format.",
-			})
-			return nil, diags
-		}
-		customTransport.TLSClientConfig = &tls.Config{RootCAs: certPool, MinVersion: tls.VersionTLS12}
+	customTransport, diags := newTransport(d, settings.insecure)
+	if diags.HasError() {
+		return nil, diags
 	}
 
 	client := &http.Client{
 		Transport: HeadersRoundTripper{r: customTransport, headers: headers},
 	}
 
+	if retryWaitMax < retryWaitMin {
+		return nil, utils.Diagf(
+			"Invalid retry settings",
+			"retry_wait_max (%s) must be greater than or equal to retry_wait_min (%s).",
+			retryWaitMax, retryWaitMin,
+		)
+	}
+	retry := awx.WithRetry(d.Get("max_retries").(int), retryWaitMin, retryWaitMax)
+	rateLimit := awx.WithRateLimit(d.Get("max_concurrent_requests").(int), d.Get("requests_per_second").(float64))
+	opts := []awx.RequesterOption{retry, rateLimit}
+	if d.Get("lookup_cache").(bool) {
+		opts = append(opts, awx.WithLookupCache())
+	}
+
+	var auth awx.Authenticator
+	switch {
+	case token != "":
+		auth = &awx.TokenAuth{Token: token}
+	case d.Get("auth_method").(string) == authMethodPersonalToken:
+		auth = &awx.PersonalTokenAuth{
+			Username:    username,
+			Password:    password,
+			Scope:       d.Get("personal_token_scope").(string),
+			Description: d.Get("personal_token_description").(string),
+		}
+	case d.Get("auth_method").(string) == authMethodSession:
+		auth = &awx.SessionAuth{
+			Username: username,
+			Password: password,
+		}
+	default:
+		auth = &awx.BasicAuth{Username: username, Password: password}
+	}
+
+	apiBasePath := d.Get("api_base_path").(string)
+	deferred := d.Get("skip_connectivity_check").(bool)
+	if unknown := unknownConnectionSettings(d); len(unknown) > 0 {
+		// The settings depend on resources not created yet: the plan must not need AWX.
+		tflog.Warn(ctx, "AWX connection settings are not known yet, deferring the connection", map[string]interface{}{
+			"unknown": unknown,
+		})
+		deferred = true
+	}
+
 	var c *awx.AWX
-	var err error
-	if token != "" {
-		c, err = awx.NewAWXToken(hostname, token, client)
+	if deferred {
+		// The client connects and detects the API base path on its first request.
+		if apiBasePath != "" {
+			opts = append(opts, awx.WithAPIBasePath(apiBasePath))
+		}
+		c = awx.NewAWXDeferred(hostname, auth, client, opts...)
 	} else {
-		c, err = awx.NewAWX(hostname, username, password, client)
+		if apiBasePath == "" {
+			detected, err := awx.DetectAPIBasePath(ctx, hostname, client)
+			if err != nil {
+				tflog.Warn(ctx, "Unable to detect the AWX API base path, using the default", map[string]interface{}{
+					"default": awx.DefaultAPIBasePath,
+					"error":   err,
+				})
+				detected = awx.DefaultAPIBasePath
+			}
+			apiBasePath = detected
+		}
+		opts = append(opts, awx.WithAPIBasePath(apiBasePath))
+		c, err = awx.NewAWXWithAuthenticator(ctx, hostname, auth, client, opts...)
+	}
+	if err == nil {
+		trackClient(ctx, p, c, token == "" && d.Get("auth_method").(string) != authMethodBasic)
 	}
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to create AWX client",
-			Detail:   "Unable to auth user against AWX API: check the hostname, username and password",
+			Detail:   fmt.Sprintf("Unable to auth user against AWX API: check the hostname, username and password: %s", err),
 		})
 		return nil, diags
 	}
 
+	if info := c.ServerInfo(); !info.Supports(awx.FeatureExecutionEnvironments) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Outdated AWX server",
+			Detail: fmt.Sprintf(
+				"The provider is connected to %s, older than %s. Resources relying on execution environments "+
+					"and recent prompts on launch will be rejected.",
+				info, awx.FeatureExecutionEnvironments.Requirement(),
+			),
+		})
+	}
+
 	return c, diags
 }
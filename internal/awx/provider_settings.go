@@ -0,0 +1,239 @@
+package awx
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Connection settings used when neither the provider block, the environment nor a config file set them.
+const (
+	defaultHostname = "http://localhost"
+	defaultUsername = "admin"
+	defaultPassword = "password"
+)
+
+// defaultConfigProfile is the section of the tower-cli config files read when no profile is set,
+// as tower-cli and the ansible.controller collection do.
+const defaultConfigProfile = "general"
+
+// Environment variables read, in this order, for the connection settings left out of the provider
+// block: the provider's own, then those of the ansible.controller collection and of awxkit, then
+// those of tower-cli.
+//
+//nolint:gochecknoglobals
+var (
+	hostnameEnvVars  = []string{"AWX_HOSTNAME", "CONTROLLER_HOST", "TOWER_HOST"}
+	usernameEnvVars  = []string{"AWX_USERNAME", "CONTROLLER_USERNAME", "TOWER_USERNAME"}
+	passwordEnvVars  = []string{"AWX_PASSWORD", "CONTROLLER_PASSWORD", "TOWER_PASSWORD"}
+	tokenEnvVars     = []string{"AWX_TOKEN", "CONTROLLER_OAUTH_TOKEN", "TOWER_OAUTH_TOKEN"}
+	verifySSLEnvVars = []string{"CONTROLLER_VERIFY_SSL", "TOWER_VERIFY_SSL"}
+)
+
+// connectionSettings are the settings used to reach and authenticate against AWX.
+type connectionSettings struct {
+	hostname string
+	username string
+	password string
+	token    string
+	insecure bool
+}
+
+// towerCLIConfigPaths returns the tower-cli config files, from the lowest to the highest precedence.
+func towerCLIConfigPaths() []string {
+	paths := []string{"/etc/tower/tower_cli.cfg"}
+	if home, err := os.UserHomeDir(); err == nil {
+		paths = append(paths, filepath.Join(home, ".tower_cli.cfg"))
+	}
+	return append(paths, ".tower_cli.cfg")
+}
+
+// resolveConnectionSettings completes the connection settings of the provider block, where the
+// hostname, username, password and token arguments already fall back to their environment variables,
+// with the profile of the tower-cli config files, then with the defaults.
+func resolveConnectionSettings(d *schema.ResourceData) (connectionSettings, error) {
+	settings := connectionSettings{
+		hostname: d.Get("hostname").(string),
+		username: d.Get("username").(string),
+		password: d.Get("password").(string),
+		token:    d.Get("token").(string),
+		insecure: d.Get("insecure").(bool),
+	}
+
+	profile, err := loadConfigProfile(d.Get("config_file").(string), d.Get("profile").(string))
+	if err != nil {
+		return settings, err
+	}
+
+	if settings.hostname == "" {
+		settings.hostname = profile["host"]
+	}
+	// A token of the config file must not take over credentials configured above it.
+	if settings.token == "" && settings.username == "" && settings.password == "" {
+		settings.token = profile["oauth_token"]
+	}
+	if settings.username == "" {
+		settings.username = profile["username"]
+	}
+	if settings.password == "" {
+		settings.password = profile["password"]
+	}
+
+	if !isConfigured(d, "insecure") {
+		verifySSL, source := firstEnv(verifySSLEnvVars), "environment"
+		if verifySSL == "" {
+			verifySSL, source = profile["verify_ssl"], "config file"
+		}
+		if verifySSL != "" {
+			verify, err := parseConfigBool(verifySSL)
+			if err != nil {
+				return settings, fmt.Errorf("invalid verify_ssl value %q in the %s: %w", verifySSL, source, err)
+			}
+			settings.insecure = !verify
+		}
+	}
+
+	if settings.hostname == "" {
+		settings.hostname = defaultHostname
+	}
+	if settings.token == "" && settings.username == "" {
+		settings.username = defaultUsername
+	}
+	if settings.token == "" && settings.password == "" {
+		settings.password = defaultPassword
+	}
+	return settings, nil
+}
+
+// connectionArguments are the provider arguments used to reach and authenticate against AWX.
+//
+//nolint:gochecknoglobals
+var connectionArguments = []string{"hostname", "username", "password", "token", "config_file", "profile", "api_base_path", "auth_method"}
+
+// unknownConnectionSettings returns the connection arguments whose values depend on resources not
+// created yet, which Terraform leaves unknown while planning.
+func unknownConnectionSettings(d *schema.ResourceData) []string {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() || !raw.Type().IsObjectType() {
+		return nil
+	}
+	var unknown []string
+	for _, name := range connectionArguments {
+		if raw.Type().HasAttribute(name) && !raw.GetAttr(name).IsKnown() {
+			unknown = append(unknown, name)
+		}
+	}
+	return unknown
+}
+
+// isConfigured reports whether the provider block sets the argument, even to its zero value.
+func isConfigured(d *schema.ResourceData, name string) bool {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.Type().IsObjectType() || !raw.Type().HasAttribute(name) {
+		return false
+	}
+	return !raw.GetAttr(name).IsNull()
+}
+
+// firstEnv returns the value of the first of the environment variables that is set.
+func firstEnv(names []string) string {
+	for _, name := range names {
+		if v := os.Getenv(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// loadConfigProfile reads the settings of profile from configFile, or from the tower-cli config
+// files when it is empty, the files read later taking precedence. The settings written before any
+// section belong to the default profile, as in the files of the ansible.controller collection.
+func loadConfigProfile(configFile, profile string) (map[string]string, error) {
+	paths := towerCLIConfigPaths()
+	if configFile != "" {
+		paths = []string{configFile}
+	}
+	if profile == "" {
+		profile = defaultConfigProfile
+	}
+
+	settings := make(map[string]string)
+	found := false
+	for _, path := range paths {
+		sections, err := readConfigFile(path)
+		if errors.Is(err, fs.ErrNotExist) && configFile == "" {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the config file %s: %w", path, err)
+		}
+		section, ok := sections[profile]
+		if !ok {
+			continue
+		}
+		found = true
+		for key, value := range section {
+			settings[key] = value
+		}
+	}
+	if !found && profile != defaultConfigProfile {
+		return nil, fmt.Errorf("profile %q not found in %s", profile, strings.Join(paths, ", "))
+	}
+	return settings, nil
+}
+
+// readConfigFile parses an INI config file into its sections. Keys are lowercased, and the
+// values written before any section belong to the default profile.
+func readConfigFile(path string) (map[string]map[string]string, error) {
+	f, err := os.Open(path) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close() //nolint:errcheck
+
+	sections := map[string]map[string]string{defaultConfigProfile: {}}
+	section := sections[defaultConfigProfile]
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if sections[name] == nil {
+				sections[name] = make(map[string]string)
+			}
+			section = sections[name]
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if i := strings.Index(line, ":"); i >= 0 && (!ok || i < len(key)) {
+			key, value, ok = line[:i], line[i+1:], true
+		}
+		if !ok {
+			return nil, fmt.Errorf("line %d: expecting a key = value setting", n)
+		}
+		section[strings.ToLower(strings.TrimSpace(key))] = strings.Trim(strings.TrimSpace(value), `"'`)
+	}
+	return sections, scanner.Err()
+}
+
+// parseConfigBool parses the boolean values of the config files and environment variables, which
+// also accept yes/no and on/off.
+func parseConfigBool(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "yes", "on":
+		return true, nil
+	case "no", "off":
+		return false, nil
+	}
+	return strconv.ParseBool(value)
+}
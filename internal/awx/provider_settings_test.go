@@ -0,0 +1,95 @@
+package awx
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Test_resolveConnectionSettings(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "tower_cli.cfg")
+	config := `host: https://awx.example.com
+oauth_token = file-token
+
+[staging]
+host = https://awx.staging.example.com
+username = operator
+password = "secret"
+verify_ssl = no
+`
+	if err := os.WriteFile(configFile, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range [][]string{hostnameEnvVars, usernameEnvVars, passwordEnvVars, tokenEnvVars, verifySSLEnvVars} {
+		for _, env := range name {
+			t.Setenv(env, "")
+		}
+	}
+
+	cases := []struct {
+		name   string
+		env    map[string]string
+		config map[string]interface{}
+		want   connectionSettings
+	}{
+		{
+			name:   "default profile",
+			config: map[string]interface{}{},
+			want:   connectionSettings{hostname: "https://awx.example.com", token: "file-token"},
+		},
+		{
+			name:   "named profile",
+			config: map[string]interface{}{"profile": "staging"},
+			want: connectionSettings{
+				hostname: "https://awx.staging.example.com",
+				username: "operator",
+				password: "secret",
+				insecure: true,
+			},
+		},
+		{
+			name:   "environment over config file",
+			env:    map[string]string{"CONTROLLER_HOST": "https://controller.example.com", "TOWER_USERNAME": "tower"},
+			config: map[string]interface{}{"profile": "staging"},
+			want: connectionSettings{
+				hostname: "https://controller.example.com",
+				username: "tower",
+				password: "secret",
+				insecure: true,
+			},
+		},
+		{
+			name: "arguments over environment",
+			env:  map[string]string{"AWX_HOSTNAME": "https://awx.internal", "CONTROLLER_VERIFY_SSL": "true"},
+			config: map[string]interface{}{
+				"hostname": "https://awx.argument",
+				"username": "admin",
+			},
+			want: connectionSettings{hostname: "https://awx.argument", username: "admin", password: defaultPassword},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+			tc.config["config_file"] = configFile
+			d := schema.TestResourceDataRaw(t, Provider().Schema, tc.config)
+
+			got, err := resolveConnectionSettings(d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("Expecting %+v but got %+v", tc.want, got)
+			}
+		})
+	}
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{"config_file": configFile, "profile": "production"})
+	if _, err := resolveConnectionSettings(d); err == nil {
+		t.Error("Expecting an error for a missing profile")
+	}
+}
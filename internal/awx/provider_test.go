@@ -0,0 +1,163 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/josh-silvas/terraform-provider-awx/internal/framework"
+	"github.com/josh-silvas/terraform-provider-awx/tools/goawx/awxtest"
+)
+
+// testAccProviderFactories serves the provider to the acceptance tests.
+//
+//nolint:gochecknoglobals
+var testAccProviderFactories = map[string]func() (*schema.Provider, error){
+	"awx": func() (*schema.Provider, error) {
+		return Provider(), nil
+	},
+}
+
+// testAccProtoV5ProviderFactories serves the provider muxed with the framework provider, to the
+// acceptance tests relying on the resources ported to internal/framework.
+//
+//nolint:gochecknoglobals
+var testAccProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+	"awx": func() (tfprotov5.ProviderServer, error) {
+		sdk := Provider()
+		mux, err := tf5muxserver.NewMuxServer(context.Background(),
+			sdk.GRPCProvider,
+			providerserver.NewProtocol5(framework.New(sdk)()),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return mux.ProviderServer(), nil
+	},
+}
+
+// testAccServer starts a fake AWX for the duration of the test, and points the provider at it.
+func testAccServer(t *testing.T) *awxtest.Server {
+	t.Helper()
+	srv := awxtest.NewServer()
+	t.Cleanup(srv.Close)
+	t.Setenv("AWX_HOSTNAME", srv.URL)
+	t.Setenv("AWX_USERNAME", srv.Username)
+	t.Setenv("AWX_PASSWORD", srv.Password)
+	t.Setenv("AWX_TOKEN", "")
+	return srv
+}
+
+// testAccCheckDestroy verifies that the resources of resourceType no longer exist in the collection of the fake AWX.
+func testAccCheckDestroy(srv *awxtest.Server, resourceType, collection string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			id, err := strconv.Atoi(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			if _, ok := srv.Object(collection, id); ok {
+				return fmt.Errorf("%s %d still exists", resourceType, id)
+			}
+		}
+		return nil
+	}
+}
+
+func TestProvider(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func Test_providerConfigure(t *testing.T) {
+	srv := awxtest.NewServer()
+	defer srv.Close()
+
+	for _, authMethod := range []string{authMethodBasic, authMethodPersonalToken, authMethodSession} {
+		t.Run(authMethod, func(t *testing.T) {
+			p := Provider()
+			diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+				"hostname":    srv.URL,
+				"username":    srv.Username,
+				"password":    srv.Password,
+				"auth_method": authMethod,
+			}))
+			if diags.HasError() {
+				t.Fatalf("Configure() = %v", diags)
+			}
+
+			Shutdown(context.Background())
+			if tokens := srv.Objects("tokens"); len(tokens) != 0 {
+				t.Errorf("Shutdown() left %d personal access tokens", len(tokens))
+			}
+		})
+	}
+}
+
+func Test_providerConfigure_invalidCredentials(t *testing.T) {
+	srv := awxtest.NewServer()
+	defer srv.Close()
+
+	diags := Provider().Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"hostname":    srv.URL,
+		"username":    srv.Username,
+		"password":    "wrong",
+		"auth_method": authMethodSession,
+	}))
+	if !diags.HasError() || !strings.Contains(diags[0].Detail, "no session cookie returned") {
+		t.Errorf("Expecting the AWX error in the diagnostics, got %+v", diags)
+	}
+}
+
+func Test_providerConfigure_reconfigured(t *testing.T) {
+	srv := awxtest.NewServer()
+	defer srv.Close()
+	defer Shutdown(context.Background())
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"hostname":    srv.URL,
+		"username":    srv.Username,
+		"password":    srv.Password,
+		"auth_method": authMethodPersonalToken,
+	})
+	p := Provider()
+	for i := 0; i < 3; i++ {
+		if diags := p.Configure(context.Background(), config); diags.HasError() {
+			t.Fatalf("Configure() = %v", diags)
+		}
+	}
+	// The token of the client configured before is revoked on every configuration.
+	if tokens := srv.Objects("tokens"); len(tokens) != 1 {
+		t.Errorf("Expecting the provider to hold 1 personal access token but got %d", len(tokens))
+	}
+	configuredClientsMu.Lock()
+	clients := len(configuredClients)
+	configuredClientsMu.Unlock()
+	if clients != 1 {
+		t.Errorf("Expecting 1 configured client to be tracked but got %d", clients)
+	}
+
+	// A configuration without server-side credentials releases the former ones as well.
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"hostname": srv.URL,
+		"username": srv.Username,
+		"password": srv.Password,
+	})); diags.HasError() {
+		t.Fatalf("Configure() = %v", diags)
+	}
+	if tokens := srv.Objects("tokens"); len(tokens) != 0 {
+		t.Errorf("Expecting the personal access token to be revoked but got %d tokens", len(tokens))
+	}
+}
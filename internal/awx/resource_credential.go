@@ -2,17 +2,43 @@ package awx
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
 	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
 const diagCredentialTitle = "Credential"
 
+// credentialAttributes maps the credential fields to their attributes.
+//
+//nolint:gochecknoglobals
+var credentialAttributes = utils.AttributeMap{
+	"organization":    "organization_id",
+	"credential_type": "credential_type_id",
+	"inputs":          "inputs",
+}
+
+// credentialInputAttributes maps the fields of a credential whose inputs are set from the attributes
+// of the same name, as the credential resources of the managed credential types do.
+func credentialInputAttributes(inputs ...string) utils.AttributeMap {
+	attributes := utils.AttributeMap{
+		"organization":    "organization_id",
+		"credential_type": "",
+	}
+	for _, input := range inputs {
+		attributes["inputs."+input] = input
+	}
+	return attributes
+}
+
 func resourceCredential() *schema.Resource {
 	return &schema.Resource{
 		Description:   "The `awx_credential` resource allows you to create and manage credentials in Ansible Tower.",
@@ -42,48 +68,77 @@ func resourceCredential() *schema.Resource {
 				Description: "Specify the type of credential you want to create. Refer to the Ansible Tower documentation for details on each type",
 			},
 			"inputs": {
-				Type:        schema.TypeMap,
-				Required:    true,
-				Sensitive:   true,
-				Description: "The inputs to be created with the credential.",
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Sensitive:    true,
+				AtLeastOneOf: []string{"inputs", "inputs_wo"},
+				Description:  "The inputs to be created with the credential.",
+			},
+			"inputs_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				ValidateFunc: validation.StringIsJSON,
+				Description: "Write-only inputs of the credential, as a JSON object merged over `inputs`, never stored in the state. " +
+					"Requires Terraform 1.11 or later.",
+			},
+			"inputs_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"inputs_wo"},
+				Description:  "Version of `inputs_wo`, to be changed for new values to be applied.",
+			},
+			"write_only_inputs": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the inputs set by `inputs_wo`, which are left out of `inputs`.",
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateIDOrNamedURL("credentials"),
 		},
 	}
 }
 
 func resourceCredentialCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	inputsMap := d.Get("inputs")
-
-	payload := map[string]interface{}{
-		"name":            d.Get("name").(string),
-		"description":     d.Get("description").(string),
-		"organization":    d.Get("organization_id").(int),
-		"credential_type": d.Get("credential_type_id").(int),
-		"inputs":          inputsMap,
+	payload := &awx.CredentialRequest{
+		Name:           awx.Ptr(d.Get("name").(string)),
+		Description:    awx.Ptr(d.Get("description").(string)),
+		Organization:   optionalID(d.Get("organization_id").(int)),
+		CredentialType: optionalID(d.Get("credential_type_id").(int)),
+	}
+	inputs, writeOnlyKeys, err := credentialInputs(d)
+	if err != nil {
+		return utils.DiagCreate(diagCredentialTitle, err, credentialAttributes)
 	}
+	payload.Inputs = inputs
 
 	client := m.(*awx.AWX)
-	cred, err := client.CredentialsService.CreateCredentials(payload, map[string]string{})
+	cred, err := client.CredentialsService.CreateCredentialsFromRequest(ctx, payload, map[string]string{})
 	if err != nil {
-		return utils.DiagCreate(diagCredentialTitle, err)
+		return utils.DiagCreate(diagCredentialTitle, err, credentialAttributes)
 	}
 
 	d.SetId(strconv.Itoa(cred.ID))
+	if err := d.Set("write_only_inputs", writeOnlyKeys); err != nil {
+		return diag.FromErr(err)
+	}
 	resourceCredentialRead(ctx, d, m)
 	return diag.Diagnostics{}
 }
 
-func resourceCredentialRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCredentialRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return utils.DiagFetch(diagCredentialTitle, d.Id(), err)
 	}
-	cred, err := client.CredentialsService.GetCredentialsByID(id, map[string]string{})
+	cred, err := client.CredentialsService.GetCredentialsByID(ctx, id, map[string]string{})
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		return utils.DiagFetch(diagCredentialTitle, d.Id(), err)
 	}
 
@@ -113,7 +168,7 @@ func resourceCredentialRead(_ context.Context, d *schema.ResourceData, m interfa
 		// Fetch the credential type to identify which input fields are secret.
 		// AWX returns "$encrypted$" for secret fields, which would cause
 		// perpetual drift. For those fields, preserve the current state value.
-		secretFields := getSecretFields(client, cred.CredentialTypeID)
+		secretFields := getSecretFields(ctx, client, cred.CredentialTypeID)
 		currentInputs, ok := d.GetOk("inputs")
 		var stateInputs map[string]interface{}
 		if ok {
@@ -121,6 +176,16 @@ func resourceCredentialRead(_ context.Context, d *schema.ResourceData, m interfa
 		}
 		inputs = sanitizeEncryptedInputs(inputs, stateInputs, secretFields)
 	}
+	// The write-only inputs must not land in the state.
+	if writeOnlyKeys, ok := d.Get("write_only_inputs").(*schema.Set); ok && writeOnlyKeys.Len() > 0 {
+		filtered := make(map[string]interface{}, len(inputs))
+		for k, v := range inputs {
+			if !writeOnlyKeys.Contains(k) {
+				filtered[k] = v
+			}
+		}
+		inputs = filtered
+	}
 
 	if err := d.Set("inputs", inputs); err != nil {
 		return diag.FromErr(err)
@@ -174,10 +239,13 @@ func sanitizeEncryptedInputs(apiInputs, stateInputs map[string]interface{}, secr
 // On any error (network, parsing), it returns nil so the caller can fall
 // back to sanitizing all "$encrypted$" values rather than skipping
 // sanitization entirely.
-func getSecretFields(client *awx.AWX, credentialTypeID int) map[string]struct{} {
-	credType, err := client.CredentialTypeService.GetCredentialTypeByID(credentialTypeID, map[string]string{})
+func getSecretFields(ctx context.Context, client *awx.AWX, credentialTypeID int) map[string]struct{} {
+	credType, err := client.CredentialTypeService.GetCredentialTypeByID(ctx, credentialTypeID, map[string]string{})
 	if err != nil {
-		fmt.Printf("[WARN] Unable to fetch credential type %d to determine secret fields: %v\n", credentialTypeID, err)
+		tflog.Warn(ctx, "Unable to fetch credential type to determine secret fields", map[string]interface{}{
+			"credential_type_id": credentialTypeID,
+			"error":              err,
+		})
 		return nil
 	}
 
@@ -230,41 +298,70 @@ func resourceCredentialUpdate(ctx context.Context, d *schema.ResourceData, m int
 		"description",
 		"organization_id",
 		"inputs",
+		"inputs_wo_version",
 	}
 
 	if d.HasChanges(keys...) {
-		var err error
-
-		inputsMap := d.Get("inputs")
-
 		id, err := strconv.Atoi(d.Id())
 		if err != nil {
-			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err)
+			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err, credentialAttributes)
+		}
+		update := &awx.CredentialRequest{
+			Name:           awx.Ptr(d.Get("name").(string)),
+			Description:    awx.Ptr(d.Get("description").(string)),
+			Organization:   optionalID(d.Get("organization_id").(int)),
+			CredentialType: optionalID(d.Get("credential_type_id").(int)),
 		}
-		update := map[string]interface{}{
-			"name":            d.Get("name").(string),
-			"description":     d.Get("description").(string),
-			"organization":    d.Get("organization_id").(int),
-			"credential_type": d.Get("credential_type_id"),
-			"inputs":          inputsMap,
+		inputs, writeOnlyKeys, err := credentialInputs(d)
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err, credentialAttributes)
 		}
+		update.Inputs = inputs
 
 		client := m.(*awx.AWX)
-		if _, err = client.CredentialsService.UpdateCredentialsByID(id, update, map[string]string{}); err != nil {
-			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err)
+		if _, err = client.CredentialsService.UpdateCredentialsByIDFromRequest(ctx, id, update, map[string]string{}); err != nil {
+			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err, credentialAttributes)
+		}
+		if err := d.Set("write_only_inputs", writeOnlyKeys); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	return resourceCredentialRead(ctx, d, m)
 }
 
-func resourceCredentialDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+// credentialInputs returns the inputs of the credential, with the write-only inputs merged over
+// inputs, and the names of the write-only inputs.
+func credentialInputs(d *schema.ResourceData) (map[string]interface{}, []string, error) {
+	inputs := make(map[string]interface{})
+	for k, v := range d.Get("inputs").(map[string]interface{}) {
+		inputs[k] = v
+	}
+
+	raw, ok := writeOnlyString(d, "inputs_wo")
+	if !ok {
+		return inputs, nil, nil
+	}
+	var writeOnly map[string]interface{}
+	if err := json.Unmarshal([]byte(raw), &writeOnly); err != nil {
+		return nil, nil, fmt.Errorf("inputs_wo must be a JSON object: %w", err)
+	}
+	keys := make([]string, 0, len(writeOnly))
+	for k, v := range writeOnly {
+		inputs[k] = v
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return inputs, keys, nil
+}
+
+func resourceCredentialDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return utils.DiagDelete(diagCredentialTitle, d.Id(), err)
 	}
 	client := m.(*awx.AWX)
-	if err := client.CredentialsService.DeleteCredentialsByID(id, map[string]string{}); err != nil {
+	if err := client.CredentialsService.DeleteCredentialsByID(ctx, id, map[string]string{}); err != nil {
 		return utils.DiagDelete(diagCredentialTitle, d.Id(), err)
 	}
 
@@ -12,8 +12,13 @@ import (
 
 const azureKeyVaultCredentialTypeName = "Microsoft Azure Key Vault"
 
+// credentialAzureKeyVaultAttributes maps the credential fields to their attributes.
+//
+//nolint:gochecknoglobals
+var credentialAzureKeyVaultAttributes = credentialInputAttributes("url", "client", "secret", "tenant", "cloud_name")
+
 func resourceCredentialAzureKeyVault() *schema.Resource {
-	return &schema.Resource{
+	return withWriteOnlySecrets(&schema.Resource{
 		Description:   "The `awx_credential_azure_key_vault` resource allows you to manage Azure Key Vault credentials in Ansible AWX.",
 		CreateContext: resourceCredentialAzureKeyVaultCreate,
 		ReadContext:   resourceCredentialAzureKeyVaultRead,
@@ -64,14 +69,14 @@ func resourceCredentialAzureKeyVault() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateIDOrNamedURL("credentials"),
 		},
-	}
+	}, "secret")
 }
 
 func resourceCredentialAzureKeyVaultCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
-	azureKVCredType, err := client.CredentialTypeService.GetCredentialTypeByName(azureKeyVaultCredentialTypeName, map[string]string{})
+	azureKVCredType, err := client.CredentialTypeService.GetCredentialTypeByName(ctx, azureKeyVaultCredentialTypeName, map[string]string{})
 	if err != nil {
 		return utils.DiagCreate("Azure Key Vault Credential Type", err)
 	}
@@ -79,7 +84,7 @@ func resourceCredentialAzureKeyVaultCreate(ctx context.Context, d *schema.Resour
 	inputs := map[string]interface{}{
 		"url":    d.Get("url").(string),
 		"client": d.Get("client").(string),
-		"secret": d.Get("secret").(string),
+		"secret": secretInput(d, "secret"),
 		"tenant": d.Get("tenant").(string),
 	}
 
@@ -87,17 +92,17 @@ func resourceCredentialAzureKeyVaultCreate(ctx context.Context, d *schema.Resour
 		inputs["cloud_name"] = cloudName.(string)
 	}
 
-	payload := map[string]interface{}{
-		"name":            d.Get("name").(string),
-		"description":     d.Get("description").(string),
-		"organization":    d.Get("organization_id").(int),
-		"credential_type": azureKVCredType.ID,
-		"inputs":          inputs,
+	payload := &awx.CredentialRequest{
+		Name:           awx.Ptr(d.Get("name").(string)),
+		Description:    awx.Ptr(d.Get("description").(string)),
+		Organization:   optionalID(d.Get("organization_id").(int)),
+		CredentialType: awx.NewNullable(azureKVCredType.ID),
+		Inputs:         inputs,
 	}
 
-	cred, err := client.CredentialsService.CreateCredentials(payload, map[string]string{})
+	cred, err := client.CredentialsService.CreateCredentialsFromRequest(ctx, payload, map[string]string{})
 	if err != nil {
-		return utils.DiagCreate("Azure Key Vault Credential", err)
+		return utils.DiagCreate("Azure Key Vault Credential", err, credentialAzureKeyVaultAttributes)
 	}
 
 	d.SetId(strconv.Itoa(cred.ID))
@@ -106,14 +111,17 @@ func resourceCredentialAzureKeyVaultCreate(ctx context.Context, d *schema.Resour
 	return diag.Diagnostics{}
 }
 
-func resourceCredentialAzureKeyVaultRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCredentialAzureKeyVaultRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return utils.DiagFetch("Azure Key Vault Credential", d.Id(), err)
 	}
-	cred, err := client.CredentialsService.GetCredentialsByID(id, map[string]string{})
+	cred, err := client.CredentialsService.GetCredentialsByID(ctx, id, map[string]string{})
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		return utils.DiagFetch("Azure Key Vault Credential", d.Id(), err)
 	}
 
@@ -154,6 +162,7 @@ func resourceCredentialAzureKeyVaultUpdate(ctx context.Context, d *schema.Resour
 		"url",
 		"client",
 		"secret",
+		"secret_wo_version",
 		"tenant",
 		"cloud_name",
 		"organization_id",
@@ -166,7 +175,7 @@ func resourceCredentialAzureKeyVaultUpdate(ctx context.Context, d *schema.Resour
 		}
 
 		client := m.(*awx.AWX)
-		azureKVCredType, err := client.CredentialTypeService.GetCredentialTypeByName(azureKeyVaultCredentialTypeName, map[string]string{})
+		azureKVCredType, err := client.CredentialTypeService.GetCredentialTypeByName(ctx, azureKeyVaultCredentialTypeName, map[string]string{})
 		if err != nil {
 			return utils.DiagUpdate("Azure Key Vault Credential Type", d.Id(), err)
 		}
@@ -174,7 +183,7 @@ func resourceCredentialAzureKeyVaultUpdate(ctx context.Context, d *schema.Resour
 		inputs := map[string]interface{}{
 			"url":    d.Get("url").(string),
 			"client": d.Get("client").(string),
-			"secret": d.Get("secret").(string),
+			"secret": secretInput(d, "secret"),
 			"tenant": d.Get("tenant").(string),
 		}
 
@@ -182,16 +191,16 @@ func resourceCredentialAzureKeyVaultUpdate(ctx context.Context, d *schema.Resour
 			inputs["cloud_name"] = cloudName.(string)
 		}
 
-		payload := map[string]interface{}{
-			"name":            d.Get("name").(string),
-			"description":     d.Get("description").(string),
-			"organization":    d.Get("organization_id").(int),
-			"credential_type": azureKVCredType.ID,
-			"inputs":          inputs,
+		payload := &awx.CredentialRequest{
+			Name:           awx.Ptr(d.Get("name").(string)),
+			Description:    awx.Ptr(d.Get("description").(string)),
+			Organization:   optionalID(d.Get("organization_id").(int)),
+			CredentialType: awx.NewNullable(azureKVCredType.ID),
+			Inputs:         inputs,
 		}
 
-		if _, err = client.CredentialsService.UpdateCredentialsByID(id, payload, map[string]string{}); err != nil {
-			return utils.DiagUpdate("Azure Key Vault Credential", d.Id(), err)
+		if _, err = client.CredentialsService.UpdateCredentialsByIDFromRequest(ctx, id, payload, map[string]string{}); err != nil {
+			return utils.DiagUpdate("Azure Key Vault Credential", d.Id(), err, credentialAzureKeyVaultAttributes)
 		}
 	}
 
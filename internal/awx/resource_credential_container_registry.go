@@ -8,12 +8,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
 const containerRegistryCredentialTypeName = "Container Registry" //nolint:gosec
 
+// credentialContainerRegistryAttributes maps the credential fields to their attributes.
+//
+//nolint:gochecknoglobals
+var credentialContainerRegistryAttributes = credentialInputAttributes("username", "password", "host", "verify_ssl")
+
 func resourceCredentialContainerRegistry() *schema.Resource {
-	return &schema.Resource{
+	return withWriteOnlySecrets(&schema.Resource{
 		Description:   "`awx_credential_container_registry` manages container registry credentials in AWX.",
 		CreateContext: resourceCredentialContainerRegistryCreate,
 		ReadContext:   resourceCredentialContainerRegistryRead,
@@ -59,9 +65,9 @@ func resourceCredentialContainerRegistry() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateIDOrNamedURL("credentials"),
 		},
-	}
+	}, "password")
 }
 
 func resourceCredentialContainerRegistryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -69,7 +75,7 @@ func resourceCredentialContainerRegistryCreate(ctx context.Context, d *schema.Re
 	var err error
 
 	client := m.(*awx.AWX)
-	containerRegistryCredType, err := client.CredentialTypeService.GetCredentialTypeByName(containerRegistryCredentialTypeName, map[string]string{})
+	containerRegistryCredType, err := client.CredentialTypeService.GetCredentialTypeByName(ctx, containerRegistryCredentialTypeName, map[string]string{})
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -79,27 +85,22 @@ func resourceCredentialContainerRegistryCreate(ctx context.Context, d *schema.Re
 		return diags
 	}
 
-	newCredential := map[string]interface{}{
-		"name":            d.Get("name").(string),
-		"description":     d.Get("description").(string),
-		"organization":    d.Get("organization_id").(int),
-		"credential_type": containerRegistryCredType.ID,
-		"inputs": map[string]interface{}{
+	newCredential := &awx.CredentialRequest{
+		Name:           awx.Ptr(d.Get("name").(string)),
+		Description:    awx.Ptr(d.Get("description").(string)),
+		Organization:   optionalID(d.Get("organization_id").(int)),
+		CredentialType: awx.NewNullable(containerRegistryCredType.ID),
+		Inputs: map[string]interface{}{
 			"username":   d.Get("username").(string),
-			"password":   d.Get("password").(string),
+			"password":   secretInput(d, "password"),
 			"host":       d.Get("host").(string),
 			"verify_ssl": d.Get("verify_ssl").(bool),
 		},
 	}
 
-	cred, err := client.CredentialsService.CreateCredentials(newCredential, map[string]string{})
+	cred, err := client.CredentialsService.CreateCredentialsFromRequest(ctx, newCredential, map[string]string{})
 	if err != nil {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Unable to create new credentials",
-			Detail:   fmt.Sprintf("Unable to create new credentials: %s", err.Error()),
-		})
-		return diags
+		return utils.DiagCreate(diagCredentialTitle, err, credentialContainerRegistryAttributes)
 	}
 
 	d.SetId(strconv.Itoa(cred.ID))
@@ -108,13 +109,16 @@ func resourceCredentialContainerRegistryCreate(ctx context.Context, d *schema.Re
 	return diags
 }
 
-func resourceCredentialContainerRegistryRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCredentialContainerRegistryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	client := m.(*awx.AWX)
 	id, _ := strconv.Atoi(d.Id())
-	cred, err := client.CredentialsService.GetCredentialsByID(id, map[string]string{})
+	cred, err := client.CredentialsService.GetCredentialsByID(ctx, id, map[string]string{})
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to fetch credentials",
@@ -156,6 +160,7 @@ func resourceCredentialContainerRegistryUpdate(ctx context.Context, d *schema.Re
 		"description",
 		"username",
 		"password",
+		"password_wo_version",
 		"host",
 		"verify_ssl",
 	}
@@ -165,7 +170,7 @@ func resourceCredentialContainerRegistryUpdate(ctx context.Context, d *schema.Re
 	if d.HasChanges(keys...) {
 		var err error
 
-		containerRegistryCredType, err := client.CredentialTypeService.GetCredentialTypeByName(containerRegistryCredentialTypeName, map[string]string{})
+		containerRegistryCredType, err := client.CredentialTypeService.GetCredentialTypeByName(ctx, containerRegistryCredentialTypeName, map[string]string{})
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -176,27 +181,22 @@ func resourceCredentialContainerRegistryUpdate(ctx context.Context, d *schema.Re
 		}
 
 		id, _ := strconv.Atoi(d.Id())
-		updatedCredential := map[string]interface{}{
-			"name":            d.Get("name").(string),
-			"description":     d.Get("description").(string),
-			"organization":    d.Get("organization_id").(int),
-			"credential_type": containerRegistryCredType.ID,
-			"inputs": map[string]interface{}{
+		updatedCredential := &awx.CredentialRequest{
+			Name:           awx.Ptr(d.Get("name").(string)),
+			Description:    awx.Ptr(d.Get("description").(string)),
+			Organization:   optionalID(d.Get("organization_id").(int)),
+			CredentialType: awx.NewNullable(containerRegistryCredType.ID),
+			Inputs: map[string]interface{}{
 				"username":   d.Get("username").(string),
-				"password":   d.Get("password").(string),
+				"password":   secretInput(d, "password"),
 				"host":       d.Get("host").(string),
 				"verify_ssl": d.Get("verify_ssl").(bool),
 			},
 		}
 
-		_, err = client.CredentialsService.UpdateCredentialsByID(id, updatedCredential, map[string]string{})
+		_, err = client.CredentialsService.UpdateCredentialsByIDFromRequest(ctx, id, updatedCredential, map[string]string{})
 		if err != nil {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "Unable to update existing credentials",
-				Detail:   fmt.Sprintf("Unable to update existing credentials with id %d: %s", id, err.Error()),
-			})
-			return diags
+			return utils.DiagUpdate(diagCredentialTitle, id, err, credentialContainerRegistryAttributes)
 		}
 	}
 
@@ -8,12 +8,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
 const galaxyCredentialTypeName = "Ansible Galaxy/Automation Hub API Token" //nolint:gosec
 
+// credentialGalaxyAttributes maps the credential fields to their attributes.
+//
+//nolint:gochecknoglobals
+var credentialGalaxyAttributes = credentialInputAttributes("url", "auth_url", "token")
+
 func resourceCredentialGalaxy() *schema.Resource {
-	return &schema.Resource{
+	return withWriteOnlySecrets(&schema.Resource{
 		Description:   "`awx_credential_galaxy` manages Ansible Galaxy/Automation Hub API Token credentials in AWX.",
 		CreateContext: resourceCredentialGalaxyCreate,
 		ReadContext:   resourceCredentialGalaxyRead,
@@ -53,16 +59,16 @@ func resourceCredentialGalaxy() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateIDOrNamedURL("credentials"),
 		},
-	}
+	}, "token")
 }
 
 func resourceCredentialGalaxyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	client := m.(*awx.AWX)
-	galaxyCredType, err := client.CredentialTypeService.GetCredentialTypeByName(galaxyCredentialTypeName, map[string]string{})
+	galaxyCredType, err := client.CredentialTypeService.GetCredentialTypeByName(ctx, galaxyCredentialTypeName, map[string]string{})
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -72,26 +78,21 @@ func resourceCredentialGalaxyCreate(ctx context.Context, d *schema.ResourceData,
 		return diags
 	}
 
-	newCredential := map[string]interface{}{
-		"name":            d.Get("name").(string),
-		"description":     d.Get("description").(string),
-		"organization":    d.Get("organization_id").(int),
-		"credential_type": galaxyCredType.ID,
-		"inputs": map[string]interface{}{
+	newCredential := &awx.CredentialRequest{
+		Name:           awx.Ptr(d.Get("name").(string)),
+		Description:    awx.Ptr(d.Get("description").(string)),
+		Organization:   optionalID(d.Get("organization_id").(int)),
+		CredentialType: awx.NewNullable(galaxyCredType.ID),
+		Inputs: map[string]interface{}{
 			"url":      d.Get("url").(string),
 			"auth_url": d.Get("auth_url").(string),
-			"token":    d.Get("token").(string),
+			"token":    secretInput(d, "token"),
 		},
 	}
 
-	cred, err := client.CredentialsService.CreateCredentials(newCredential, map[string]string{})
+	cred, err := client.CredentialsService.CreateCredentialsFromRequest(ctx, newCredential, map[string]string{})
 	if err != nil {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Unable to create new credentials",
-			Detail:   fmt.Sprintf("Unable to create new credentials: %s", err.Error()),
-		})
-		return diags
+		return utils.DiagCreate(diagCredentialTitle, err, credentialGalaxyAttributes)
 	}
 
 	d.SetId(strconv.Itoa(cred.ID))
@@ -100,13 +101,16 @@ func resourceCredentialGalaxyCreate(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
-func resourceCredentialGalaxyRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCredentialGalaxyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	client := m.(*awx.AWX)
 	id, _ := strconv.Atoi(d.Id())
-	cred, err := client.CredentialsService.GetCredentialsByID(id, map[string]string{})
+	cred, err := client.CredentialsService.GetCredentialsByID(ctx, id, map[string]string{})
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to fetch credentials",
@@ -146,6 +150,7 @@ func resourceCredentialGalaxyUpdate(ctx context.Context, d *schema.ResourceData,
 		"url",
 		"auth_url",
 		"token",
+		"token_wo_version",
 		"organization_id",
 		"team_id",
 		"owner_id",
@@ -153,7 +158,7 @@ func resourceCredentialGalaxyUpdate(ctx context.Context, d *schema.ResourceData,
 
 	if d.HasChanges(keys...) {
 		client := m.(*awx.AWX)
-		galaxyCredType, err := client.CredentialTypeService.GetCredentialTypeByName(galaxyCredentialTypeName, map[string]string{})
+		galaxyCredType, err := client.CredentialTypeService.GetCredentialTypeByName(ctx, galaxyCredentialTypeName, map[string]string{})
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -164,26 +169,21 @@ func resourceCredentialGalaxyUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 
 		id, _ := strconv.Atoi(d.Id())
-		updatedCredential := map[string]interface{}{
-			"name":            d.Get("name").(string),
-			"description":     d.Get("description").(string),
-			"organization":    d.Get("organization_id").(int),
-			"credential_type": galaxyCredType.ID,
-			"inputs": map[string]interface{}{
+		updatedCredential := &awx.CredentialRequest{
+			Name:           awx.Ptr(d.Get("name").(string)),
+			Description:    awx.Ptr(d.Get("description").(string)),
+			Organization:   optionalID(d.Get("organization_id").(int)),
+			CredentialType: awx.NewNullable(galaxyCredType.ID),
+			Inputs: map[string]interface{}{
 				"url":      d.Get("url").(string),
 				"auth_url": d.Get("auth_url").(string),
-				"token":    d.Get("token").(string),
+				"token":    secretInput(d, "token"),
 			},
 		}
 
-		_, err = client.CredentialsService.UpdateCredentialsByID(id, updatedCredential, map[string]string{})
+		_, err = client.CredentialsService.UpdateCredentialsByIDFromRequest(ctx, id, updatedCredential, map[string]string{})
 		if err != nil {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "Unable to update existing credentials",
-				Detail:   fmt.Sprintf("Unable to update existing credentials with id %d: %s", id, err.Error()),
-			})
-			return diags
+			return utils.DiagUpdate(diagCredentialTitle, id, err, credentialGalaxyAttributes)
 		}
 	}
 
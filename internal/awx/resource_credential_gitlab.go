@@ -8,12 +8,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
 const gitlabCredentialTypeName = "GitLab Personal Access Token"
 
+// credentialGitlabAttributes maps the credential fields to their attributes.
+//
+//nolint:gochecknoglobals
+var credentialGitlabAttributes = credentialInputAttributes("token")
+
 func resourceCredentialGitlab() *schema.Resource {
-	return &schema.Resource{
+	return withWriteOnlySecrets(&schema.Resource{
 		Description:   "`awx_credential_gitlab` manages GitLab Personal Access Token credentials in AWX.",
 		CreateContext: resourceCredentialGitlabCreate,
 		ReadContext:   resourceCredentialGitlabRead,
@@ -43,16 +49,16 @@ func resourceCredentialGitlab() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateIDOrNamedURL("credentials"),
 		},
-	}
+	}, "token")
 }
 
 func resourceCredentialGitlabCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	client := m.(*awx.AWX)
-	gitlabCredType, err := client.CredentialTypeService.GetCredentialTypeByName(gitlabCredentialTypeName, map[string]string{})
+	gitlabCredType, err := client.CredentialTypeService.GetCredentialTypeByName(ctx, gitlabCredentialTypeName, map[string]string{})
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -62,24 +68,19 @@ func resourceCredentialGitlabCreate(ctx context.Context, d *schema.ResourceData,
 		return diags
 	}
 
-	newCredential := map[string]interface{}{
-		"name":            d.Get("name").(string),
-		"description":     d.Get("description").(string),
-		"organization":    d.Get("organization_id").(int),
-		"credential_type": gitlabCredType.ID,
-		"inputs": map[string]interface{}{
-			"token": d.Get("token").(string),
+	newCredential := &awx.CredentialRequest{
+		Name:           awx.Ptr(d.Get("name").(string)),
+		Description:    awx.Ptr(d.Get("description").(string)),
+		Organization:   optionalID(d.Get("organization_id").(int)),
+		CredentialType: awx.NewNullable(gitlabCredType.ID),
+		Inputs: map[string]interface{}{
+			"token": secretInput(d, "token"),
 		},
 	}
 
-	cred, err := client.CredentialsService.CreateCredentials(newCredential, map[string]string{})
+	cred, err := client.CredentialsService.CreateCredentialsFromRequest(ctx, newCredential, map[string]string{})
 	if err != nil {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Unable to create new credentials",
-			Detail:   fmt.Sprintf("Unable to create new credentials: %s", err.Error()),
-		})
-		return diags
+		return utils.DiagCreate(diagCredentialTitle, err, credentialGitlabAttributes)
 	}
 
 	d.SetId(strconv.Itoa(cred.ID))
@@ -88,13 +89,16 @@ func resourceCredentialGitlabCreate(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
-func resourceCredentialGitlabRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCredentialGitlabRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	client := m.(*awx.AWX)
 	id, _ := strconv.Atoi(d.Id())
-	cred, err := client.CredentialsService.GetCredentialsByID(id, map[string]string{})
+	cred, err := client.CredentialsService.GetCredentialsByID(ctx, id, map[string]string{})
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to fetch credentials",
@@ -126,12 +130,13 @@ func resourceCredentialGitlabUpdate(ctx context.Context, d *schema.ResourceData,
 		"name",
 		"description",
 		"token",
+		"token_wo_version",
 		"organization_id",
 	}
 
 	if d.HasChanges(keys...) {
 		client := m.(*awx.AWX)
-		gitlabCredType, err := client.CredentialTypeService.GetCredentialTypeByName(gitlabCredentialTypeName, map[string]string{})
+		gitlabCredType, err := client.CredentialTypeService.GetCredentialTypeByName(ctx, gitlabCredentialTypeName, map[string]string{})
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -142,24 +147,19 @@ func resourceCredentialGitlabUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 
 		id, _ := strconv.Atoi(d.Id())
-		updatedCredential := map[string]interface{}{
-			"name":            d.Get("name").(string),
-			"description":     d.Get("description").(string),
-			"organization":    d.Get("organization_id").(int),
-			"credential_type": gitlabCredType.ID,
-			"inputs": map[string]interface{}{
-				"token": d.Get("token").(string),
+		updatedCredential := &awx.CredentialRequest{
+			Name:           awx.Ptr(d.Get("name").(string)),
+			Description:    awx.Ptr(d.Get("description").(string)),
+			Organization:   optionalID(d.Get("organization_id").(int)),
+			CredentialType: awx.NewNullable(gitlabCredType.ID),
+			Inputs: map[string]interface{}{
+				"token": secretInput(d, "token"),
 			},
 		}
 
-		_, err = client.CredentialsService.UpdateCredentialsByID(id, updatedCredential, map[string]string{})
+		_, err = client.CredentialsService.UpdateCredentialsByIDFromRequest(ctx, id, updatedCredential, map[string]string{})
 		if err != nil {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "Unable to update existing credentials",
-				Detail:   fmt.Sprintf("Unable to update existing credentials with id %d: %s", id, err.Error()),
-			})
-			return diags
+			return utils.DiagUpdate(diagCredentialTitle, id, err, credentialGitlabAttributes)
 		}
 	}
 
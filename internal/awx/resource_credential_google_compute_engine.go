@@ -8,12 +8,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
 const gceCredentialTypeName = "Google Compute Engine" //nolint:gosec
 
+// credentialGoogleComputeEngineAttributes maps the credential fields to their attributes.
+//
+//nolint:gochecknoglobals
+var credentialGoogleComputeEngineAttributes = credentialInputAttributes("username", "project", "ssh_key_data")
+
 func resourceCredentialGoogleComputeEngine() *schema.Resource {
-	return &schema.Resource{
+	return withWriteOnlySecrets(&schema.Resource{
 		Description:   "`awx_credential_google_compute_engine` manages Google Compute Engine credentials in AWX.",
 		CreateContext: resourceCredentialGoogleComputeEngineCreate,
 		ReadContext:   resourceCredentialGoogleComputeEngineRead,
@@ -53,9 +59,9 @@ func resourceCredentialGoogleComputeEngine() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateIDOrNamedURL("credentials"),
 		},
-	}
+	}, "ssh_key_data")
 }
 
 func resourceCredentialGoogleComputeEngineCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -63,7 +69,7 @@ func resourceCredentialGoogleComputeEngineCreate(ctx context.Context, d *schema.
 	var err error
 
 	client := m.(*awx.AWX)
-	gceCredType, err := client.CredentialTypeService.GetCredentialTypeByName(gceCredentialTypeName, map[string]string{})
+	gceCredType, err := client.CredentialTypeService.GetCredentialTypeByName(ctx, gceCredentialTypeName, map[string]string{})
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -73,26 +79,21 @@ func resourceCredentialGoogleComputeEngineCreate(ctx context.Context, d *schema.
 		return diags
 	}
 
-	newCredential := map[string]interface{}{
-		"name":            d.Get("name").(string),
-		"description":     d.Get("description").(string),
-		"organization":    d.Get("organization_id").(int),
-		"credential_type": gceCredType.ID,
-		"inputs": map[string]interface{}{
+	newCredential := &awx.CredentialRequest{
+		Name:           awx.Ptr(d.Get("name").(string)),
+		Description:    awx.Ptr(d.Get("description").(string)),
+		Organization:   optionalID(d.Get("organization_id").(int)),
+		CredentialType: awx.NewNullable(gceCredType.ID),
+		Inputs: map[string]interface{}{
 			"username":     d.Get("username").(string),
 			"project":      d.Get("project").(string),
-			"ssh_key_data": d.Get("ssh_key_data").(string),
+			"ssh_key_data": secretInput(d, "ssh_key_data"),
 		},
 	}
 
-	cred, err := client.CredentialsService.CreateCredentials(newCredential, map[string]string{})
+	cred, err := client.CredentialsService.CreateCredentialsFromRequest(ctx, newCredential, map[string]string{})
 	if err != nil {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Unable to create new credentials",
-			Detail:   fmt.Sprintf("Unable to create new credentials: %s", err.Error()),
-		})
-		return diags
+		return utils.DiagCreate(diagCredentialTitle, err, credentialGoogleComputeEngineAttributes)
 	}
 
 	d.SetId(strconv.Itoa(cred.ID))
@@ -101,13 +102,16 @@ func resourceCredentialGoogleComputeEngineCreate(ctx context.Context, d *schema.
 	return diags
 }
 
-func resourceCredentialGoogleComputeEngineRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCredentialGoogleComputeEngineRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	client := m.(*awx.AWX)
 	id, _ := strconv.Atoi(d.Id())
-	cred, err := client.CredentialsService.GetCredentialsByID(id, map[string]string{})
+	cred, err := client.CredentialsService.GetCredentialsByID(ctx, id, map[string]string{})
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to fetch credentials",
@@ -144,6 +148,7 @@ func resourceCredentialGoogleComputeEngineUpdate(ctx context.Context, d *schema.
 		"username",
 		"project",
 		"ssh_key_data",
+		"ssh_key_data_wo_version",
 	}
 
 	client := m.(*awx.AWX)
@@ -151,7 +156,7 @@ func resourceCredentialGoogleComputeEngineUpdate(ctx context.Context, d *schema.
 	if d.HasChanges(keys...) {
 		var err error
 
-		gceCredType, err := client.CredentialTypeService.GetCredentialTypeByName(gceCredentialTypeName, map[string]string{})
+		gceCredType, err := client.CredentialTypeService.GetCredentialTypeByName(ctx, gceCredentialTypeName, map[string]string{})
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -162,26 +167,21 @@ func resourceCredentialGoogleComputeEngineUpdate(ctx context.Context, d *schema.
 		}
 
 		id, _ := strconv.Atoi(d.Id())
-		updatedCredential := map[string]interface{}{
-			"name":            d.Get("name").(string),
-			"description":     d.Get("description").(string),
-			"organization":    d.Get("organization_id").(int),
-			"credential_type": gceCredType.ID,
-			"inputs": map[string]interface{}{
+		updatedCredential := &awx.CredentialRequest{
+			Name:           awx.Ptr(d.Get("name").(string)),
+			Description:    awx.Ptr(d.Get("description").(string)),
+			Organization:   optionalID(d.Get("organization_id").(int)),
+			CredentialType: awx.NewNullable(gceCredType.ID),
+			Inputs: map[string]interface{}{
 				"username":     d.Get("username").(string),
 				"project":      d.Get("project").(string),
-				"ssh_key_data": d.Get("ssh_key_data").(string),
+				"ssh_key_data": secretInput(d, "ssh_key_data"),
 			},
 		}
 
-		_, err = client.CredentialsService.UpdateCredentialsByID(id, updatedCredential, map[string]string{})
+		_, err = client.CredentialsService.UpdateCredentialsByIDFromRequest(ctx, id, updatedCredential, map[string]string{})
 		if err != nil {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "Unable to update existing credentials",
-				Detail:   fmt.Sprintf("Unable to update existing credentials with id %d: %s", id, err.Error()),
-			})
-			return diags
+			return utils.DiagUpdate(diagCredentialTitle, id, err, credentialGoogleComputeEngineAttributes)
 		}
 	}
 
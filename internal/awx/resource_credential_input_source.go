@@ -8,8 +8,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
+const diagCredentialInputSourceTitle = "Credential Input Source"
+
+// credentialInputSourceAttributes maps the credential input source fields to their attributes.
+//
+//nolint:gochecknoglobals
+var credentialInputSourceAttributes = utils.AttributeMap{
+	"target_credential": "target",
+	"source_credential": "source",
+	"metadata":          "metadata",
+}
+
 func resourceCredentialInputSource() *schema.Resource {
 	return &schema.Resource{
 		Description:   "Resource `credential_input_source` manages the input source for a credential.",
@@ -57,23 +69,18 @@ func resourceCredentialInputSourceCreate(ctx context.Context, d *schema.Resource
 	var diags diag.Diagnostics
 	var err error
 
-	newSourceInput := map[string]interface{}{
-		"description":       d.Get("description").(string),
-		"input_field_name":  d.Get("input_field_name").(string),
-		"target_credential": d.Get("target").(int),
-		"source_credential": d.Get("source").(int),
-		"metadata":          d.Get("metadata").(map[string]interface{}),
+	newSourceInput := &awx.CredentialInputSourceRequest{
+		Description:      awx.Ptr(d.Get("description").(string)),
+		InputFieldName:   awx.Ptr(d.Get("input_field_name").(string)),
+		TargetCredential: optionalID(d.Get("target").(int)),
+		SourceCredential: optionalID(d.Get("source").(int)),
+		Metadata:         d.Get("metadata").(map[string]interface{}),
 	}
 
 	client := m.(*awx.AWX)
-	cred, err := client.CredentialInputSourceService.CreateCredentialInputSource(newSourceInput, map[string]string{})
+	cred, err := client.CredentialInputSourceService.CreateCredentialInputSourceFromRequest(ctx, newSourceInput, map[string]string{})
 	if err != nil {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Unable to create new credentials",
-			Detail:   fmt.Sprintf("Unable to create new credentials: %s", err.Error()),
-		})
-		return diags
+		return utils.DiagCreate(diagCredentialInputSourceTitle, err, credentialInputSourceAttributes)
 	}
 
 	d.SetId(strconv.Itoa(cred.ID))
@@ -82,13 +89,16 @@ func resourceCredentialInputSourceCreate(ctx context.Context, d *schema.Resource
 	return diags
 }
 
-func resourceCredentialInputSourceRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCredentialInputSourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	client := m.(*awx.AWX)
 	id, _ := strconv.Atoi(d.Id())
-	inputSource, err := client.CredentialInputSourceService.GetCredentialInputSourceByID(id, map[string]string{})
+	inputSource, err := client.CredentialInputSourceService.GetCredentialInputSourceByID(ctx, id, map[string]string{})
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to fetch credentials",
@@ -117,8 +127,6 @@ func resourceCredentialInputSourceRead(_ context.Context, d *schema.ResourceData
 }
 
 func resourceCredentialInputSourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-
 	keys := []string{
 		"description",
 		"input_field_name",
@@ -131,35 +139,30 @@ func resourceCredentialInputSourceUpdate(ctx context.Context, d *schema.Resource
 		var err error
 
 		id, _ := strconv.Atoi(d.Id())
-		updatedSourceInput := map[string]interface{}{
-			"description":       d.Get("description").(string),
-			"input_field_name":  d.Get("input_field_name").(string),
-			"target_credential": d.Get("target").(int),
-			"source_credential": d.Get("source").(int),
-			"metadata":          d.Get("metadata").(map[string]interface{}),
+		updatedSourceInput := &awx.CredentialInputSourceRequest{
+			Description:      awx.Ptr(d.Get("description").(string)),
+			InputFieldName:   awx.Ptr(d.Get("input_field_name").(string)),
+			TargetCredential: optionalID(d.Get("target").(int)),
+			SourceCredential: optionalID(d.Get("source").(int)),
+			Metadata:         d.Get("metadata").(map[string]interface{}),
 		}
 
 		client := m.(*awx.AWX)
-		_, err = client.CredentialInputSourceService.UpdateCredentialInputSourceByID(id, updatedSourceInput, map[string]string{})
+		_, err = client.CredentialInputSourceService.UpdateCredentialInputSourceByIDFromRequest(ctx, id, updatedSourceInput, map[string]string{})
 		if err != nil {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "Unable to update existing credentials",
-				Detail:   fmt.Sprintf("Unable to update existing credentials with id %d: %s", id, err.Error()),
-			})
-			return diags
+			return utils.DiagUpdate(diagCredentialInputSourceTitle, id, err, credentialInputSourceAttributes)
 		}
 	}
 
 	return resourceCredentialInputSourceRead(ctx, d, m)
 }
 
-func resourceCredentialInputSourceDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCredentialInputSourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	id, _ := strconv.Atoi(d.Id())
 	client := m.(*awx.AWX)
-	err := client.CredentialInputSourceService.DeleteCredentialInputSourceByID(id, map[string]string{})
+	err := client.CredentialInputSourceService.DeleteCredentialInputSourceByID(ctx, id, map[string]string{})
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
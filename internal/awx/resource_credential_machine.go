@@ -8,13 +8,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
 const machineCredentialTypeName = "Machine"
 
+// credentialMachineAttributes maps the credential fields to their attributes.
+//
+//nolint:gochecknoglobals
+var credentialMachineAttributes = credentialInputAttributes("username", "password", "ssh_key_data", "ssh_public_key_data", "ssh_key_unlock", "become_method", "become_username", "become_password")
+
 //nolint:funlen
 func resourceCredentialMachine() *schema.Resource {
-	return &schema.Resource{
+	return withWriteOnlySecrets(&schema.Resource{
 		Description:   "The `awx_credential_machine` resource allows creation and management of machine credentials within an AWX instance.",
 		CreateContext: resourceCredentialMachineCreate,
 		ReadContext:   resourceCredentialMachineRead,
@@ -82,16 +88,16 @@ func resourceCredentialMachine() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateIDOrNamedURL("credentials"),
 		},
-	}
+	}, "password", "ssh_key_data", "ssh_key_unlock", "become_password")
 }
 
 func resourceCredentialMachineCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	client := m.(*awx.AWX)
-	machineCredType, err := client.CredentialTypeService.GetCredentialTypeByName(machineCredentialTypeName, map[string]string{})
+	machineCredType, err := client.CredentialTypeService.GetCredentialTypeByName(ctx, machineCredentialTypeName, map[string]string{})
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -101,31 +107,26 @@ func resourceCredentialMachineCreate(ctx context.Context, d *schema.ResourceData
 		return diags
 	}
 
-	newCredential := map[string]interface{}{
-		"name":            d.Get("name").(string),
-		"description":     d.Get("description").(string),
-		"organization":    d.Get("organization_id").(int),
-		"credential_type": machineCredType.ID,
-		"inputs": map[string]interface{}{
+	newCredential := &awx.CredentialRequest{
+		Name:           awx.Ptr(d.Get("name").(string)),
+		Description:    awx.Ptr(d.Get("description").(string)),
+		Organization:   optionalID(d.Get("organization_id").(int)),
+		CredentialType: awx.NewNullable(machineCredType.ID),
+		Inputs: map[string]interface{}{
 			"username":            d.Get("username").(string),
-			"password":            d.Get("password").(string),
-			"ssh_key_data":        d.Get("ssh_key_data").(string),
+			"password":            secretInput(d, "password"),
+			"ssh_key_data":        secretInput(d, "ssh_key_data"),
 			"ssh_public_key_data": d.Get("ssh_public_key_data").(string),
-			"ssh_key_unlock":      d.Get("ssh_key_unlock").(string),
+			"ssh_key_unlock":      secretInput(d, "ssh_key_unlock"),
 			"become_method":       d.Get("become_method").(string),
 			"become_username":     d.Get("become_username").(string),
-			"become_password":     d.Get("become_password").(string),
+			"become_password":     secretInput(d, "become_password"),
 		},
 	}
 
-	cred, err := client.CredentialsService.CreateCredentials(newCredential, map[string]string{})
+	cred, err := client.CredentialsService.CreateCredentialsFromRequest(ctx, newCredential, map[string]string{})
 	if err != nil {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Unable to create new credentials",
-			Detail:   fmt.Sprintf("Unable to create new credentials: %s", err.Error()),
-		})
-		return diags
+		return utils.DiagCreate(diagCredentialTitle, err, credentialMachineAttributes)
 	}
 
 	d.SetId(strconv.Itoa(cred.ID))
@@ -134,13 +135,16 @@ func resourceCredentialMachineCreate(ctx context.Context, d *schema.ResourceData
 	return diags
 }
 
-func resourceCredentialMachineRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCredentialMachineRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	client := m.(*awx.AWX)
 	id, _ := strconv.Atoi(d.Id())
-	cred, err := client.CredentialsService.GetCredentialsByID(id, map[string]string{})
+	cred, err := client.CredentialsService.GetCredentialsByID(ctx, id, map[string]string{})
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to fetch credentials",
@@ -194,12 +198,16 @@ func resourceCredentialMachineUpdate(ctx context.Context, d *schema.ResourceData
 		"description",
 		"username",
 		"password",
+		"password_wo_version",
 		"ssh_key_data",
+		"ssh_key_data_wo_version",
 		"ssh_public_key_data",
 		"ssh_key_unlock",
+		"ssh_key_unlock_wo_version",
 		"become_method",
 		"become_username",
 		"become_password",
+		"become_password_wo_version",
 		"organization_id",
 		"team_id",
 		"owner_id",
@@ -207,7 +215,7 @@ func resourceCredentialMachineUpdate(ctx context.Context, d *schema.ResourceData
 
 	if d.HasChanges(keys...) {
 		client := m.(*awx.AWX)
-		machineCredType, err := client.CredentialTypeService.GetCredentialTypeByName(machineCredentialTypeName, map[string]string{})
+		machineCredType, err := client.CredentialTypeService.GetCredentialTypeByName(ctx, machineCredentialTypeName, map[string]string{})
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -218,31 +226,26 @@ func resourceCredentialMachineUpdate(ctx context.Context, d *schema.ResourceData
 		}
 
 		id, _ := strconv.Atoi(d.Id())
-		updatedCredential := map[string]interface{}{
-			"name":            d.Get("name").(string),
-			"description":     d.Get("description").(string),
-			"organization":    d.Get("organization_id").(int),
-			"credential_type": machineCredType.ID,
-			"inputs": map[string]interface{}{
+		updatedCredential := &awx.CredentialRequest{
+			Name:           awx.Ptr(d.Get("name").(string)),
+			Description:    awx.Ptr(d.Get("description").(string)),
+			Organization:   optionalID(d.Get("organization_id").(int)),
+			CredentialType: awx.NewNullable(machineCredType.ID),
+			Inputs: map[string]interface{}{
 				"username":            d.Get("username").(string),
-				"password":            d.Get("password").(string),
-				"ssh_key_data":        d.Get("ssh_key_data").(string),
+				"password":            secretInput(d, "password"),
+				"ssh_key_data":        secretInput(d, "ssh_key_data"),
 				"ssh_public_key_data": d.Get("ssh_public_key_data").(string),
-				"ssh_key_unlock":      d.Get("ssh_key_unlock").(string),
+				"ssh_key_unlock":      secretInput(d, "ssh_key_unlock"),
 				"become_method":       d.Get("become_method").(string),
 				"become_username":     d.Get("become_username").(string),
-				"become_password":     d.Get("become_password").(string),
+				"become_password":     secretInput(d, "become_password"),
 			},
 		}
 
-		_, err = client.CredentialsService.UpdateCredentialsByID(id, updatedCredential, map[string]string{})
+		_, err = client.CredentialsService.UpdateCredentialsByIDFromRequest(ctx, id, updatedCredential, map[string]string{})
 		if err != nil {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "Unable to update existing credentials",
-				Detail:   fmt.Sprintf("Unable to update existing credentials with id %d: %s", id, err.Error()),
-			})
-			return diags
+			return utils.DiagUpdate(diagCredentialTitle, id, err, credentialMachineAttributes)
 		}
 	}
 
@@ -8,12 +8,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
 const scmCredentialTypeName = "Source Control"
 
+// credentialSCMAttributes maps the credential fields to their attributes.
+//
+//nolint:gochecknoglobals
+var credentialSCMAttributes = credentialInputAttributes("username", "password", "ssh_key_data", "ssh_key_unlock")
+
 func resourceCredentialSCM() *schema.Resource {
-	return &schema.Resource{
+	return withWriteOnlySecrets(&schema.Resource{
 		Description:   "`awx_credential_scm` manages Source Control credentials in AWX.",
 		CreateContext: resourceCredentialSCMCreate,
 		ReadContext:   resourceCredentialSCMRead,
@@ -60,16 +66,16 @@ func resourceCredentialSCM() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateIDOrNamedURL("credentials"),
 		},
-	}
+	}, "password", "ssh_key_data", "ssh_key_unlock")
 }
 
 func resourceCredentialSCMCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	client := m.(*awx.AWX)
-	scmCredType, err := client.CredentialTypeService.GetCredentialTypeByName(scmCredentialTypeName, map[string]string{})
+	scmCredType, err := client.CredentialTypeService.GetCredentialTypeByName(ctx, scmCredentialTypeName, map[string]string{})
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -79,27 +85,22 @@ func resourceCredentialSCMCreate(ctx context.Context, d *schema.ResourceData, m
 		return diags
 	}
 
-	newCredential := map[string]interface{}{
-		"name":            d.Get("name").(string),
-		"description":     d.Get("description").(string),
-		"organization":    d.Get("organization_id").(int),
-		"credential_type": scmCredType.ID,
-		"inputs": map[string]interface{}{
+	newCredential := &awx.CredentialRequest{
+		Name:           awx.Ptr(d.Get("name").(string)),
+		Description:    awx.Ptr(d.Get("description").(string)),
+		Organization:   optionalID(d.Get("organization_id").(int)),
+		CredentialType: awx.NewNullable(scmCredType.ID),
+		Inputs: map[string]interface{}{
 			"username":       d.Get("username").(string),
-			"password":       d.Get("password").(string),
-			"ssh_key_data":   d.Get("ssh_key_data").(string),
-			"ssh_key_unlock": d.Get("ssh_key_unlock").(string),
+			"password":       secretInput(d, "password"),
+			"ssh_key_data":   secretInput(d, "ssh_key_data"),
+			"ssh_key_unlock": secretInput(d, "ssh_key_unlock"),
 		},
 	}
 
-	cred, err := client.CredentialsService.CreateCredentials(newCredential, map[string]string{})
+	cred, err := client.CredentialsService.CreateCredentialsFromRequest(ctx, newCredential, map[string]string{})
 	if err != nil {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Unable to create new credentials",
-			Detail:   fmt.Sprintf("Unable to create new credentials: %s", err.Error()),
-		})
-		return diags
+		return utils.DiagCreate(diagCredentialTitle, err, credentialSCMAttributes)
 	}
 
 	d.SetId(strconv.Itoa(cred.ID))
@@ -108,13 +109,16 @@ func resourceCredentialSCMCreate(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
-func resourceCredentialSCMRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCredentialSCMRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	client := m.(*awx.AWX)
 	id, _ := strconv.Atoi(d.Id())
-	cred, err := client.CredentialsService.GetCredentialsByID(id, map[string]string{})
+	cred, err := client.CredentialsService.GetCredentialsByID(ctx, id, map[string]string{})
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to fetch credentials",
@@ -156,14 +160,17 @@ func resourceCredentialSCMUpdate(ctx context.Context, d *schema.ResourceData, m
 		"description",
 		"username",
 		"password",
+		"password_wo_version",
 		"ssh_key_data",
+		"ssh_key_data_wo_version",
 		"ssh_key_unlock",
+		"ssh_key_unlock_wo_version",
 		"organization_id",
 	}
 
 	if d.HasChanges(keys...) {
 		client := m.(*awx.AWX)
-		scmCredType, err := client.CredentialTypeService.GetCredentialTypeByName(scmCredentialTypeName, map[string]string{})
+		scmCredType, err := client.CredentialTypeService.GetCredentialTypeByName(ctx, scmCredentialTypeName, map[string]string{})
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -174,27 +181,22 @@ func resourceCredentialSCMUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 
 		id, _ := strconv.Atoi(d.Id())
-		updatedCredential := map[string]interface{}{
-			"name":            d.Get("name").(string),
-			"description":     d.Get("description").(string),
-			"organization":    d.Get("organization_id").(int),
-			"credential_type": scmCredType.ID,
-			"inputs": map[string]interface{}{
+		updatedCredential := &awx.CredentialRequest{
+			Name:           awx.Ptr(d.Get("name").(string)),
+			Description:    awx.Ptr(d.Get("description").(string)),
+			Organization:   optionalID(d.Get("organization_id").(int)),
+			CredentialType: awx.NewNullable(scmCredType.ID),
+			Inputs: map[string]interface{}{
 				"username":       d.Get("username").(string),
-				"password":       d.Get("password").(string),
-				"ssh_key_data":   d.Get("ssh_key_data").(string),
-				"ssh_key_unlock": d.Get("ssh_key_unlock").(string),
+				"password":       secretInput(d, "password"),
+				"ssh_key_data":   secretInput(d, "ssh_key_data"),
+				"ssh_key_unlock": secretInput(d, "ssh_key_unlock"),
 			},
 		}
 
-		_, err = client.CredentialsService.UpdateCredentialsByID(id, updatedCredential, map[string]string{})
+		_, err = client.CredentialsService.UpdateCredentialsByIDFromRequest(ctx, id, updatedCredential, map[string]string{})
 		if err != nil {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "Unable to update existing credentials",
-				Detail:   fmt.Sprintf("Unable to update existing credentials with id %d: %s", id, err.Error()),
-			})
-			return diags
+			return utils.DiagUpdate(diagCredentialTitle, id, err, credentialSCMAttributes)
 		}
 	}
 
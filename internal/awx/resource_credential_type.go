@@ -47,7 +47,7 @@ func resourceCredentialType() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateIDOrNamedURL("credential_types"),
 		},
 	}
 }
@@ -65,16 +65,16 @@ func resourceCredentialTypeCreate(ctx context.Context, d *schema.ResourceData, m
 		return utils.DiagCreate("Credential Type", err)
 	}
 
-	newCredentialType := map[string]interface{}{
-		"name":        d.Get("name").(string),
-		"description": d.Get("description").(string),
-		"kind":        d.Get("kind").(string),
-		"inputs":      inputsMap,
-		"injectors":   injectorsMap,
+	newCredentialType := &awx.CredentialTypeRequest{
+		Name:        awx.Ptr(d.Get("name").(string)),
+		Description: awx.Ptr(d.Get("description").(string)),
+		Kind:        awx.Ptr(d.Get("kind").(string)),
+		Inputs:      inputsMap,
+		Injectors:   injectorsMap,
 	}
 
 	client := m.(*awx.AWX)
-	credType, err := client.CredentialTypeService.CreateCredentialType(newCredentialType, map[string]string{})
+	credType, err := client.CredentialTypeService.CreateCredentialTypeFromRequest(ctx, newCredentialType, map[string]string{})
 	if err != nil {
 		return utils.DiagCreate("Credential Type", err)
 	}
@@ -85,14 +85,17 @@ func resourceCredentialTypeCreate(ctx context.Context, d *schema.ResourceData, m
 	return diag.Diagnostics{}
 }
 
-func resourceCredentialTypeRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCredentialTypeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return utils.DiagFetch("Credential Type", id, err)
 	}
-	credType, err := client.CredentialTypeService.GetCredentialTypeByID(id, map[string]string{})
+	credType, err := client.CredentialTypeService.GetCredentialTypeByID(ctx, id, map[string]string{})
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		return utils.DiagFetch("Credential Type", id, err)
 	}
 
@@ -151,16 +154,16 @@ func resourceCredentialTypeUpdate(ctx context.Context, d *schema.ResourceData, m
 		if err != nil {
 			return utils.DiagUpdate("Credential Type", id, err)
 		}
-		payload := map[string]interface{}{
-			"name":        d.Get("name").(string),
-			"description": d.Get("description").(string),
-			"kind":        d.Get("kind").(string),
-			"inputs":      inputsMap,
-			"injectors":   injectorsMap,
+		payload := &awx.CredentialTypeRequest{
+			Name:        awx.Ptr(d.Get("name").(string)),
+			Description: awx.Ptr(d.Get("description").(string)),
+			Kind:        awx.Ptr(d.Get("kind").(string)),
+			Inputs:      inputsMap,
+			Injectors:   injectorsMap,
 		}
 
 		client := m.(*awx.AWX)
-		if _, err = client.CredentialTypeService.UpdateCredentialTypeByID(id, payload, map[string]string{}); err != nil {
+		if _, err = client.CredentialTypeService.UpdateCredentialTypeByIDFromRequest(ctx, id, payload, map[string]string{}); err != nil {
 			return utils.DiagUpdate("Credential Type", id, err)
 		}
 	}
@@ -168,13 +171,13 @@ func resourceCredentialTypeUpdate(ctx context.Context, d *schema.ResourceData, m
 	return resourceCredentialTypeRead(ctx, d, m)
 }
 
-func resourceCredentialTypeDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCredentialTypeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return utils.DiagDelete("Credential Type", id, err)
 	}
 	client := m.(*awx.AWX)
-	if err := client.CredentialTypeService.DeleteCredentialTypeByID(id, map[string]string{}); err != nil {
+	if err := client.CredentialTypeService.DeleteCredentialTypeByID(ctx, id, map[string]string{}); err != nil {
 		return utils.DiagDelete("Credential Type", id, err)
 	}
 	return diag.Diagnostics{}
@@ -8,12 +8,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
 const vaultCredentialTypeName = "Vault" //nolint:gosec
 
+// credentialVaultAttributes maps the credential fields to their attributes.
+//
+//nolint:gochecknoglobals
+var credentialVaultAttributes = credentialInputAttributes("vault_password", "vault_id")
+
 func resourceCredentialVault() *schema.Resource {
-	return &schema.Resource{
+	return withWriteOnlySecrets(&schema.Resource{
 		Description:   "`awx_credential_vault` manages vault credentials in AWX.",
 		CreateContext: resourceCredentialVaultCreate,
 		ReadContext:   resourceCredentialVaultRead,
@@ -48,9 +54,9 @@ func resourceCredentialVault() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateIDOrNamedURL("credentials"),
 		},
-	}
+	}, "vault_password")
 }
 
 func resourceCredentialVaultCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -58,7 +64,7 @@ func resourceCredentialVaultCreate(ctx context.Context, d *schema.ResourceData,
 	var err error
 
 	client := m.(*awx.AWX)
-	vaultCredType, err := client.CredentialTypeService.GetCredentialTypeByName(vaultCredentialTypeName, map[string]string{})
+	vaultCredType, err := client.CredentialTypeService.GetCredentialTypeByName(ctx, vaultCredentialTypeName, map[string]string{})
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -68,25 +74,20 @@ func resourceCredentialVaultCreate(ctx context.Context, d *schema.ResourceData,
 		return diags
 	}
 
-	newCredential := map[string]interface{}{
-		"name":            d.Get("name").(string),
-		"description":     d.Get("description").(string),
-		"organization":    d.Get("organization_id").(int),
-		"credential_type": vaultCredType.ID,
-		"inputs": map[string]interface{}{
-			"vault_password": d.Get("vault_password").(string),
+	newCredential := &awx.CredentialRequest{
+		Name:           awx.Ptr(d.Get("name").(string)),
+		Description:    awx.Ptr(d.Get("description").(string)),
+		Organization:   optionalID(d.Get("organization_id").(int)),
+		CredentialType: awx.NewNullable(vaultCredType.ID),
+		Inputs: map[string]interface{}{
+			"vault_password": secretInput(d, "vault_password"),
 			"vault_id":       d.Get("vault_id").(string),
 		},
 	}
 
-	cred, err := client.CredentialsService.CreateCredentials(newCredential, map[string]string{})
+	cred, err := client.CredentialsService.CreateCredentialsFromRequest(ctx, newCredential, map[string]string{})
 	if err != nil {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Unable to create new credentials",
-			Detail:   fmt.Sprintf("Unable to create new credentials: %s", err.Error()),
-		})
-		return diags
+		return utils.DiagCreate(diagCredentialTitle, err, credentialVaultAttributes)
 	}
 
 	d.SetId(strconv.Itoa(cred.ID))
@@ -95,13 +96,16 @@ func resourceCredentialVaultCreate(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
-func resourceCredentialVaultRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCredentialVaultRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	client := m.(*awx.AWX)
 	id, _ := strconv.Atoi(d.Id())
-	cred, err := client.CredentialsService.GetCredentialsByID(id, map[string]string{})
+	cred, err := client.CredentialsService.GetCredentialsByID(ctx, id, map[string]string{})
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to fetch credentials",
@@ -137,6 +141,7 @@ func resourceCredentialVaultUpdate(ctx context.Context, d *schema.ResourceData,
 		"description",
 		"organization_id",
 		"vault_password",
+		"vault_password_wo_version",
 		"vault_id",
 	}
 
@@ -145,7 +150,7 @@ func resourceCredentialVaultUpdate(ctx context.Context, d *schema.ResourceData,
 	if d.HasChanges(keys...) {
 		var err error
 
-		vaultCredType, err := client.CredentialTypeService.GetCredentialTypeByName(vaultCredentialTypeName, map[string]string{})
+		vaultCredType, err := client.CredentialTypeService.GetCredentialTypeByName(ctx, vaultCredentialTypeName, map[string]string{})
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -156,25 +161,20 @@ func resourceCredentialVaultUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 
 		id, _ := strconv.Atoi(d.Id())
-		updatedCredential := map[string]interface{}{
-			"name":            d.Get("name").(string),
-			"description":     d.Get("description").(string),
-			"organization":    d.Get("organization_id").(int),
-			"credential_type": vaultCredType.ID,
-			"inputs": map[string]interface{}{
-				"vault_password": d.Get("vault_password").(string),
+		updatedCredential := &awx.CredentialRequest{
+			Name:           awx.Ptr(d.Get("name").(string)),
+			Description:    awx.Ptr(d.Get("description").(string)),
+			Organization:   optionalID(d.Get("organization_id").(int)),
+			CredentialType: awx.NewNullable(vaultCredType.ID),
+			Inputs: map[string]interface{}{
+				"vault_password": secretInput(d, "vault_password"),
 				"vault_id":       d.Get("vault_id").(string),
 			},
 		}
 
-		_, err = client.CredentialsService.UpdateCredentialsByID(id, updatedCredential, map[string]string{})
+		_, err = client.CredentialsService.UpdateCredentialsByIDFromRequest(ctx, id, updatedCredential, map[string]string{})
 		if err != nil {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "Unable to update existing credentials",
-				Detail:   fmt.Sprintf("Unable to update existing credentials with id %d: %s", id, err.Error()),
-			})
-			return diags
+			return utils.DiagUpdate(diagCredentialTitle, id, err, credentialVaultAttributes)
 		}
 	}
 
@@ -2,10 +2,9 @@ package awx
 
 import (
 	"context"
-	"fmt"
-	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -61,30 +60,37 @@ func resourceExecutionEnvironment() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"", "always", "missing", "never"}, false),
 			},
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateIDOrNamedURL("execution_environments"),
+		},
 	}
 }
 
 func resourceExecutionEnvironmentsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
+	info, diags := connectedServerInfo(ctx, client)
+	if diags.HasError() {
+		return diags
+	}
+	if !info.Supports(awx.FeatureExecutionEnvironments) {
+		return utils.Diagf(
+			"Execution environments are not supported by the AWX server",
+			"Execution environments require %s, the provider is connected to %s.",
+			awx.FeatureExecutionEnvironments.Requirement(), info,
+		)
+	}
 	awxService := client.ExecutionEnvironmentsService
 
-	result, err := awxService.CreateExecutionEnvironment(map[string]interface{}{
-		"name":         d.Get("name").(string),
-		"image":        d.Get("image").(string),
-		"description":  d.Get("description").(string),
-		"organization": utils.AtoiDefault(d.Get("organization").(string), nil),
-		"credential":   utils.AtoiDefault(d.Get("credential").(string), nil),
-		"pull":         d.Get("pull").(string),
+	result, err := awxService.CreateExecutionEnvironmentFromRequest(ctx, &awx.ExecutionEnvironmentRequest{
+		Name:         awx.Ptr(d.Get("name").(string)),
+		Image:        awx.Ptr(d.Get("image").(string)),
+		Description:  awx.Ptr(d.Get("description").(string)),
+		Organization: optionalIDFromString(d.Get("organization").(string)),
+		Credential:   optionalIDFromString(d.Get("credential").(string)),
+		Pull:         awx.Ptr(d.Get("pull").(string)),
 	}, map[string]string{})
 	if err != nil {
-		log.Printf("Fail to Create ExecutionEnvironment %v", err)
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Unable to create ExecutionEnvironments",
-			Detail:   fmt.Sprintf("ExecutionEnvironments with name %s, failed to create %s", d.Get("name").(string), err),
-		})
-		return diags
+		return utils.DiagCreate(diagExecutionEnvironmentTitle, err)
 	}
 
 	d.SetId(strconv.Itoa(result.ID))
@@ -101,17 +107,17 @@ func resourceExecutionEnvironmentsUpdate(ctx context.Context, d *schema.Resource
 
 	params := make(map[string]string)
 
-	if _, err := client.ExecutionEnvironmentsService.GetExecutionEnvironmentByID(id, params); err != nil {
+	if _, err := client.ExecutionEnvironmentsService.GetExecutionEnvironmentByID(ctx, id, params); err != nil {
 		return utils.DiagNotFound(diagExecutionEnvironmentTitle, id, err)
 	}
 
-	if _, err := client.ExecutionEnvironmentsService.UpdateExecutionEnvironment(id, map[string]interface{}{
-		"name":         d.Get("name").(string),
-		"image":        d.Get("image").(string),
-		"description":  d.Get("description").(string),
-		"organization": utils.AtoiDefault(d.Get("organization").(string), nil),
-		"credential":   utils.AtoiDefault(d.Get("credential").(string), nil),
-		"pull":         d.Get("pull").(string),
+	if _, err := client.ExecutionEnvironmentsService.UpdateExecutionEnvironmentFromRequest(ctx, id, &awx.ExecutionEnvironmentRequest{
+		Name:         awx.Ptr(d.Get("name").(string)),
+		Image:        awx.Ptr(d.Get("image").(string)),
+		Description:  awx.Ptr(d.Get("description").(string)),
+		Organization: optionalIDFromString(d.Get("organization").(string)),
+		Credential:   optionalIDFromString(d.Get("credential").(string)),
+		Pull:         awx.Ptr(d.Get("pull").(string)),
 	}, map[string]string{}); err != nil {
 		return utils.DiagUpdate(diagExecutionEnvironmentTitle, id, err)
 	}
@@ -119,7 +125,7 @@ func resourceExecutionEnvironmentsUpdate(ctx context.Context, d *schema.Resource
 	return resourceExecutionEnvironmentsRead(ctx, d, m)
 }
 
-func resourceExecutionEnvironmentsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceExecutionEnvironmentsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 	awxService := client.ExecutionEnvironmentsService
@@ -128,47 +134,50 @@ func resourceExecutionEnvironmentsRead(_ context.Context, d *schema.ResourceData
 		return diags
 	}
 
-	res, err := awxService.GetExecutionEnvironmentByID(id, make(map[string]string))
+	res, err := awxService.GetExecutionEnvironmentByID(ctx, id, make(map[string]string))
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		return utils.DiagNotFound(diagExecutionEnvironmentTitle, id, err)
 
 	}
-	d = setExecutionEnvironmentsResourceData(d, res)
+	d = setExecutionEnvironmentsResourceData(ctx, d, res)
 	return nil
 }
 
-func resourceExecutionEnvironmentsDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceExecutionEnvironmentsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, diags := utils.StateIDToInt("Delete ExecutionEnvironment", d)
 	if diags.HasError() {
 		return diags
 	}
 
-	if _, err := client.ExecutionEnvironmentsService.DeleteExecutionEnvironment(id); err != nil {
+	if _, err := client.ExecutionEnvironmentsService.DeleteExecutionEnvironment(ctx, id); err != nil {
 		return utils.DiagDelete(diagExecutionEnvironmentTitle, id, err)
 	}
 	d.SetId("")
 	return diag.Diagnostics{}
 }
 
-func setExecutionEnvironmentsResourceData(d *schema.ResourceData, r *awx.ExecutionEnvironment) *schema.ResourceData {
+func setExecutionEnvironmentsResourceData(ctx context.Context, d *schema.ResourceData, r *awx.ExecutionEnvironment) *schema.ResourceData {
 	if err := d.Set("name", r.Name); err != nil {
-		fmt.Println("Error setting name", err)
+		tflog.Warn(ctx, "Error setting name", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("image", r.Image); err != nil {
-		fmt.Println("Error setting image", err)
+		tflog.Warn(ctx, "Error setting image", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("description", r.Description); err != nil {
-		fmt.Println("Error setting description", err)
+		tflog.Warn(ctx, "Error setting description", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("organization", r.Organization); err != nil {
-		fmt.Println("Error setting organization", err)
+		tflog.Warn(ctx, "Error setting organization", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("credential", r.Credential); err != nil {
-		fmt.Println("Error setting credential", err)
+		tflog.Warn(ctx, "Error setting credential", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("pull", r.Pull); err != nil {
-		fmt.Println("Error setting pull", err)
+		tflog.Warn(ctx, "Error setting pull", map[string]interface{}{"error": err})
 	}
 	d.SetId(strconv.Itoa(r.ID))
 	return d
@@ -2,9 +2,9 @@ package awx
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
@@ -13,6 +13,13 @@ import (
 
 const diagHostTitle = "Host"
 
+// hostAttributes maps the host fields to their attributes.
+//
+//nolint:gochecknoglobals
+var hostAttributes = utils.AttributeMap{
+	"inventory": "inventory_id",
+}
+
 func resourceHost() *schema.Resource {
 	return &schema.Resource{
 		Description:   "Resource Host",
@@ -75,7 +82,7 @@ func resourceHost() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: importStateIDOrNamedURL("hosts"),
 		},
 	}
 }
@@ -85,16 +92,16 @@ func resourceHostCreate(ctx context.Context, d *schema.ResourceData, m interface
 	client := m.(*awx.AWX)
 	awxService := client.HostService
 
-	result, err := awxService.CreateHost(map[string]interface{}{
-		"name":        d.Get("name").(string),
-		"description": d.Get("description").(string),
-		"inventory":   d.Get("inventory_id").(int),
-		"enabled":     d.Get("enabled").(bool),
-		"instance_id": d.Get("instance_id").(string),
-		"variables":   d.Get("variables").(string),
+	result, err := awxService.CreateHostFromRequest(ctx, &awx.HostRequest{
+		Name:        awx.Ptr(d.Get("name").(string)),
+		Description: awx.Ptr(d.Get("description").(string)),
+		Inventory:   optionalID(d.Get("inventory_id").(int)),
+		Enabled:     awx.Ptr(d.Get("enabled").(bool)),
+		InstanceID:  awx.Ptr(d.Get("instance_id").(string)),
+		Variables:   awx.Ptr(d.Get("variables").(string)),
 	}, map[string]string{})
 	if err != nil {
-		return utils.DiagCreate(diagHostTitle, err)
+		return utils.DiagCreate(diagHostTitle, err, hostAttributes)
 	}
 
 	hostID := result.ID
@@ -102,7 +109,7 @@ func resourceHostCreate(ctx context.Context, d *schema.ResourceData, m interface
 		rawGroups := d.Get("group_ids").(*schema.Set).List()
 		for _, v := range rawGroups {
 
-			if _, err := awxService.AssociateGroup(hostID, map[string]interface{}{
+			if _, err := awxService.AssociateGroup(ctx, hostID, map[string]interface{}{
 				"id": v.(int),
 			}, map[string]string{}); err != nil {
 				return utils.Diagf(diagHostTitle, "Assign Group Id %v to hostid %v fail, got  %s", v, hostID, err)
@@ -120,15 +127,15 @@ func resourceHostUpdate(ctx context.Context, d *schema.ResourceData, m interface
 		return diags
 	}
 
-	if _, err := client.HostService.UpdateHost(id, map[string]interface{}{
-		"name":        d.Get("name").(string),
-		"description": d.Get("description").(string),
-		"inventory":   d.Get("inventory_id").(int),
-		"enabled":     d.Get("enabled").(bool),
-		"instance_id": d.Get("instance_id").(string),
-		"variables":   d.Get("variables").(string),
+	if _, err := client.HostService.UpdateHostFromRequest(ctx, id, &awx.HostRequest{
+		Name:        awx.Ptr(d.Get("name").(string)),
+		Description: awx.Ptr(d.Get("description").(string)),
+		Inventory:   optionalID(d.Get("inventory_id").(int)),
+		Enabled:     awx.Ptr(d.Get("enabled").(bool)),
+		InstanceID:  awx.Ptr(d.Get("instance_id").(string)),
+		Variables:   awx.Ptr(d.Get("variables").(string)),
 	}, nil); err != nil {
-		return utils.DiagUpdate(diagHostTitle, id, err)
+		return utils.DiagUpdate(diagHostTitle, id, err, hostAttributes)
 	}
 
 	if d.HasChange("group_ids") {
@@ -138,7 +145,7 @@ func resourceHostUpdate(ctx context.Context, d *schema.ResourceData, m interface
 
 		for _, v := range newSet.Difference(oldSet).List() {
 			gid := v.(int)
-			if _, err := client.HostService.AssociateGroup(id, map[string]interface{}{
+			if _, err := client.HostService.AssociateGroup(ctx, id, map[string]interface{}{
 				"id": gid,
 			}, map[string]string{}); err != nil {
 				return utils.Diagf(diagHostTitle, "Associate Group Id %v to hostid %v fail, got  %s", gid, id, err)
@@ -147,7 +154,7 @@ func resourceHostUpdate(ctx context.Context, d *schema.ResourceData, m interface
 
 		for _, v := range oldSet.Difference(newSet).List() {
 			gid := v.(int)
-			if _, err := client.HostService.DisAssociateGroup(id, map[string]interface{}{
+			if _, err := client.HostService.DisAssociateGroup(ctx, id, map[string]interface{}{
 				"id": gid,
 			}, map[string]string{}); err != nil {
 				return utils.Diagf(diagHostTitle, "Disassociate Group Id %v from hostid %v fail, got  %s", gid, id, err)
@@ -157,20 +164,23 @@ func resourceHostUpdate(ctx context.Context, d *schema.ResourceData, m interface
 	return resourceHostRead(ctx, d, m)
 }
 
-func resourceHostRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceHostRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, diags := utils.StateIDToInt(diagHostTitle, d)
 	if diags.HasError() {
 		return diags
 	}
-	res, err := client.HostService.GetHostByID(id, make(map[string]string))
+	res, err := client.HostService.GetHostByID(ctx, id, make(map[string]string))
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		return utils.DiagNotFound(diagHostTitle, id, err)
 	}
-	d = setHostResourceData(d, res)
+	d = setHostResourceData(ctx, d, res)
 
 	// Fetch actual group memberships from AWX API
-	groups, err := client.HostService.ListHostGroups(id, make(map[string]string))
+	groups, err := client.HostService.ListHostGroups(ctx, id, make(map[string]string))
 	if err != nil {
 		return utils.Diagf(diagHostTitle, "Failed to list groups for host %v: %s", id, err)
 	}
@@ -184,38 +194,38 @@ func resourceHostRead(_ context.Context, d *schema.ResourceData, m interface{})
 	return nil
 }
 
-func resourceHostDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceHostDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, diags := utils.StateIDToInt(diagHostTitle, d)
 	if diags.HasError() {
 		return diags
 	}
 
-	if _, err := client.HostService.DeleteHost(id); err != nil {
+	if _, err := client.HostService.DeleteHost(ctx, id); err != nil {
 		return utils.DiagDelete(diagHostTitle, id, err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func setHostResourceData(d *schema.ResourceData, r *awx.Host) *schema.ResourceData {
+func setHostResourceData(ctx context.Context, d *schema.ResourceData, r *awx.Host) *schema.ResourceData {
 	if err := d.Set("name", r.Name); err != nil {
-		fmt.Println("Error setting name", err)
+		tflog.Warn(ctx, "Error setting name", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("description", r.Description); err != nil {
-		fmt.Println("Error setting description", err)
+		tflog.Warn(ctx, "Error setting description", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("inventory_id", r.Inventory); err != nil {
-		fmt.Println("Error setting inventory_id", err)
+		tflog.Warn(ctx, "Error setting inventory_id", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("enabled", r.Enabled); err != nil {
-		fmt.Println("Error setting enabled", err)
+		tflog.Warn(ctx, "Error setting enabled", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("instance_id", r.InstanceID); err != nil {
-		fmt.Println("Error setting instance_id", err)
+		tflog.Warn(ctx, "Error setting instance_id", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("variables", utils.Normalize(r.Variables)); err != nil {
-		fmt.Println("Error setting variables", err)
+		tflog.Warn(ctx, "Error setting variables", map[string]interface{}{"error": err})
 	}
 	// group_ids are set by resourceHostRead after fetching from the API
 	return d
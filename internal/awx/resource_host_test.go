@@ -4,6 +4,10 @@ import (
 	"context"
 	"reflect"
 	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
 )
 
 func Test_resourceHostStateUpgradeV0(t *testing.T) {
@@ -25,3 +29,23 @@ func Test_resourceHostStateUpgradeV0(t *testing.T) {
 		t.Errorf("resourceHostStateUpgradeV0() = %v, want %v", got, rawState)
 	}
 }
+
+func Test_resourceHostCreate_fieldErrors(t *testing.T) {
+	srv := testAccServer(t)
+	client, err := awx.NewAWX(context.Background(), srv.URL, srv.Username, srv.Password, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceHost().Schema, map[string]interface{}{
+		"name":         "web-1",
+		"inventory_id": 4242,
+	})
+	diags := resourceHostCreate(context.Background(), d, client)
+	if len(diags) != 1 {
+		t.Fatalf("Expecting a diagnostic for the inventory field, got %v", diags)
+	}
+	if want := cty.GetAttrPath("inventory_id"); !diags[0].AttributePath.Equals(want) {
+		t.Errorf("Expecting the error on %#v, got %#v: %s", want, diags[0].AttributePath, diags[0].Detail)
+	}
+}
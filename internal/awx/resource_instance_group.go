@@ -2,9 +2,9 @@ package awx
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
@@ -13,6 +13,13 @@ import (
 
 const diagInstanceGroupTitle = "Instance Group"
 
+// instanceGroupAttributes maps the instance group fields to their attributes.
+//
+//nolint:gochecknoglobals
+var instanceGroupAttributes = utils.AttributeMap{
+	"credential": "credential_id",
+}
+
 func resourceInstanceGroup() *schema.Resource {
 	return &schema.Resource{
 		Description:   "Resource `awx_instance_group` manages instance groups within an AWX instance.",
@@ -61,7 +68,7 @@ func resourceInstanceGroup() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateIDOrNamedURL("instance_groups"),
 		},
 	}
 }
@@ -71,16 +78,16 @@ func resourceInstanceGroupCreate(ctx context.Context, d *schema.ResourceData, m
 	client := m.(*awx.AWX)
 	awxService := client.InstanceGroupsService
 
-	result, err := awxService.CreateInstanceGroup(map[string]interface{}{
-		"name":                       d.Get("name").(string),
-		"policy_instance_minimum":    d.Get("policy_instance_minimum").(int),
-		"is_container_group":         d.Get("is_container_group").(bool),
-		"policy_instance_percentage": d.Get("policy_instance_percentage").(int),
-		"pod_spec_override":          d.Get("pod_spec_override").(string),
-		"credential":                 d.Get("credential_id").(string),
+	result, err := awxService.CreateInstanceGroupFromRequest(ctx, &awx.InstanceGroupRequest{
+		Name:                     awx.Ptr(d.Get("name").(string)),
+		PolicyInstanceMinimum:    awx.Ptr(d.Get("policy_instance_minimum").(int)),
+		IsContainerGroup:         awx.Ptr(d.Get("is_container_group").(bool)),
+		PolicyInstancePercentage: awx.Ptr(d.Get("policy_instance_percentage").(int)),
+		PodSpecOverride:          awx.Ptr(d.Get("pod_spec_override").(string)),
+		Credential:               optionalIDFromString(d.Get("credential_id").(string)),
 	}, map[string]string{})
 	if err != nil {
-		return utils.DiagCreate(diagInstanceGroupTitle, err)
+		return utils.DiagCreate(diagInstanceGroupTitle, err, instanceGroupAttributes)
 	}
 
 	d.SetId(strconv.Itoa(result.ID))
@@ -95,59 +102,62 @@ func resourceInstanceGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diags
 	}
 
-	if _, err := client.InstanceGroupsService.UpdateInstanceGroup(id, map[string]interface{}{
-		"name":                       d.Get("name").(string),
-		"policy_instance_minimum":    d.Get("policy_instance_minimum").(int),
-		"is_container_group":         d.Get("is_container_group").(bool),
-		"policy_instance_percentage": d.Get("policy_instance_percentage").(int),
-		"pod_spec_override":          d.Get("pod_spec_override").(string),
-		"credential":                 d.Get("credential_id").(string),
+	if _, err := client.InstanceGroupsService.UpdateInstanceGroupFromRequest(ctx, id, &awx.InstanceGroupRequest{
+		Name:                     awx.Ptr(d.Get("name").(string)),
+		PolicyInstanceMinimum:    awx.Ptr(d.Get("policy_instance_minimum").(int)),
+		IsContainerGroup:         awx.Ptr(d.Get("is_container_group").(bool)),
+		PolicyInstancePercentage: awx.Ptr(d.Get("policy_instance_percentage").(int)),
+		PodSpecOverride:          awx.Ptr(d.Get("pod_spec_override").(string)),
+		Credential:               optionalIDFromString(d.Get("credential_id").(string)),
 	}, nil); err != nil {
-		return utils.DiagUpdate(diagInstanceGroupTitle, id, err)
+		return utils.DiagUpdate(diagInstanceGroupTitle, id, err, instanceGroupAttributes)
 	}
 
 	return resourceInstanceGroupRead(ctx, d, m)
 
 }
 
-func resourceInstanceGroupDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceInstanceGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, diags := utils.StateIDToInt(diagInstanceGroupTitle, d)
 	if diags.HasError() {
 		return diags
 	}
 
-	if _, err := client.InstanceGroupsService.DeleteInstanceGroup(id); err != nil {
+	if _, err := client.InstanceGroupsService.DeleteInstanceGroup(ctx, id); err != nil {
 		return utils.DiagDelete(diagInstanceGroupTitle, id, err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func resourceInstanceGroupRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceInstanceGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, diags := utils.StateIDToInt(diagInstanceGroupTitle, d)
 	if diags.HasError() {
 		return diags
 	}
 
-	res, err := client.InstanceGroupsService.GetInstanceGroupByID(id, make(map[string]string))
+	res, err := client.InstanceGroupsService.GetInstanceGroupByID(ctx, id, make(map[string]string))
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		return utils.DiagNotFound(diagInstanceGroupTitle, id, err)
 	}
-	d = setInstanceGroupResourceData(d, res)
+	d = setInstanceGroupResourceData(ctx, d, res)
 	return diag.Diagnostics{}
 }
 
-func setInstanceGroupResourceData(d *schema.ResourceData, r *awx.InstanceGroup) *schema.ResourceData {
+func setInstanceGroupResourceData(ctx context.Context, d *schema.ResourceData, r *awx.InstanceGroup) *schema.ResourceData {
 	if err := d.Set("name", r.Name); err != nil {
-		fmt.Println("Error setting name", err)
+		tflog.Warn(ctx, "Error setting name", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("is_container_group", r.IsContainerGroup); err != nil {
-		fmt.Println("Error setting is_container_group", err)
+		tflog.Warn(ctx, "Error setting is_container_group", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("pod_spec_override", utils.Normalize(r.PodSpecOverride)); err != nil {
-		fmt.Println("Error setting pod_spec_override", err)
+		tflog.Warn(ctx, "Error setting pod_spec_override", map[string]interface{}{"error": err})
 	}
 
 	d.SetId(strconv.Itoa(r.ID))
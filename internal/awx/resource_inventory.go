@@ -2,15 +2,22 @@ package awx
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
 	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
+// inventoryAttributes maps the inventory fields to their attributes.
+//
+//nolint:gochecknoglobals
+var inventoryAttributes = utils.AttributeMap{
+	"organization": "organization_id",
+}
+
 func resourceInventory() *schema.Resource {
 	return &schema.Resource{
 		Description:   "Resource Inventory is used to define an inventory in AWX",
@@ -57,23 +64,23 @@ func resourceInventory() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateIDOrNamedURL("inventories"),
 		},
 	}
 }
 
 func resourceInventoryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
-	result, err := client.InventoriesService.CreateInventory(map[string]interface{}{
-		"name":         d.Get("name").(string),
-		"organization": d.Get("organization_id").(string),
-		"description":  d.Get("description").(string),
-		"kind":         d.Get("kind").(string),
-		"host_filter":  d.Get("host_filter").(string),
-		"variables":    d.Get("variables").(string),
+	result, err := client.InventoriesService.CreateInventoryFromRequest(ctx, &awx.InventoryRequest{
+		Name:         awx.Ptr(d.Get("name").(string)),
+		Organization: optionalIDFromString(d.Get("organization_id").(string)),
+		Description:  awx.Ptr(d.Get("description").(string)),
+		Kind:         awx.Ptr(d.Get("kind").(string)),
+		HostFilter:   awx.Ptr(d.Get("host_filter").(string)),
+		Variables:    awx.Ptr(d.Get("variables").(string)),
 	}, map[string]string{})
 	if err != nil {
-		return utils.DiagCreate(diagInventoryTitle, err)
+		return utils.DiagCreate(diagInventoryTitle, err, inventoryAttributes)
 	}
 
 	d.SetId(strconv.Itoa(result.ID))
@@ -87,68 +94,71 @@ func resourceInventoryUpdate(ctx context.Context, d *schema.ResourceData, m inte
 	if diags.HasError() {
 		return diags
 	}
-	if _, err := client.InventoriesService.UpdateInventory(id, map[string]interface{}{
-		"name":         d.Get("name").(string),
-		"organization": d.Get("organization_id").(string),
-		"description":  d.Get("description").(string),
-		"kind":         d.Get("kind").(string),
-		"host_filter":  d.Get("host_filter").(string),
-		"variables":    d.Get("variables").(string),
+	if _, err := client.InventoriesService.UpdateInventoryFromRequest(ctx, id, &awx.InventoryRequest{
+		Name:         awx.Ptr(d.Get("name").(string)),
+		Organization: optionalIDFromString(d.Get("organization_id").(string)),
+		Description:  awx.Ptr(d.Get("description").(string)),
+		Kind:         awx.Ptr(d.Get("kind").(string)),
+		HostFilter:   awx.Ptr(d.Get("host_filter").(string)),
+		Variables:    awx.Ptr(d.Get("variables").(string)),
 	}, nil); err != nil {
-		return utils.DiagUpdate(diagInventoryTitle, id, err)
+		return utils.DiagUpdate(diagInventoryTitle, id, err, inventoryAttributes)
 	}
 
 	return resourceInventoryRead(ctx, d, m)
 
 }
 
-func resourceInventoryRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceInventoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, err := strconv.Atoi(d.Id())
 	id, diags := utils.StateIDToInt(diagInventoryTitle, d)
 	if diags.HasError() {
 		return diags
 	}
-	r, err := client.InventoriesService.GetInventory(id, map[string]string{})
+	r, err := client.InventoriesService.GetInventory(ctx, id, map[string]string{})
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		return utils.DiagFetch(diagInventoryTitle, id, err)
 	}
-	d = setInventoryResourceData(d, r)
+	d = setInventoryResourceData(ctx, d, r)
 	return nil
 }
 
-func resourceInventoryDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceInventoryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	awxService := client.InventoriesService
 	id, diags := utils.StateIDToInt(diagInventoryTitle, d)
 	if diags.HasError() {
 		return diags
 	}
-	if _, err := awxService.DeleteInventory(id); err != nil {
+	if _, err := awxService.DeleteInventory(ctx, id); err != nil {
 		return utils.DiagDelete(diagInventoryTitle, id, err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func setInventoryResourceData(d *schema.ResourceData, r *awx.Inventory) *schema.ResourceData {
+func setInventoryResourceData(ctx context.Context, d *schema.ResourceData, r *awx.Inventory) *schema.ResourceData {
 	if err := d.Set("name", r.Name); err != nil {
-		fmt.Println("Error setting name", err)
+		tflog.Warn(ctx, "Error setting name", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("organization_id", strconv.Itoa(r.Organization)); err != nil {
-		fmt.Println("Error setting organization_id", err)
+		tflog.Warn(ctx, "Error setting organization_id", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("description", r.Description); err != nil {
-		fmt.Println("Error setting description", err)
+		tflog.Warn(ctx, "Error setting description", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("kind", r.Kind); err != nil {
-		fmt.Println("Error setting kind", err)
+		tflog.Warn(ctx, "Error setting kind", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("host_filter", r.HostFilter); err != nil {
-		fmt.Println("Error setting host_filter", err)
+		tflog.Warn(ctx, "Error setting host_filter", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("variables", utils.Normalize(r.Variables)); err != nil {
-		fmt.Println("Error setting variables", err)
+		tflog.Warn(ctx, "Error setting variables", map[string]interface{}{"error": err})
 	}
 	d.SetId(strconv.Itoa(r.ID))
 	return d
@@ -2,15 +2,22 @@ package awx
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
 	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
+// inventoryGroupAttributes maps the group fields to their attributes.
+//
+//nolint:gochecknoglobals
+var inventoryGroupAttributes = utils.AttributeMap{
+	"inventory": "inventory_id",
+}
+
 func resourceInventoryGroup() *schema.Resource {
 	return &schema.Resource{
 		Description:   "Resource Inventory Group is used to manage the group in the AWX",
@@ -46,7 +53,7 @@ func resourceInventoryGroup() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateIDOrNamedURL("groups"),
 		},
 	}
 }
@@ -56,14 +63,14 @@ func resourceInventoryGroupCreate(ctx context.Context, d *schema.ResourceData, m
 	client := m.(*awx.AWX)
 	awxService := client.GroupService
 
-	result, err := awxService.CreateGroup(map[string]interface{}{
-		"name":        d.Get("name").(string),
-		"description": d.Get("description").(string),
-		"inventory":   d.Get("inventory_id").(string),
-		"variables":   d.Get("variables").(string),
+	result, err := awxService.CreateGroupFromRequest(ctx, &awx.GroupRequest{
+		Name:        awx.Ptr(d.Get("name").(string)),
+		Description: awx.Ptr(d.Get("description").(string)),
+		Inventory:   optionalIDFromString(d.Get("inventory_id").(string)),
+		Variables:   awx.Ptr(d.Get("variables").(string)),
 	}, map[string]string{})
 	if err != nil {
-		return utils.DiagCreate(diagInventoryGroupTitle, err)
+		return utils.DiagCreate(diagInventoryGroupTitle, err, inventoryGroupAttributes)
 	}
 
 	d.SetId(strconv.Itoa(result.ID))
@@ -78,60 +85,63 @@ func resourceInventoryGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diags
 	}
 
-	if _, err := client.GroupService.UpdateGroup(id, map[string]interface{}{
-		"name":        d.Get("name").(string),
-		"description": d.Get("description").(string),
-		"inventory":   d.Get("inventory_id").(string),
-		"variables":   d.Get("variables").(string),
+	if _, err := client.GroupService.UpdateGroupFromRequest(ctx, id, &awx.GroupRequest{
+		Name:        awx.Ptr(d.Get("name").(string)),
+		Description: awx.Ptr(d.Get("description").(string)),
+		Inventory:   optionalIDFromString(d.Get("inventory_id").(string)),
+		Variables:   awx.Ptr(d.Get("variables").(string)),
 	}, nil); err != nil {
-		return utils.DiagUpdate(diagInventoryGroupTitle, id, err)
+		return utils.DiagUpdate(diagInventoryGroupTitle, id, err, inventoryGroupAttributes)
 	}
 
 	return resourceInventoryGroupRead(ctx, d, m)
 
 }
 
-func resourceInventoryGroupDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceInventoryGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, diags := utils.StateIDToInt(diagInventoryGroupTitle, d)
 	if diags.HasError() {
 		return diags
 	}
 
-	if _, err := client.GroupService.DeleteGroup(id); err != nil {
+	if _, err := client.GroupService.DeleteGroup(ctx, id); err != nil {
 		return utils.DiagDelete(diagInventoryGroupTitle, id, err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func resourceInventoryGroupRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceInventoryGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, diags := utils.StateIDToInt(diagInventoryGroupTitle, d)
 	if diags.HasError() {
 		return diags
 	}
 
-	res, err := client.GroupService.GetGroupByID(id, make(map[string]string))
+	res, err := client.GroupService.GetGroupByID(ctx, id, make(map[string]string))
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		return utils.DiagFetch(diagInventoryGroupTitle, id, err)
 	}
-	d = setInventoryGroupResourceData(d, res)
+	d = setInventoryGroupResourceData(ctx, d, res)
 	return nil
 }
 
-func setInventoryGroupResourceData(d *schema.ResourceData, r *awx.Group) *schema.ResourceData {
+func setInventoryGroupResourceData(ctx context.Context, d *schema.ResourceData, r *awx.Group) *schema.ResourceData {
 	if err := d.Set("name", r.Name); err != nil {
-		fmt.Println("Error setting name", err)
+		tflog.Warn(ctx, "Error setting name", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("description", r.Description); err != nil {
-		fmt.Println("Error setting description", err)
+		tflog.Warn(ctx, "Error setting description", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("inventory_id", r.Inventory); err != nil {
-		fmt.Println("Error setting inventory_id", err)
+		tflog.Warn(ctx, "Error setting inventory_id", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("variables", utils.Normalize(r.Variables)); err != nil {
-		fmt.Println("Error setting variables", err)
+		tflog.Warn(ctx, "Error setting variables", map[string]interface{}{"error": err})
 	}
 
 	d.SetId(strconv.Itoa(r.ID))
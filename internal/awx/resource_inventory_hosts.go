@@ -0,0 +1,313 @@
+package awx
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
+)
+
+const diagInventoryHostsTitle = "Inventory Hosts"
+
+// inventoryHostsAttributes maps the fields of the bulk and host requests to their attributes.
+//
+//nolint:gochecknoglobals
+var inventoryHostsAttributes = utils.AttributeMap{
+	"inventory":   "inventory_id",
+	"hosts":       "host",
+	"name":        "host",
+	"description": "host",
+	"enabled":     "host",
+	"instance_id": "host",
+	"variables":   "host",
+}
+
+func resourceInventoryHosts() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource `awx_inventory_hosts` authoritatively manages the hosts of an inventory with the AWX bulk API, " +
+			"which registers thousands of hosts in a few requests. Hosts of the inventory that are not listed are deleted, " +
+			"so the inventory must not be populated by `awx_host` resources or inventory sources as well.",
+		CreateContext: resourceInventoryHostsCreate,
+		ReadContext:   resourceInventoryHostsRead,
+		UpdateContext: resourceInventoryHostsUpdate,
+		DeleteContext: resourceInventoryHostsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"inventory_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The inventory whose hosts are managed.",
+			},
+			"host": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The hosts of the inventory.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the host, unique in the inventory.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "",
+							Description: "The description of the host.",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether the host is available to jobs.",
+						},
+						"instance_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "",
+							Description: "The value used by the remote inventory source to uniquely identify the host.",
+						},
+						"variables": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "",
+							Description: "The variables of the host, in JSON or YAML.",
+						},
+					},
+				},
+			},
+			"host_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the hosts, by host name.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateIDOrNamedURL("inventories"),
+		},
+	}
+}
+
+func resourceInventoryHostsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	if diags := checkBulkHosts(ctx, client); diags.HasError() {
+		return diags
+	}
+
+	inventoryID := d.Get("inventory_id").(int)
+	if err := syncInventoryHosts(ctx, client, inventoryID, expandInventoryHosts(d)); err != nil {
+		return utils.DiagCreate(diagInventoryHostsTitle, err, inventoryHostsAttributes)
+	}
+
+	d.SetId(strconv.Itoa(inventoryID))
+	return resourceInventoryHostsRead(ctx, d, m)
+}
+
+func resourceInventoryHostsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	id, diags := utils.StateIDToInt(diagInventoryHostsTitle, d)
+	if diags.HasError() {
+		return diags
+	}
+
+	if d.HasChange("host") {
+		if diags := checkBulkHosts(ctx, client); diags.HasError() {
+			return diags
+		}
+		if err := syncInventoryHosts(ctx, client, id, expandInventoryHosts(d)); err != nil {
+			return utils.DiagUpdate(diagInventoryHostsTitle, id, err, inventoryHostsAttributes)
+		}
+	}
+	return resourceInventoryHostsRead(ctx, d, m)
+}
+
+func resourceInventoryHostsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	id, diags := utils.StateIDToInt(diagInventoryHostsTitle, d)
+	if diags.HasError() {
+		return diags
+	}
+
+	if _, err := client.InventoriesService.GetInventory(ctx, id, map[string]string{}); err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
+		return utils.DiagNotFound(diagInventoryHostsTitle, id, err)
+	}
+	hosts, _, err := client.HostService.ListHosts(ctx, map[string]string{"inventory": strconv.Itoa(id)})
+	if err != nil {
+		return utils.DiagFetch(diagInventoryHostsTitle, id, err)
+	}
+
+	// Keep the configured variables when AWX only reformatted them.
+	configured := make(map[string]string)
+	for _, host := range expandInventoryHosts(d) {
+		configured[host.Name] = *host.Variables
+	}
+
+	hostSet := make([]interface{}, 0, len(hosts))
+	hostIDs := make(map[string]interface{}, len(hosts))
+	for _, host := range hosts {
+		variables := host.Variables
+		if value, ok := configured[host.Name]; ok && utils.Normalize(value) == utils.Normalize(variables) {
+			variables = value
+		}
+		hostSet = append(hostSet, map[string]interface{}{
+			"name":        host.Name,
+			"description": host.Description,
+			"enabled":     host.Enabled,
+			"instance_id": host.InstanceID,
+			"variables":   variables,
+		})
+		hostIDs[host.Name] = host.ID
+	}
+
+	if err := d.Set("inventory_id", id); err != nil {
+		return utils.Diagf(diagInventoryHostsTitle, "Error setting inventory_id for inventory %d: %s", id, err)
+	}
+	if err := d.Set("host", hostSet); err != nil {
+		return utils.Diagf(diagInventoryHostsTitle, "Error setting host for inventory %d: %s", id, err)
+	}
+	if err := d.Set("host_ids", hostIDs); err != nil {
+		return utils.Diagf(diagInventoryHostsTitle, "Error setting host_ids for inventory %d: %s", id, err)
+	}
+	return nil
+}
+
+func resourceInventoryHostsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	id, diags := utils.StateIDToInt(diagInventoryHostsTitle, d)
+	if diags.HasError() {
+		return diags
+	}
+	if diags := checkBulkHosts(ctx, client); diags.HasError() {
+		return diags
+	}
+
+	if err := syncInventoryHosts(ctx, client, id, nil); err != nil {
+		if awx.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return utils.DiagDelete(diagInventoryHostsTitle, id, err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// checkBulkHosts rejects the servers without the bulk host API the resource syncs the hosts with.
+func checkBulkHosts(ctx context.Context, client *awx.AWX) diag.Diagnostics {
+	info, diags := connectedServerInfo(ctx, client)
+	if diags.HasError() {
+		return diags
+	}
+	if !info.Supports(awx.FeatureBulkHosts) {
+		return utils.Diagf(
+			"Bulk host management is not supported by the AWX server",
+			"awx_inventory_hosts requires %s, the provider is connected to %s. Use awx_host resources instead.",
+			awx.FeatureBulkHosts.Requirement(), info,
+		)
+	}
+	return nil
+}
+
+// expandInventoryHosts returns the hosts configured in the host set.
+func expandInventoryHosts(d *schema.ResourceData) []*awx.BulkHost {
+	raw := d.Get("host").(*schema.Set).List()
+	hosts := make([]*awx.BulkHost, 0, len(raw))
+	for _, item := range raw {
+		host := item.(map[string]interface{})
+		hosts = append(hosts, &awx.BulkHost{
+			Name:        host["name"].(string),
+			Description: awx.Ptr(host["description"].(string)),
+			Enabled:     awx.Ptr(host["enabled"].(bool)),
+			InstanceID:  awx.Ptr(host["instance_id"].(string)),
+			Variables:   awx.Ptr(host["variables"].(string)),
+		})
+	}
+	return hosts
+}
+
+// syncInventoryHosts makes the hosts of the inventory match desired, by name: extra hosts are deleted
+// and missing hosts created with the bulk API, while the hosts that changed are updated one by one.
+func syncInventoryHosts(ctx context.Context, client *awx.AWX, inventoryID int, desired []*awx.BulkHost) error {
+	existing, _, err := client.HostService.ListHosts(ctx, map[string]string{"inventory": strconv.Itoa(inventoryID)})
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[string]*awx.BulkHost, len(desired))
+	for _, host := range desired {
+		wanted[host.Name] = host
+	}
+
+	var toDelete []int
+	current := make(map[string]*awx.Host, len(existing))
+	for _, host := range existing {
+		if _, ok := wanted[host.Name]; !ok {
+			toDelete = append(toDelete, host.ID)
+			continue
+		}
+		current[host.Name] = host
+	}
+
+	var toCreate []*awx.BulkHost
+	var toUpdate []*awx.Host
+	for _, host := range desired {
+		found, ok := current[host.Name]
+		if !ok {
+			toCreate = append(toCreate, host)
+			continue
+		}
+		if inventoryHostChanged(found, host) {
+			toUpdate = append(toUpdate, found)
+		}
+	}
+	sort.Ints(toDelete)
+
+	tflog.Debug(ctx, "Synchronizing inventory hosts", map[string]interface{}{
+		"inventory": inventoryID,
+		"create":    len(toCreate),
+		"update":    len(toUpdate),
+		"delete":    len(toDelete),
+	})
+
+	// Delete first, so that the names of the deleted hosts are free again.
+	if len(toDelete) > 0 {
+		if err := client.BulkService.DeleteHosts(ctx, toDelete); err != nil {
+			return err
+		}
+	}
+	if len(toCreate) > 0 {
+		if _, err := client.BulkService.CreateHosts(ctx, inventoryID, toCreate); err != nil {
+			return err
+		}
+	}
+	for _, host := range toUpdate {
+		want := wanted[host.Name]
+		if _, err := client.HostService.UpdateHostFromRequest(ctx, host.ID, &awx.HostRequest{
+			Description: want.Description,
+			Enabled:     want.Enabled,
+			InstanceID:  want.InstanceID,
+			Variables:   want.Variables,
+		}, map[string]string{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// inventoryHostChanged reports whether the host differs from its configuration.
+func inventoryHostChanged(host *awx.Host, want *awx.BulkHost) bool {
+	return host.Description != *want.Description ||
+		host.Enabled != *want.Enabled ||
+		host.InstanceID != *want.InstanceID ||
+		utils.Normalize(host.Variables) != utils.Normalize(*want.Variables)
+}
@@ -0,0 +1,101 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/goawx/awxtest"
+)
+
+func TestAccResourceInventoryHosts(t *testing.T) {
+	srv := testAccServer(t)
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckDestroy(srv, "awx_inventory", "inventories"),
+			func(_ *terraform.State) error {
+				if hosts := srv.Objects("hosts"); len(hosts) != 0 {
+					return fmt.Errorf("%d hosts still exist", len(hosts))
+				}
+				return nil
+			},
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceInventoryHostsConfig(150, "web"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_inventory_hosts.test", "host.#", "150"),
+					resource.TestCheckResourceAttr("awx_inventory_hosts.test", "host_ids.%", "150"),
+					resource.TestCheckResourceAttrSet("awx_inventory_hosts.test", "host_ids.web-000.example.com"),
+				),
+			},
+			{
+				Config: testAccResourceInventoryHostsConfig(120, "db"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_inventory_hosts.test", "host.#", "120"),
+					resource.TestCheckResourceAttr("awx_inventory_hosts.test", "host_ids.%", "120"),
+					resource.TestCheckResourceAttrSet("awx_inventory_hosts.test", "host_ids.db-119.example.com"),
+				),
+			},
+			{
+				ResourceName:      "awx_inventory_hosts.test",
+				ImportState:       true,
+				ImportStateId:     "Bulk++Default",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceInventoryHostsConfig(count int, prefix string) string {
+	var hosts strings.Builder
+	for i := 0; i < count; i++ {
+		fmt.Fprintf(&hosts, `
+  host {
+    name      = "%s-%03d.example.com"
+    variables = "ansible_host: 10.0.%d.%d\n"
+  }
+`, prefix, i, i/250, i%250+1)
+	}
+	return fmt.Sprintf(`
+resource "awx_inventory" "test" {
+  name            = "Bulk"
+  organization_id = 1
+}
+
+resource "awx_inventory_hosts" "test" {
+  inventory_id = awx_inventory.test.id
+%s}
+`, hosts.String())
+}
+
+func Test_resourceInventoryHosts_unsupported(t *testing.T) {
+	srv := awxtest.NewServer(awxtest.WithVersion("21.0.0"))
+	defer srv.Close()
+	client := awx.NewAWXDeferred(srv.URL, &awx.BasicAuth{Username: srv.Username, Password: srv.Password}, nil)
+
+	for name, op := range map[string]func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics{
+		"create": resourceInventoryHostsCreate,
+		"update": resourceInventoryHostsUpdate,
+		"delete": resourceInventoryHostsDelete,
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceInventoryHosts().Schema, map[string]interface{}{
+				"inventory_id": 1,
+				"host":         []interface{}{map[string]interface{}{"name": "web-01.example.com"}},
+			})
+			d.SetId("1")
+			diags := op(context.Background(), d, client)
+			if !diags.HasError() || !strings.Contains(diags[0].Summary, "Bulk host management is not supported") {
+				t.Errorf("Expecting the bulk host API to be required, got %+v", diags)
+			}
+		})
+	}
+}
@@ -35,14 +35,14 @@ func resourceInventoryInstanceGroups() *schema.Resource {
 	}
 }
 
-func resourceInventoryInstanceGroupsCreate(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceInventoryInstanceGroupsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	inventoryID := d.Get("inventory_id").(int)
-	if _, err := client.InventoriesService.GetInventoryByID(inventoryID, make(map[string]string)); err != nil {
+	if _, err := client.InventoriesService.GetInventoryByID(ctx, inventoryID, make(map[string]string)); err != nil {
 		return utils.DiagNotFound("Inventory InstanceGroup", inventoryID, err)
 	}
 
-	result, err := client.InventoriesService.AssociateInstanceGroups(inventoryID, map[string]interface{}{
+	result, err := client.InventoriesService.AssociateInstanceGroups(ctx, inventoryID, map[string]interface{}{
 		"id": d.Get("instance_group_id").(int),
 	}, map[string]string{})
 
@@ -58,15 +58,15 @@ func resourceInventoryInstanceGroupsRead(_ context.Context, _ *schema.ResourceDa
 	return nil
 }
 
-func resourceInventoryInstanceGroupsDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceInventoryInstanceGroupsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	inventoryID := d.Get("inventory_id").(int)
-	res, err := client.InventoriesService.GetInventoryByID(inventoryID, make(map[string]string))
+	res, err := client.InventoriesService.GetInventoryByID(ctx, inventoryID, make(map[string]string))
 	if err != nil {
 		return utils.DiagNotFound("Inventory InstanceGroup", inventoryID, err)
 	}
 
-	if _, err = client.InventoriesService.DisAssociateInstanceGroups(res.ID, map[string]interface{}{
+	if _, err = client.InventoriesService.DisAssociateInstanceGroups(ctx, res.ID, map[string]interface{}{
 		"id": d.Get("instance_group_id").(int),
 	}, map[string]string{}); err != nil {
 		return utils.DiagDelete("Inventory DisAssociateInstanceGroups", inventoryID, err)
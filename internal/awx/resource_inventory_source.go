@@ -2,9 +2,9 @@ package awx
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
@@ -13,6 +13,15 @@ import (
 
 const diagInventorySourceTitle = "Inventory Source"
 
+// inventorySourceAttributes maps the inventory source fields to their attributes.
+//
+//nolint:gochecknoglobals
+var inventorySourceAttributes = utils.AttributeMap{
+	"inventory":      "inventory_id",
+	"credential":     "credential_id",
+	"source_project": "source_project_id",
+}
+
 //nolint:funlen
 func resourceInventorySource() *schema.Resource {
 	return &schema.Resource{
@@ -134,7 +143,7 @@ func resourceInventorySource() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateIDOrNamedURL("inventory_sources"),
 		},
 	}
 }
@@ -142,38 +151,9 @@ func resourceInventorySource() *schema.Resource {
 func resourceInventorySourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 
-	payload := map[string]interface{}{
-		"name":                  d.Get("name").(string),
-		"description":           d.Get("description").(string),
-		"enabled_var":           d.Get("enabled_var").(string),
-		"enabled_value":         d.Get("enabled_value").(string),
-		"overwrite":             d.Get("overwrite").(bool),
-		"overwrite_vars":        d.Get("overwrite_vars").(bool),
-		"update_on_launch":      d.Get("update_on_launch").(bool),
-		"inventory":             d.Get("inventory_id").(int),
-		"source":                d.Get("source").(string),
-		"source_vars":           d.Get("source_vars").(string),
-		"host_filter":           d.Get("host_filter").(string),
-		"update_cache_timeout":  d.Get("update_cache_timeout").(int),
-		"verbosity":             d.Get("verbosity").(int),
-		"execution_environment": d.Get("execution_environment").(int),
-		// obsolete schema added so terraform doesn't break
-		// these don't do anything in later versions of AWX! Update your code.
-		"source_regions":   d.Get("source_regions").(string),
-		"instance_filters": d.Get("instance_filters").(string),
-		"group_by":         d.Get("group_by").(string),
-		"source_path":      d.Get("source_path").(string),
-	}
-	if _, ok := d.GetOk("credential_id"); ok {
-		payload["credential"] = d.Get("credential_id").(int)
-	}
-	if _, ok := d.GetOk("source_project_id"); ok {
-		payload["source_project"] = d.Get("source_project_id").(int)
-	}
-
-	result, err := client.InventorySourcesService.CreateInventorySource(payload, map[string]string{})
+	result, err := client.InventorySourcesService.CreateInventorySourceFromRequest(ctx, inventorySourceRequest(d), map[string]string{})
 	if err != nil {
-		return utils.DiagCreate(diagInventorySourceTitle, err)
+		return utils.DiagCreate(diagInventorySourceTitle, err, inventorySourceAttributes)
 	}
 
 	d.SetId(strconv.Itoa(result.ID))
@@ -189,137 +169,144 @@ func resourceInventorySourceUpdate(ctx context.Context, d *schema.ResourceData,
 		return diags
 	}
 
-	payload := map[string]interface{}{
-		"name":                  d.Get("name").(string),
-		"description":           d.Get("description").(string),
-		"enabled_var":           d.Get("enabled_var").(string),
-		"enabled_value":         d.Get("enabled_value").(string),
-		"overwrite":             d.Get("overwrite").(bool),
-		"overwrite_vars":        d.Get("overwrite_vars").(bool),
-		"update_on_launch":      d.Get("update_on_launch").(bool),
-		"inventory":             d.Get("inventory_id").(int),
-		"source":                d.Get("source").(string),
-		"source_vars":           d.Get("source_vars").(string),
-		"host_filter":           d.Get("host_filter").(string),
-		"update_cache_timeout":  d.Get("update_cache_timeout").(int),
-		"verbosity":             d.Get("verbosity").(int),
-		"execution_environment": d.Get("execution_environment").(int),
+	if _, err := awxService.UpdateInventorySourceFromRequest(ctx, id, inventorySourceRequest(d), nil); err != nil {
+		return utils.DiagUpdate(diagInventorySourceTitle, id, err, inventorySourceAttributes)
+	}
+
+	return resourceInventorySourceRead(ctx, d, m)
+}
+
+// inventorySourceRequest builds the inventory source payload from the configuration.
+func inventorySourceRequest(d *schema.ResourceData) *awx.InventorySourceRequest {
+	payload := &awx.InventorySourceRequest{
+		Name:                 awx.Ptr(d.Get("name").(string)),
+		Description:          awx.Ptr(d.Get("description").(string)),
+		EnabledVar:           awx.Ptr(d.Get("enabled_var").(string)),
+		EnabledValue:         awx.Ptr(d.Get("enabled_value").(string)),
+		Overwrite:            awx.Ptr(d.Get("overwrite").(bool)),
+		OverwriteVars:        awx.Ptr(d.Get("overwrite_vars").(bool)),
+		UpdateOnLaunch:       awx.Ptr(d.Get("update_on_launch").(bool)),
+		Inventory:            optionalID(d.Get("inventory_id").(int)),
+		Source:               awx.Ptr(d.Get("source").(string)),
+		SourceVars:           awx.Ptr(d.Get("source_vars").(string)),
+		HostFilter:           awx.Ptr(d.Get("host_filter").(string)),
+		UpdateCacheTimeout:   awx.Ptr(d.Get("update_cache_timeout").(int)),
+		Verbosity:            awx.Ptr(d.Get("verbosity").(int)),
+		ExecutionEnvironment: optionalID(d.Get("execution_environment").(int)),
 		// obsolete schema added so terraform doesn't break
 		// these don't do anything in later versions of AWX! Update your code.
-		"source_regions":   d.Get("source_regions").(string),
-		"instance_filters": d.Get("instance_filters").(string),
-		"group_by":         d.Get("group_by").(string),
-		"source_path":      d.Get("source_path").(string),
+		SourceRegions:   awx.Ptr(d.Get("source_regions").(string)),
+		InstanceFilters: awx.Ptr(d.Get("instance_filters").(string)),
+		GroupBy:         awx.Ptr(d.Get("group_by").(string)),
+		SourcePath:      awx.Ptr(d.Get("source_path").(string)),
 	}
 	if _, ok := d.GetOk("credential_id"); ok {
-		payload["credential"] = d.Get("credential_id").(int)
+		payload.Credential = awx.NewNullable(d.Get("credential_id").(int))
 	}
 	if _, ok := d.GetOk("source_project_id"); ok {
-		payload["source_project"] = d.Get("source_project_id").(int)
+		payload.SourceProject = awx.NewNullable(d.Get("source_project_id").(int))
 	}
-
-	if _, err := awxService.UpdateInventorySource(id, payload, nil); err != nil {
-		return utils.DiagUpdate(diagInventorySourceTitle, id, err)
-	}
-
-	return resourceInventorySourceRead(ctx, d, m)
+	return payload
 }
 
-func resourceInventorySourceDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceInventorySourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, diags := utils.StateIDToInt(diagInventorySourceTitle, d)
 	if diags.HasError() {
 		return diags
 	}
-	if _, err := client.InventorySourcesService.DeleteInventorySource(id); err != nil {
+	if _, err := client.InventorySourcesService.DeleteInventorySource(ctx, id); err != nil {
 		return utils.DiagDelete(diagInventorySourceTitle, id, err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func resourceInventorySourceRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceInventorySourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, diags := utils.StateIDToInt(diagInventorySourceTitle, d)
 	if diags.HasError() {
 		return diags
 	}
-	res, err := client.InventorySourcesService.GetInventorySourceByID(id, make(map[string]string))
+	res, err := client.InventorySourcesService.GetInventorySourceByID(ctx, id, make(map[string]string))
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		return utils.DiagFetch(diagInventorySourceTitle, id, err)
 	}
-	d = setInventorySourceResourceData(d, res)
+	d = setInventorySourceResourceData(ctx, d, res)
 	return nil
 }
 
-func setInventorySourceResourceData(d *schema.ResourceData, r *awx.InventorySource) *schema.ResourceData {
+func setInventorySourceResourceData(ctx context.Context, d *schema.ResourceData, r *awx.InventorySource) *schema.ResourceData {
 	if err := d.Set("name", r.Name); err != nil {
-		fmt.Println("Error setting name", err)
+		tflog.Warn(ctx, "Error setting name", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("description", r.Description); err != nil {
-		fmt.Println("Error setting description", err)
+		tflog.Warn(ctx, "Error setting description", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("enabled_var", r.EnabledVar); err != nil {
-		fmt.Println("Error setting enabled_var", err)
+		tflog.Warn(ctx, "Error setting enabled_var", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("enabled_value", r.EnabledValue); err != nil {
-		fmt.Println("Error setting enabled_value", err)
+		tflog.Warn(ctx, "Error setting enabled_value", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("overwrite", r.Overwrite); err != nil {
-		fmt.Println("Error setting overwrite", err)
+		tflog.Warn(ctx, "Error setting overwrite", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("overwrite_vars", r.OverwriteVars); err != nil {
-		fmt.Println("Error setting overwrite_vars", err)
+		tflog.Warn(ctx, "Error setting overwrite_vars", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("update_on_launch", r.UpdateOnLaunch); err != nil {
-		fmt.Println("Error setting update_on_launch", err)
+		tflog.Warn(ctx, "Error setting update_on_launch", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("inventory_id", r.Inventory); err != nil {
-		fmt.Println("Error setting inventory_id", err)
+		tflog.Warn(ctx, "Error setting inventory_id", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("credential_id", r.Credential); err != nil {
-		fmt.Println("Error setting credential_id", err)
+		tflog.Warn(ctx, "Error setting credential_id", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("source", r.Source); err != nil {
-		fmt.Println("Error setting source", err)
+		tflog.Warn(ctx, "Error setting source", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("source_vars", utils.Normalize(r.SourceVars)); err != nil {
-		fmt.Println("Error setting source_vars", err)
+		tflog.Warn(ctx, "Error setting source_vars", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("host_filter", r.HostFilter); err != nil {
-		fmt.Println("Error setting host_filter", err)
+		tflog.Warn(ctx, "Error setting host_filter", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("update_cache_timeout", r.UpdateCacheTimeout); err != nil {
-		fmt.Println("Error setting update_cache_timeout", err)
+		tflog.Warn(ctx, "Error setting update_cache_timeout", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("verbosity", r.Verbosity); err != nil {
-		fmt.Println("Error setting verbosity", err)
+		tflog.Warn(ctx, "Error setting verbosity", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("execution_environment", r.ExecutionEnvironment); err != nil {
-		fmt.Println("Error setting execution_environment", err)
+		tflog.Warn(ctx, "Error setting execution_environment", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("source_project_id", r.SourceProject); err != nil {
-		fmt.Println("Error setting source_project_id", err)
+		tflog.Warn(ctx, "Error setting source_project_id", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("source_path", r.SourcePath); err != nil {
-		fmt.Println("Error setting source_path", err)
+		tflog.Warn(ctx, "Error setting source_path", map[string]interface{}{"error": err})
 	}
 	// obsolete schema added so terraform doesn't break
 	// these don't do anything in later versions of AWX! Update your code.
 	if err := d.Set("source_regions", r.SourceRegions); err != nil {
-		fmt.Println("Error setting source_regions", err)
+		tflog.Warn(ctx, "Error setting source_regions", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("instance_filters", r.InstanceFilters); err != nil {
-		fmt.Println("Error setting instance_filters", err)
+		tflog.Warn(ctx, "Error setting instance_filters", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("group_by", r.GroupBy); err != nil {
-		fmt.Println("Error setting group_by", err)
+		tflog.Warn(ctx, "Error setting group_by", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("source_project_id", r.SourceProject); err != nil {
-		fmt.Println("Error setting source_project_id", err)
+		tflog.Warn(ctx, "Error setting source_project_id", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("source_path", r.SourcePath); err != nil {
-		fmt.Println("Error setting source_path", err)
+		tflog.Warn(ctx, "Error setting source_path", map[string]interface{}{"error": err})
 	}
 
 	return d
@@ -0,0 +1,66 @@
+package awx
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceInventory(t *testing.T) {
+	srv := testAccServer(t)
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckDestroy(srv, "awx_inventory", "inventories"),
+			testAccCheckDestroy(srv, "awx_inventory_group", "groups"),
+			testAccCheckDestroy(srv, "awx_host", "hosts"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceInventoryConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_inventory.test", "name", "Web servers"),
+					resource.TestCheckResourceAttr("awx_inventory.test", "organization_id", "1"),
+					resource.TestCheckResourceAttrPair("awx_inventory_group.test", "inventory_id", "awx_inventory.test", "id"),
+					resource.TestCheckResourceAttrPair("awx_host.test", "inventory_id", "awx_inventory.test", "id"),
+					resource.TestCheckResourceAttr("awx_host.test", "enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      "awx_inventory.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:            "awx_host.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"group_ids"},
+			},
+		},
+	})
+}
+
+const testAccResourceInventoryConfig = `
+data "awx_organization" "default" {
+  name = "Default"
+}
+
+resource "awx_inventory" "test" {
+  name            = "Web servers"
+  organization_id = data.awx_organization.default.id
+  variables       = "ansible_user: deploy\n"
+}
+
+resource "awx_inventory_group" "test" {
+  name         = "frontends"
+  inventory_id = awx_inventory.test.id
+}
+
+resource "awx_host" "test" {
+  name         = "web-01.example.com"
+  inventory_id = awx_inventory.test.id
+  group_ids    = [awx_inventory_group.test.id]
+  variables    = "http_port: 8080\n"
+}
+`
@@ -2,9 +2,9 @@ package awx
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
@@ -13,6 +13,14 @@ import (
 
 const diagJobTemplateTitle = "Job Template"
 
+// jobTemplateAttributes maps the job template fields to their attributes.
+//
+//nolint:gochecknoglobals
+var jobTemplateAttributes = utils.AttributeMap{
+	"inventory": "inventory_id",
+	"project":   "project_id",
+}
+
 //nolint:funlen
 func resourceJobTemplate() *schema.Resource {
 	return &schema.Resource{
@@ -21,6 +29,7 @@ func resourceJobTemplate() *schema.Resource {
 		ReadContext:   resourceJobTemplateRead,
 		UpdateContext: resourceJobTemplateUpdate,
 		DeleteContext: resourceJobTemplateDelete,
+		CustomizeDiff: customizeDiffFeatures(jobTemplateFeatures),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -245,58 +254,37 @@ func resourceJobTemplate() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateIDOrNamedURL("job_templates"),
 		},
 	}
 }
 
+// jobTemplateExtendedPromptFields are the prompts on launch added along with awx.FeatureExtendedLaunchPrompts.
+//
+//nolint:gochecknoglobals
+var jobTemplateExtendedPromptFields = []string{
+	"ask_execution_environment_on_launch",
+	"ask_labels_on_launch",
+	"ask_forks_on_launch",
+	"ask_job_slice_count_on_launch",
+	"ask_timeout_on_launch",
+	"ask_instance_group_on_launch",
+}
+
+// jobTemplateFeatures rejects the attributes the AWX server does not support.
+func jobTemplateFeatures(d attributeGetter, info *awx.ServerInfo) diag.Diagnostics {
+	diags := checkFeatureFields(d, info, awx.FeatureExtendedLaunchPrompts, jobTemplateExtendedPromptFields...)
+	return append(diags, checkFeatureFields(d, info, awx.FeatureExecutionEnvironments, "execution_environment")...)
+}
+
 func resourceJobTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
-	result, err := client.JobTemplateService.CreateJobTemplate(map[string]interface{}{
-		"name":                                d.Get("name").(string),
-		"description":                         d.Get("description").(string),
-		"job_type":                            d.Get("job_type").(string),
-		"inventory":                           utils.AtoiDefault(d.Get("inventory_id").(string), nil),
-		"project":                             d.Get("project_id").(int),
-		"playbook":                            d.Get("playbook").(string),
-		"scm_branch":                          d.Get("scm_branch").(string),
-		"forks":                               d.Get("forks").(int),
-		"limit":                               d.Get("limit").(string),
-		"verbosity":                           d.Get("verbosity").(int),
-		"extra_vars":                          d.Get("extra_vars").(string),
-		"job_tags":                            d.Get("job_tags").(string),
-		"force_handlers":                      d.Get("force_handlers").(bool),
-		"skip_tags":                           d.Get("skip_tags").(string),
-		"start_at_task":                       d.Get("start_at_task").(string),
-		"timeout":                             d.Get("timeout").(int),
-		"use_fact_cache":                      d.Get("use_fact_cache").(bool),
-		"host_config_key":                     d.Get("host_config_key").(string),
-		"ask_scm_branch_on_launch":            d.Get("ask_scm_branch_on_launch").(bool),
-		"ask_diff_mode_on_launch":             d.Get("ask_diff_mode_on_launch").(bool),
-		"ask_variables_on_launch":             d.Get("ask_variables_on_launch").(bool),
-		"ask_limit_on_launch":                 d.Get("ask_limit_on_launch").(bool),
-		"ask_tags_on_launch":                  d.Get("ask_tags_on_launch").(bool),
-		"ask_skip_tags_on_launch":             d.Get("ask_skip_tags_on_launch").(bool),
-		"ask_job_type_on_launch":              d.Get("ask_job_type_on_launch").(bool),
-		"ask_verbosity_on_launch":             d.Get("ask_verbosity_on_launch").(bool),
-		"ask_inventory_on_launch":             d.Get("ask_inventory_on_launch").(bool),
-		"ask_credential_on_launch":            d.Get("ask_credential_on_launch").(bool),
-		"ask_execution_environment_on_launch": d.Get("ask_execution_environment_on_launch").(bool),
-		"ask_labels_on_launch":                d.Get("ask_labels_on_launch").(bool),
-		"ask_forks_on_launch":                 d.Get("ask_forks_on_launch").(bool),
-		"ask_job_slice_count_on_launch":       d.Get("ask_job_slice_count_on_launch").(bool),
-		"ask_timeout_on_launch":               d.Get("ask_timeout_on_launch").(bool),
-		"ask_instance_group_on_launch":        d.Get("ask_instance_group_on_launch").(bool),
-		"survey_enabled":                      d.Get("survey_enabled").(bool),
-		"become_enabled":                      d.Get("become_enabled").(bool),
-		"diff_mode":                           d.Get("diff_mode").(bool),
-		"allow_simultaneous":                  d.Get("allow_simultaneous").(bool),
-		"custom_virtualenv":                   utils.AtoiDefault(d.Get("custom_virtualenv").(string), nil),
-		"execution_environment":               utils.AtoiDefault(d.Get("execution_environment").(string), nil),
-		"job_slice_count":                     d.Get("job_slice_count").(int),
-	}, map[string]string{})
+	if diags := checkFeatures(ctx, d, client, jobTemplateFeatures); diags.HasError() {
+		return diags
+	}
+	result, err := client.JobTemplateService.CreateJobTemplateFromRequest(ctx, jobTemplateRequest(d), map[string]string{})
 	if err != nil {
-		return utils.DiagCreate(diagJobTemplateTitle, err)
+		return utils.DiagCreate(diagJobTemplateTitle, err, jobTemplateAttributes)
 	}
 
 	d.SetId(strconv.Itoa(result.ID))
@@ -310,84 +298,97 @@ func resourceJobTemplateUpdate(ctx context.Context, d *schema.ResourceData, m in
 		return diags
 	}
 
+	if diags := checkFeatures(ctx, d, client, jobTemplateFeatures); diags.HasError() {
+		return diags
+	}
+
 	params := make(map[string]string)
-	if _, err := client.JobTemplateService.GetJobTemplateByID(id, params); err != nil {
+	if _, err := client.JobTemplateService.GetJobTemplateByID(ctx, id, params); err != nil {
 		return utils.DiagNotFound(diagJobTemplateTitle, id, err)
 	}
 
-	if _, err := client.JobTemplateService.UpdateJobTemplate(id, map[string]interface{}{
-		"name":                                d.Get("name").(string),
-		"description":                         d.Get("description").(string),
-		"job_type":                            d.Get("job_type").(string),
-		"inventory":                           utils.AtoiDefault(d.Get("inventory_id").(string), nil),
-		"project":                             d.Get("project_id").(int),
-		"playbook":                            d.Get("playbook").(string),
-		"scm_branch":                          d.Get("scm_branch").(string),
-		"forks":                               d.Get("forks").(int),
-		"limit":                               d.Get("limit").(string),
-		"verbosity":                           d.Get("verbosity").(int),
-		"extra_vars":                          d.Get("extra_vars").(string),
-		"job_tags":                            d.Get("job_tags").(string),
-		"force_handlers":                      d.Get("force_handlers").(bool),
-		"skip_tags":                           d.Get("skip_tags").(string),
-		"start_at_task":                       d.Get("start_at_task").(string),
-		"timeout":                             d.Get("timeout").(int),
-		"use_fact_cache":                      d.Get("use_fact_cache").(bool),
-		"host_config_key":                     d.Get("host_config_key").(string),
-		"ask_scm_branch_on_launch":            d.Get("ask_scm_branch_on_launch").(bool),
-		"ask_diff_mode_on_launch":             d.Get("ask_diff_mode_on_launch").(bool),
-		"ask_variables_on_launch":             d.Get("ask_variables_on_launch").(bool),
-		"ask_limit_on_launch":                 d.Get("ask_limit_on_launch").(bool),
-		"ask_tags_on_launch":                  d.Get("ask_tags_on_launch").(bool),
-		"ask_skip_tags_on_launch":             d.Get("ask_skip_tags_on_launch").(bool),
-		"ask_job_type_on_launch":              d.Get("ask_job_type_on_launch").(bool),
-		"ask_verbosity_on_launch":             d.Get("ask_verbosity_on_launch").(bool),
-		"ask_inventory_on_launch":             d.Get("ask_inventory_on_launch").(bool),
-		"ask_credential_on_launch":            d.Get("ask_credential_on_launch").(bool),
-		"ask_execution_environment_on_launch": d.Get("ask_execution_environment_on_launch").(bool),
-		"ask_labels_on_launch":                d.Get("ask_labels_on_launch").(bool),
-		"ask_forks_on_launch":                 d.Get("ask_forks_on_launch").(bool),
-		"ask_job_slice_count_on_launch":       d.Get("ask_job_slice_count_on_launch").(bool),
-		"ask_timeout_on_launch":               d.Get("ask_timeout_on_launch").(bool),
-		"ask_instance_group_on_launch":        d.Get("ask_instance_group_on_launch").(bool),
-		"survey_enabled":                      d.Get("survey_enabled").(bool),
-		"become_enabled":                      d.Get("become_enabled").(bool),
-		"diff_mode":                           d.Get("diff_mode").(bool),
-		"allow_simultaneous":                  d.Get("allow_simultaneous").(bool),
-		"custom_virtualenv":                   utils.AtoiDefault(d.Get("custom_virtualenv").(string), nil),
-		"execution_environment":               utils.AtoiDefault(d.Get("execution_environment").(string), nil),
-		"job_slice_count":                     d.Get("job_slice_count").(int)}, map[string]string{}); err != nil {
-		return utils.DiagUpdate(diagJobTemplateTitle, id, err)
+	if _, err := client.JobTemplateService.UpdateJobTemplateFromRequest(ctx, id, jobTemplateRequest(d), map[string]string{}); err != nil {
+		return utils.DiagUpdate(diagJobTemplateTitle, id, err, jobTemplateAttributes)
 	}
 
 	return resourceJobTemplateRead(ctx, d, m)
 }
 
-func resourceJobTemplateRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+// jobTemplateRequest builds the job template payload from the configuration.
+func jobTemplateRequest(d *schema.ResourceData) *awx.JobTemplateRequest {
+	return &awx.JobTemplateRequest{
+		Name:                            awx.Ptr(d.Get("name").(string)),
+		Description:                     awx.Ptr(d.Get("description").(string)),
+		JobType:                         awx.Ptr(d.Get("job_type").(string)),
+		Inventory:                       optionalIDFromString(d.Get("inventory_id").(string)),
+		Project:                         optionalID(d.Get("project_id").(int)),
+		Playbook:                        awx.Ptr(d.Get("playbook").(string)),
+		ScmBranch:                       awx.Ptr(d.Get("scm_branch").(string)),
+		Forks:                           awx.Ptr(d.Get("forks").(int)),
+		Limit:                           awx.Ptr(d.Get("limit").(string)),
+		Verbosity:                       awx.Ptr(d.Get("verbosity").(int)),
+		ExtraVars:                       awx.Ptr(d.Get("extra_vars").(string)),
+		JobTags:                         awx.Ptr(d.Get("job_tags").(string)),
+		ForceHandlers:                   awx.Ptr(d.Get("force_handlers").(bool)),
+		SkipTags:                        awx.Ptr(d.Get("skip_tags").(string)),
+		StartAtTask:                     awx.Ptr(d.Get("start_at_task").(string)),
+		Timeout:                         awx.Ptr(d.Get("timeout").(int)),
+		UseFactCache:                    awx.Ptr(d.Get("use_fact_cache").(bool)),
+		HostConfigKey:                   awx.Ptr(d.Get("host_config_key").(string)),
+		AskScmBranchOnLaunch:            awx.Ptr(d.Get("ask_scm_branch_on_launch").(bool)),
+		AskDiffModeOnLaunch:             awx.Ptr(d.Get("ask_diff_mode_on_launch").(bool)),
+		AskVariablesOnLaunch:            awx.Ptr(d.Get("ask_variables_on_launch").(bool)),
+		AskLimitOnLaunch:                awx.Ptr(d.Get("ask_limit_on_launch").(bool)),
+		AskTagsOnLaunch:                 awx.Ptr(d.Get("ask_tags_on_launch").(bool)),
+		AskSkipTagsOnLaunch:             awx.Ptr(d.Get("ask_skip_tags_on_launch").(bool)),
+		AskJobTypeOnLaunch:              awx.Ptr(d.Get("ask_job_type_on_launch").(bool)),
+		AskVerbosityOnLaunch:            awx.Ptr(d.Get("ask_verbosity_on_launch").(bool)),
+		AskInventoryOnLaunch:            awx.Ptr(d.Get("ask_inventory_on_launch").(bool)),
+		AskCredentialOnLaunch:           awx.Ptr(d.Get("ask_credential_on_launch").(bool)),
+		AskExecutionEnvironmentOnLaunch: awx.Ptr(d.Get("ask_execution_environment_on_launch").(bool)),
+		AskLabelsOnLaunch:               awx.Ptr(d.Get("ask_labels_on_launch").(bool)),
+		AskForksOnLaunch:                awx.Ptr(d.Get("ask_forks_on_launch").(bool)),
+		AskJobSliceCountOnLaunch:        awx.Ptr(d.Get("ask_job_slice_count_on_launch").(bool)),
+		AskTimeoutOnLaunch:              awx.Ptr(d.Get("ask_timeout_on_launch").(bool)),
+		AskInstanceGroupsOnLaunch:       awx.Ptr(d.Get("ask_instance_group_on_launch").(bool)),
+		SurveyEnabled:                   awx.Ptr(d.Get("survey_enabled").(bool)),
+		BecomeEnabled:                   awx.Ptr(d.Get("become_enabled").(bool)),
+		DiffMode:                        awx.Ptr(d.Get("diff_mode").(bool)),
+		AllowSimultaneous:               awx.Ptr(d.Get("allow_simultaneous").(bool)),
+		CustomVirtualenv:                optionalString(d.Get("custom_virtualenv").(string)),
+		ExecutionEnvironment:            optionalIDFromString(d.Get("execution_environment").(string)),
+		JobSliceCount:                   awx.Ptr(d.Get("job_slice_count").(int)),
+	}
+}
+
+func resourceJobTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, diags := utils.StateIDToInt(diagJobTemplateTitle, d)
 	if diags.HasError() {
 		return diags
 	}
 
-	res, err := client.JobTemplateService.GetJobTemplateByID(id, make(map[string]string))
+	res, err := client.JobTemplateService.GetJobTemplateByID(ctx, id, make(map[string]string))
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		return utils.DiagNotFound(diagJobTemplateTitle, id, err)
 	}
 	if res.ExtraVars != "" {
 		res.ExtraVars = utils.Normalize(res.ExtraVars)
 	}
-	d = setJobTemplateResourceData(d, res)
+	d = setJobTemplateResourceData(ctx, d, res)
 	return nil
 }
 
-func resourceJobTemplateDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceJobTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, diags := utils.StateIDToInt(diagJobTemplateTitle, d)
 	if diags.HasError() {
 		return diags
 	}
-	if _, err := client.JobTemplateService.DeleteJobTemplate(id); err != nil {
+	if _, err := client.JobTemplateService.DeleteJobTemplate(ctx, id); err != nil {
 		return utils.DiagDelete(diagJobTemplateTitle, id, err)
 	}
 	d.SetId("")
@@ -395,126 +396,126 @@ func resourceJobTemplateDelete(_ context.Context, d *schema.ResourceData, m inte
 }
 
 // nolint: gocyclo
-func setJobTemplateResourceData(d *schema.ResourceData, r *awx.JobTemplate) *schema.ResourceData {
+func setJobTemplateResourceData(ctx context.Context, d *schema.ResourceData, r *awx.JobTemplate) *schema.ResourceData {
 	if err := d.Set("allow_simultaneous", r.AllowSimultaneous); err != nil {
-		fmt.Println("Error setting allow_simultaneous", err)
+		tflog.Warn(ctx, "Error setting allow_simultaneous", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("ask_credential_on_launch", r.AskCredentialOnLaunch); err != nil {
-		fmt.Println("Error setting ask_credential_on_launch", err)
+		tflog.Warn(ctx, "Error setting ask_credential_on_launch", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("ask_job_type_on_launch", r.AskJobTypeOnLaunch); err != nil {
-		fmt.Println("Error setting ask_job_type_on_launch", err)
+		tflog.Warn(ctx, "Error setting ask_job_type_on_launch", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("ask_limit_on_launch", r.AskLimitOnLaunch); err != nil {
-		fmt.Println("Error setting ask_limit_on_launch", err)
+		tflog.Warn(ctx, "Error setting ask_limit_on_launch", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("ask_scm_branch_on_launch", r.AskScmBranchOnLaunch); err != nil {
-		fmt.Println("Error setting ask_scm_branch_on_launch", err)
+		tflog.Warn(ctx, "Error setting ask_scm_branch_on_launch", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("ask_skip_tags_on_launch", r.AskSkipTagsOnLaunch); err != nil {
-		fmt.Println("Error setting ask_skip_tags_on_launch", err)
+		tflog.Warn(ctx, "Error setting ask_skip_tags_on_launch", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("ask_tags_on_launch", r.AskTagsOnLaunch); err != nil {
-		fmt.Println("Error setting ask_tags_on_launch", err)
+		tflog.Warn(ctx, "Error setting ask_tags_on_launch", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("ask_variables_on_launch", r.AskVariablesOnLaunch); err != nil {
-		fmt.Println("Error setting ask_variables_on_launch", err)
+		tflog.Warn(ctx, "Error setting ask_variables_on_launch", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("ask_diff_mode_on_launch", r.AskDiffModeOnLaunch); err != nil {
-		fmt.Println("Error setting ask_diff_mode_on_launch", err)
+		tflog.Warn(ctx, "Error setting ask_diff_mode_on_launch", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("ask_limit_on_launch", r.AskLimitOnLaunch); err != nil {
-		fmt.Println("Error setting ask_limit_on_launch", err)
+		tflog.Warn(ctx, "Error setting ask_limit_on_launch", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("ask_tags_on_launch", r.AskTagsOnLaunch); err != nil {
-		fmt.Println("Error setting ask_tags_on_launch", err)
+		tflog.Warn(ctx, "Error setting ask_tags_on_launch", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("ask_verbosity_on_launch", r.AskVerbosityOnLaunch); err != nil {
-		fmt.Println("Error setting ask_verbosity_on_launch", err)
+		tflog.Warn(ctx, "Error setting ask_verbosity_on_launch", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("ask_inventory_on_launch", r.AskInventoryOnLaunch); err != nil {
-		fmt.Println("Error setting ask_inventory_on_launch", err)
+		tflog.Warn(ctx, "Error setting ask_inventory_on_launch", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("ask_execution_environment_on_launch", r.AskExecutionEnvironmentOnLaunch); err != nil {
-		fmt.Println("Error setting ask_execution_environment_on_launch", err)
+		tflog.Warn(ctx, "Error setting ask_execution_environment_on_launch", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("ask_labels_on_launch", r.AskLabelsOnLaunch); err != nil {
-		fmt.Println("Error setting ask_labels_on_launch", err)
+		tflog.Warn(ctx, "Error setting ask_labels_on_launch", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("ask_forks_on_launch", r.AskForksOnLaunch); err != nil {
-		fmt.Println("Error setting ask_forks_on_launch", err)
+		tflog.Warn(ctx, "Error setting ask_forks_on_launch", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("ask_job_slice_count_on_launch", r.AskJobSliceCountOnLaunch); err != nil {
-		fmt.Println("Error setting ask_job_slice_count_on_launch", err)
+		tflog.Warn(ctx, "Error setting ask_job_slice_count_on_launch", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("ask_timeout_on_launch", r.AskTimeoutOnLaunch); err != nil {
-		fmt.Println("Error setting ask_timeout_on_launch", err)
+		tflog.Warn(ctx, "Error setting ask_timeout_on_launch", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("ask_instance_groups_on_launch", r.AskInstanceGroupsOnLaunch); err != nil {
-		fmt.Println("Error setting ask_instance_groups_on_launch", err)
+		tflog.Warn(ctx, "Error setting ask_instance_groups_on_launch", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("description", r.Description); err != nil {
-		fmt.Println("Error setting description", err)
+		tflog.Warn(ctx, "Error setting description", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("extra_vars", utils.Normalize(r.ExtraVars)); err != nil {
-		fmt.Println("Error setting extra_vars", err)
+		tflog.Warn(ctx, "Error setting extra_vars", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("force_handlers", r.ForceHandlers); err != nil {
-		fmt.Println("Error setting force_handlers", err)
+		tflog.Warn(ctx, "Error setting force_handlers", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("forks", r.Forks); err != nil {
-		fmt.Println("Error setting forks", err)
+		tflog.Warn(ctx, "Error setting forks", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("host_config_key", r.HostConfigKey); err != nil {
-		fmt.Println("Error setting host_config_key", err)
+		tflog.Warn(ctx, "Error setting host_config_key", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("inventory_id", r.Inventory); err != nil {
-		fmt.Println("Error setting inventory_id", err)
+		tflog.Warn(ctx, "Error setting inventory_id", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("job_tags", r.JobTags); err != nil {
-		fmt.Println("Error setting job_tags", err)
+		tflog.Warn(ctx, "Error setting job_tags", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("job_type", r.JobType); err != nil {
-		fmt.Println("Error setting job_type", err)
+		tflog.Warn(ctx, "Error setting job_type", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("job_slice_count", r.JobSliceCount); err != nil {
-		fmt.Println("Error setting job_slice_count", err)
+		tflog.Warn(ctx, "Error setting job_slice_count", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("diff_mode", r.DiffMode); err != nil {
-		fmt.Println("Error setting diff_mode", err)
+		tflog.Warn(ctx, "Error setting diff_mode", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("custom_virtualenv", r.CustomVirtualenv); err != nil {
-		fmt.Println("Error setting custom_virtualenv", err)
+		tflog.Warn(ctx, "Error setting custom_virtualenv", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("limit", r.Limit); err != nil {
-		fmt.Println("Error setting limit", err)
+		tflog.Warn(ctx, "Error setting limit", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("name", r.Name); err != nil {
-		fmt.Println("Error setting name", err)
+		tflog.Warn(ctx, "Error setting name", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("become_enabled", r.BecomeEnabled); err != nil {
-		fmt.Println("Error setting become_enabled", err)
+		tflog.Warn(ctx, "Error setting become_enabled", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("use_fact_cache", r.UseFactCache); err != nil {
-		fmt.Println("Error setting use_fact_cache", err)
+		tflog.Warn(ctx, "Error setting use_fact_cache", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("playbook", r.Playbook); err != nil {
-		fmt.Println("Error setting playbook", err)
+		tflog.Warn(ctx, "Error setting playbook", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("project_id", r.Project); err != nil {
-		fmt.Println("Error setting project_id", err)
+		tflog.Warn(ctx, "Error setting project_id", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("skip_tags", r.SkipTags); err != nil {
-		fmt.Println("Error setting skip_tags", err)
+		tflog.Warn(ctx, "Error setting skip_tags", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("start_at_task", r.StartAtTask); err != nil {
-		fmt.Println("Error setting start_at_task", err)
+		tflog.Warn(ctx, "Error setting start_at_task", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("survey_enabled", r.SurveyEnabled); err != nil {
-		fmt.Println("Error setting survey_enabled", err)
+		tflog.Warn(ctx, "Error setting survey_enabled", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("verbosity", r.Verbosity); err != nil {
-		fmt.Println("Error setting verbosity", err)
+		tflog.Warn(ctx, "Error setting verbosity", map[string]interface{}{"error": err})
 	}
 	d.SetId(strconv.Itoa(r.ID))
 	return d
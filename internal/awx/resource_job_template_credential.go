@@ -35,14 +35,14 @@ func resourceJobTemplateCredentials() *schema.Resource {
 	}
 }
 
-func resourceJobTemplateCredentialsCreate(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceJobTemplateCredentialsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	jobTemplateID := d.Get("job_template_id").(int)
-	if _, err := client.JobTemplateService.GetJobTemplateByID(jobTemplateID, make(map[string]string)); err != nil {
+	if _, err := client.JobTemplateService.GetJobTemplateByID(ctx, jobTemplateID, make(map[string]string)); err != nil {
 		return utils.DiagNotFound("JobTemplate Credential", jobTemplateID, err)
 	}
 
-	result, err := client.JobTemplateService.AssociateCredentials(jobTemplateID, map[string]interface{}{
+	result, err := client.JobTemplateService.AssociateCredentials(ctx, jobTemplateID, map[string]interface{}{
 		"id": d.Get("credential_id").(int),
 	}, map[string]string{})
 
@@ -58,15 +58,15 @@ func resourceJobTemplateCredentialsRead(_ context.Context, _ *schema.ResourceDat
 	return nil
 }
 
-func resourceJobTemplateCredentialsDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceJobTemplateCredentialsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	jobTemplateID := d.Get("job_template_id").(int)
-	res, err := client.JobTemplateService.GetJobTemplateByID(jobTemplateID, make(map[string]string))
+	res, err := client.JobTemplateService.GetJobTemplateByID(ctx, jobTemplateID, make(map[string]string))
 	if err != nil {
 		return utils.DiagNotFound("JobTemplate Credential", jobTemplateID, err)
 	}
 
-	if _, err = client.JobTemplateService.DisAssociateCredentials(res.ID, map[string]interface{}{
+	if _, err = client.JobTemplateService.DisAssociateCredentials(ctx, res.ID, map[string]interface{}{
 		"id": d.Get("credential_id").(int),
 	}, map[string]string{}); err != nil {
 		return utils.DiagDelete("JobTemplate DisAssociateCredentials", jobTemplateID, err)
@@ -35,14 +35,14 @@ func resourceJobTemplateInstanceGroups() *schema.Resource {
 	}
 }
 
-func resourceJobTemplateInstanceGroupsCreate(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceJobTemplateInstanceGroupsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	jobTemplateID := d.Get("job_template_id").(int)
-	if _, err := client.JobTemplateService.GetJobTemplateByID(jobTemplateID, make(map[string]string)); err != nil {
+	if _, err := client.JobTemplateService.GetJobTemplateByID(ctx, jobTemplateID, make(map[string]string)); err != nil {
 		return utils.DiagNotFound("JobTemplate Credential", jobTemplateID, err)
 	}
 
-	result, err := client.JobTemplateService.AssociateInstanceGroups(jobTemplateID, map[string]interface{}{
+	result, err := client.JobTemplateService.AssociateInstanceGroups(ctx, jobTemplateID, map[string]interface{}{
 		"id": d.Get("instance_group_id").(int),
 	}, map[string]string{})
 
@@ -58,15 +58,15 @@ func resourceJobTemplateInstanceGroupsRead(_ context.Context, _ *schema.Resource
 	return nil
 }
 
-func resourceJobTemplateInstanceGroupsDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceJobTemplateInstanceGroupsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	jobTemplateID := d.Get("job_template_id").(int)
-	res, err := client.JobTemplateService.GetJobTemplateByID(jobTemplateID, make(map[string]string))
+	res, err := client.JobTemplateService.GetJobTemplateByID(ctx, jobTemplateID, make(map[string]string))
 	if err != nil {
 		return utils.DiagNotFound("JobTemplate Credential", jobTemplateID, err)
 	}
 
-	if _, err = client.JobTemplateService.DisAssociateInstanceGroups(res.ID, map[string]interface{}{
+	if _, err = client.JobTemplateService.DisAssociateInstanceGroups(ctx, res.ID, map[string]interface{}{
 		"id": d.Get("instance_group_id").(int),
 	}, map[string]string{}); err != nil {
 		return utils.DiagDelete("JobTemplate DisAssociateInstanceGroups", jobTemplateID, err)
@@ -47,15 +47,15 @@ func resourceJobTemplateLabel() *schema.Resource {
 	}
 }
 
-func resourceJobTemplateLabelCreate(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceJobTemplateLabelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	jobTemplateID := d.Get("job_template_id").(int)
 
-	if _, err := client.JobTemplateService.GetJobTemplateByID(jobTemplateID, make(map[string]string)); err != nil {
+	if _, err := client.JobTemplateService.GetJobTemplateByID(ctx, jobTemplateID, make(map[string]string)); err != nil {
 		return utils.DiagNotFound(diagJobTemplateLabelTitle, jobTemplateID, err)
 	}
 
-	label, err := client.JobTemplateService.AssociateLabel(jobTemplateID, map[string]interface{}{
+	label, err := client.JobTemplateService.AssociateLabel(ctx, jobTemplateID, map[string]interface{}{
 		"name":         d.Get("name").(string),
 		"organization": d.Get("organization_id").(int),
 	})
@@ -66,15 +66,18 @@ func resourceJobTemplateLabelCreate(_ context.Context, d *schema.ResourceData, m
 	return syncLabelAssociationCreateState(d, "job_template_id", label)
 }
 
-func resourceJobTemplateLabelRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceJobTemplateLabelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	jobTemplateID := d.Get("job_template_id").(int)
 
 	name := d.Get("name").(string)
 	organizationID := d.Get("organization_id").(int)
 
-	labels, err := client.JobTemplateService.ListJobTemplateLabels(jobTemplateID)
+	labels, err := client.JobTemplateService.ListJobTemplateLabels(ctx, jobTemplateID)
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		return utils.DiagNotFound(diagJobTemplateLabelTitle, jobTemplateID, err)
 	}
 
@@ -88,12 +91,12 @@ func resourceJobTemplateLabelRead(_ context.Context, d *schema.ResourceData, m i
 	return nil
 }
 
-func resourceJobTemplateLabelDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceJobTemplateLabelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	jobTemplateID := d.Get("job_template_id").(int)
 
 	if labelID, ok := storedLabelAssociationLabelID(d); ok {
-		if err := client.JobTemplateService.DisAssociateLabel(jobTemplateID, labelID); err != nil {
+		if err := client.JobTemplateService.DisAssociateLabel(ctx, jobTemplateID, labelID); err != nil {
 			return utils.DiagDelete(diagJobTemplateLabelTitle, jobTemplateID, err)
 		}
 
@@ -104,7 +107,7 @@ func resourceJobTemplateLabelDelete(_ context.Context, d *schema.ResourceData, m
 	name := d.Get("name").(string)
 	organizationID := d.Get("organization_id").(int)
 
-	labels, err := client.JobTemplateService.ListJobTemplateLabels(jobTemplateID)
+	labels, err := client.JobTemplateService.ListJobTemplateLabels(ctx, jobTemplateID)
 	if err != nil {
 		return utils.DiagDelete(diagJobTemplateLabelTitle, jobTemplateID, err)
 	}
@@ -115,7 +118,7 @@ func resourceJobTemplateLabelDelete(_ context.Context, d *schema.ResourceData, m
 		return nil
 	}
 
-	if err := client.JobTemplateService.DisAssociateLabel(jobTemplateID, label.ID); err != nil {
+	if err := client.JobTemplateService.DisAssociateLabel(ctx, jobTemplateID, label.ID); err != nil {
 		return utils.DiagDelete(diagJobTemplateLabelTitle, jobTemplateID, err)
 	}
 
@@ -3,11 +3,14 @@ package awx
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
 	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
@@ -57,34 +60,84 @@ func resourceJobTemplateLaunch() *schema.Resource {
 				Required:    false,
 				Optional:    true,
 				Default:     false,
-				Description: "Resource creation will wait for job completion.",
+				Description: "Resource creation will wait for job completion, and fail with the failed tasks and the end of the playbook output when the job does not succeed. The completion is notified by the AWX WebSocket channel, or polled when the channel is unavailable, e.g. with token authentication or through a SOCKS proxy.",
 				ForceNew:    true,
 			},
 		},
 	}
 }
 
-func statusInstanceState(_ context.Context, svc *awx.JobService, id int) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := svc.GetJob(id, map[string]string{})
-		return output, output.Status, err
-	}
-}
+// jobFailureTailLines is the number of lines of playbook output shown when a launched job fails.
+const jobFailureTailLines = 20
 
-func jobTemplateLaunchWait(ctx context.Context, svc *awx.JobService, job *awx.JobLaunch, timeout time.Duration) error {
+// jobTemplateLaunchWait waits for the job to finish, and returns an error describing the failed tasks
+// and the end of the output when the job did not succeed.
+func jobTemplateLaunchWait(ctx context.Context, client *awx.AWX, job *awx.JobLaunch, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"new", "pending", "waiting", "running"},
-		Target:     []string{"successful"},
-		Refresh:    statusInstanceState(ctx, svc, job.ID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
+	// Wait for the status change notification rather than polling the job, which matters when many
+	// launches wait in parallel. Only the status is polled otherwise, the events of a job that did
+	// not succeed are read once it finished.
+	svc := client.JobService
+	finished, err := jobWaitWebSocket(ctx, client, job.ID)
+	if err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("unable to wait for the job: %w", ctx.Err())
+		}
+		tflog.Debug(ctx, "Polling the job, its status is not available over WebSocket", map[string]interface{}{"job": job.ID, "error": err.Error()})
+	}
+	// The events of a job may still be processed when it is notified as finished.
+	if finished == nil || (finished.Status != awx.JobStatusSuccessful && !finished.IsFinished()) {
+		finished, err = svc.PollJob(ctx, job.ID, awx.DefaultJobPollInterval, awx.DefaultJobPollMaxInterval)
+		if err != nil {
+			return fmt.Errorf("unable to wait for the job: %w", err)
+		}
+	}
+	if finished.Status == awx.JobStatusSuccessful {
+		return nil
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	var detail strings.Builder
+	fmt.Fprintf(&detail, "the job ended with status %q", finished.Status)
+	if finished.JobExplanation != "" {
+		fmt.Fprintf(&detail, ": %s", finished.JobExplanation)
+	}
+	events, err := svc.GetFailedJobEvents(ctx, job.ID)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to read the failed tasks of the job", map[string]interface{}{"job": job.ID, "error": err.Error()})
+	}
+	var failedTasks []string
+	for _, event := range events {
+		if event.Stdout != "" && strings.HasPrefix(event.Event, "runner_on_") {
+			failedTasks = append(failedTasks, stripANSI(event.Stdout))
+		}
+	}
+	if len(failedTasks) > 0 {
+		fmt.Fprintf(&detail, "\n\nFailed tasks:\n%s", strings.Join(failedTasks, "\n"))
+	}
+	tail, err := svc.GetJobStdoutTail(ctx, job.ID, jobFailureTailLines)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to read the output of the failed job", map[string]interface{}{"job": job.ID, "error": err.Error()})
+	} else if tail != "" {
+		fmt.Fprintf(&detail, "\n\nEnd of the playbook output:\n%s", tail)
+	}
+	return errors.New(detail.String())
+}
+
+// jobWaitWebSocket waits for the job to finish, notified by the AWX WebSocket channel.
+func jobWaitWebSocket(ctx context.Context, client *awx.AWX, id int) (*awx.Job, error) {
+	stream, err := client.WebSocketService.SubscribeJobStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := stream.Close(ctx); err != nil {
+			tflog.Warn(ctx, "Unable to close the AWX WebSocket channel", map[string]interface{}{"error": err.Error()})
+		}
+	}()
 
-	return err
+	return client.JobService.WaitForJob(ctx, stream, id)
 }
 
 // JobTemplateLaunchData provides payload data used by the JobTemplateLaunch method
@@ -98,7 +151,7 @@ func resourceJobTemplateLaunchCreate(ctx context.Context, d *schema.ResourceData
 	client := m.(*awx.AWX)
 
 	jobTemplateID := d.Get("job_template_id").(int)
-	if _, err := client.JobTemplateService.GetJobTemplateByID(jobTemplateID, make(map[string]string)); err != nil {
+	if _, err := client.JobTemplateService.GetJobTemplateByID(ctx, jobTemplateID, make(map[string]string)); err != nil {
 		return utils.DiagNotFound(diagJobTemplateLaunchTitle, jobTemplateID, err)
 	}
 
@@ -117,7 +170,7 @@ func resourceJobTemplateLaunchCreate(ctx context.Context, d *schema.ResourceData
 		return utils.DiagCreate(diagJobTemplateLaunchTitle, err)
 	}
 
-	res, err := client.JobTemplateService.Launch(jobTemplateID, iData, map[string]string{})
+	res, err := client.JobTemplateService.Launch(ctx, jobTemplateID, iData, map[string]string{})
 	if err != nil {
 		return utils.DiagCreate(diagJobTemplateLaunchTitle, err)
 	}
@@ -126,11 +179,11 @@ func resourceJobTemplateLaunchCreate(ctx context.Context, d *schema.ResourceData
 	d.SetId(strconv.Itoa(res.ID))
 
 	if d.Get("wait_for_completion").(bool) {
-		err = jobTemplateLaunchWait(ctx, client.JobService, res, d.Timeout(schema.TimeoutCreate))
+		err = jobTemplateLaunchWait(ctx, client, res, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return utils.Diagf(
 				"JobTemplate execution failure",
-				"JobTemplateLaunch with ID %d and template ID %d, failed to complete %s", res.ID, d.Get("job_template_id").(int), err.Error(),
+				"JobTemplateLaunch with ID %d and template ID %d, failed to complete: %s", res.ID, d.Get("job_template_id").(int), err.Error(),
 			)
 		}
 	}
@@ -141,13 +194,13 @@ func resourceJobRead(_ context.Context, _ *schema.ResourceData, _ interface{}) d
 	return nil
 }
 
-func resourceJobDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceJobDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	jobID, diags := utils.StateIDToInt("Delete Job", d)
 	if diags.HasError() {
 		return diags
 	}
-	if _, err := client.JobService.GetJob(jobID, map[string]string{}); err != nil {
+	if _, err := client.JobService.GetJob(ctx, jobID, map[string]string{}); err != nil {
 		return utils.DiagNotFound(diagJobTemplateLaunchTitle, jobID, err)
 	}
 
@@ -12,7 +12,7 @@ import (
 
 const diagJobTemplateNotificationTitle = "Job Template - Notification Template"
 
-func getResourceJobTemplateNotificationTemplateAssociateFuncForType(client *awx.JobTemplateNotificationTemplatesService, typ string) func(jobTemplateID int, notificationTemplateID int) (*awx.NotificationTemplate, error) {
+func getResourceJobTemplateNotificationTemplateAssociateFuncForType(client *awx.JobTemplateNotificationTemplatesService, typ string) func(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*awx.NotificationTemplate, error) {
 	switch typ {
 	case "error":
 		return client.AssociateJobTemplateNotificationTemplatesError
@@ -24,7 +24,7 @@ func getResourceJobTemplateNotificationTemplateAssociateFuncForType(client *awx.
 	return nil
 }
 
-func getResourceJobTemplateNotificationTemplateDisassociateFuncForType(client *awx.JobTemplateNotificationTemplatesService, typ string) func(jobTemplateID int, notificationTemplateID int) (*awx.NotificationTemplate, error) {
+func getResourceJobTemplateNotificationTemplateDisassociateFuncForType(client *awx.JobTemplateNotificationTemplatesService, typ string) func(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*awx.NotificationTemplate, error) {
 	switch typ {
 	case "error":
 		return client.DisassociateJobTemplateNotificationTemplatesError
@@ -37,10 +37,10 @@ func getResourceJobTemplateNotificationTemplateDisassociateFuncForType(client *a
 }
 
 func resourceJobTemplateNotificationTemplateCreateForType(typ string) func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	return func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 		client := m.(*awx.AWX)
 		jobTemplateID := d.Get("job_template_id").(int)
-		if _, err := client.JobTemplateService.GetJobTemplateByID(jobTemplateID, make(map[string]string)); err != nil {
+		if _, err := client.JobTemplateService.GetJobTemplateByID(ctx, jobTemplateID, make(map[string]string)); err != nil {
 			return utils.DiagNotFound(diagJobTemplateNotificationTitle, jobTemplateID, err)
 		}
 
@@ -53,7 +53,7 @@ func resourceJobTemplateNotificationTemplateCreateForType(typ string) func(ctx c
 			)
 		}
 
-		result, err := associationFunc(jobTemplateID, notificationTemplateID)
+		result, err := associationFunc(ctx, jobTemplateID, notificationTemplateID)
 		if err != nil {
 			return utils.Diagf(
 				"Create: JobTemplate not AssociateJobTemplateNotificationTemplates",
@@ -72,10 +72,10 @@ func resourceJobTemplateNotificationTemplateRead(_ context.Context, _ *schema.Re
 }
 
 func resourceJobTemplateNotificationTemplateDeleteForType(typ string) func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	return func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 		client := m.(*awx.AWX)
 		jobTemplateID := d.Get("job_template_id").(int)
-		if _, err := client.JobTemplateService.GetJobTemplateByID(jobTemplateID, make(map[string]string)); err != nil {
+		if _, err := client.JobTemplateService.GetJobTemplateByID(ctx, jobTemplateID, make(map[string]string)); err != nil {
 			return utils.DiagNotFound(diagJobTemplateNotificationTitle, jobTemplateID, err)
 		}
 
@@ -88,7 +88,7 @@ func resourceJobTemplateNotificationTemplateDeleteForType(typ string) func(ctx c
 			)
 		}
 
-		if _, err := disassociationFunc(jobTemplateID, notificationTemplateID); err != nil {
+		if _, err := disassociationFunc(ctx, jobTemplateID, notificationTemplateID); err != nil {
 			return utils.Diagf(
 				"Create: JobTemplate not DisassociateJobTemplateNotificationTemplates",
 				"Fail to associate notification_template credentials with ID %v, for job_template ID %v, got error: %s",
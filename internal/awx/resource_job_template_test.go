@@ -0,0 +1,74 @@
+package awx
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceJobTemplate(t *testing.T) {
+	srv := testAccServer(t)
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckDestroy(srv, "awx_job_template", "job_templates"),
+			testAccCheckDestroy(srv, "awx_project", "projects"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceJobTemplateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_project.test", "scm_type", "git"),
+					resource.TestCheckResourceAttrPair("awx_job_template.test", "project_id", "awx_project.test", "id"),
+					resource.TestCheckResourceAttr("awx_job_template.test", "playbook", "site.yml"),
+					resource.TestCheckResourceAttr("awx_job_template.test", "ask_limit_on_launch", "true"),
+					resource.TestCheckResourceAttrSet("awx_job_template_launch.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccResourceJobTemplateConfig = `
+resource "awx_inventory" "test" {
+  name            = "Deployment targets"
+  organization_id = 1
+}
+
+resource "awx_project" "test" {
+  name            = "Playbooks"
+  organization_id = 1
+  scm_type        = "git"
+  scm_url         = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_job_template" "test" {
+  name                = "Deploy"
+  job_type            = "run"
+  inventory_id        = awx_inventory.test.id
+  project_id          = awx_project.test.id
+  playbook            = "site.yml"
+  ask_limit_on_launch = true
+}
+
+resource "awx_job_template_launch" "test" {
+  job_template_id     = awx_job_template.test.id
+  limit               = "web-01.example.com"
+  wait_for_completion = true
+}
+`
+
+func TestAccResourceJobTemplateLaunch_failure(t *testing.T) {
+	srv := testAccServer(t)
+	srv.SetJobStatuses("pending", "running", "failed")
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceJobTemplateConfig,
+				ExpectError: regexp.MustCompile(`(?s)Failed tasks:.*fatal: \[localhost\]: FAILED!.*End of the playbook output:.*PLAY RECAP`),
+			},
+		},
+	})
+}
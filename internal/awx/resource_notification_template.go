@@ -2,10 +2,10 @@ package awx
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
@@ -14,6 +14,13 @@ import (
 
 const diagNotificationTemplateTitle = "Notification Template"
 
+// notificationTemplateAttributes maps the notification template fields to their attributes.
+//
+//nolint:gochecknoglobals
+var notificationTemplateAttributes = utils.AttributeMap{
+	"organization": "organization_id",
+}
+
 func resourceNotificationTemplate() *schema.Resource {
 	return &schema.Resource{
 		Description:   "Resource `awx_notification_template` manages notification templates within an AWX organization.",
@@ -270,30 +277,17 @@ func resourceNotificationTemplate() *schema.Resource {
 				},
 			},
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateIDOrNamedURL("notification_templates"),
+		},
 	}
 }
 
 func resourceNotificationTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
-	payload := map[string]interface{}{
-		"name":              d.Get("name").(string),
-		"description":       d.Get("description").(string),
-		"organization":      d.Get("organization_id").(string),
-		"notification_type": d.Get("notification_type").(string),
-	}
-
-	notificationConfig := d.Get("notification_configuration").(*schema.Set).List()
-	if len(notificationConfig) != 0 {
-		payload["notification_configuration"] = notificationConfig[0].(map[string]interface{})
-	}
-
-	messages := d.Get("messages").(*schema.Set).List()
-	if len(messages) != 0 {
-		payload["messages"] = messages[0].(map[string]interface{})
-	}
-	result, err := client.NotificationTemplatesService.Create(payload, map[string]string{})
+	result, err := client.NotificationTemplatesService.CreateFromRequest(ctx, notificationTemplateRequest(d), map[string]string{})
 	if err != nil {
-		return utils.DiagCreate("NotificationTemplate", err)
+		return utils.DiagCreate("NotificationTemplate", err, notificationTemplateAttributes)
 	}
 
 	d.SetId(strconv.Itoa(result.ID))
@@ -309,80 +303,88 @@ func resourceNotificationTemplateUpdate(ctx context.Context, d *schema.ResourceD
 	}
 
 	params := make(map[string]string)
-	if _, err := client.NotificationTemplatesService.GetByID(id, params); err != nil {
+	if _, err := client.NotificationTemplatesService.GetByID(ctx, id, params); err != nil {
 		return utils.DiagNotFound(diagNotificationTemplateTitle, id, err)
 	}
-	payload := map[string]interface{}{
-		"name":              d.Get("name").(string),
-		"description":       d.Get("description").(string),
-		"organization":      d.Get("organization_id").(string),
-		"notification_type": d.Get("notification_type").(string),
+	if _, err := client.NotificationTemplatesService.UpdateFromRequest(ctx, id, notificationTemplateRequest(d), map[string]string{}); err != nil {
+		return utils.DiagUpdate(diagNotificationTemplateTitle, id, err, notificationTemplateAttributes)
+	}
+	time.Sleep(time.Second * 3)
+	return resourceNotificationTemplateRead(ctx, d, m)
+}
+
+// notificationTemplateRequest builds the notification template payload from the configuration.
+func notificationTemplateRequest(d *schema.ResourceData) *awx.NotificationTemplateRequest {
+	payload := &awx.NotificationTemplateRequest{
+		Name:             awx.Ptr(d.Get("name").(string)),
+		Description:      awx.Ptr(d.Get("description").(string)),
+		Organization:     optionalIDFromString(d.Get("organization_id").(string)),
+		NotificationType: awx.Ptr(d.Get("notification_type").(string)),
 	}
 
 	notificationConfig := d.Get("notification_configuration").(*schema.Set).List()
 	if len(notificationConfig) != 0 {
-		payload["notification_configuration"] = notificationConfig[0].(map[string]interface{})
+		payload.NotificationConfiguration = notificationConfig[0].(map[string]interface{})
 	}
 
 	messages := d.Get("messages").(*schema.Set).List()
 	if len(messages) != 0 {
-		payload["messages"] = messages[0].(map[string]interface{})
-	}
-	if _, err := client.NotificationTemplatesService.Update(id, payload, map[string]string{}); err != nil {
-		return utils.DiagUpdate(diagNotificationTemplateTitle, id, err)
+		payload.Messages = messages[0].(map[string]interface{})
 	}
-	time.Sleep(time.Second * 3)
-	return resourceNotificationTemplateRead(ctx, d, m)
+	return payload
 }
 
-func resourceNotificationTemplateRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceNotificationTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, diags := utils.StateIDToInt("Read notification_template", d)
 	if diags.HasError() {
 		return diags
 	}
 
-	res, err := client.NotificationTemplatesService.GetByID(id, make(map[string]string))
+	res, err := client.NotificationTemplatesService.GetByID(ctx, id, make(map[string]string))
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		return utils.DiagNotFound(diagNotificationTemplateTitle, id, err)
 
 	}
-	d = setNotificationTemplateResourceData(d, res)
+	d = setNotificationTemplateResourceData(ctx, d, res)
 	return nil
 }
 
-func resourceNotificationTemplateDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceNotificationTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, diags := utils.StateIDToInt(diagNotificationTemplateTitle, d)
 	if diags.HasError() {
 		return diags
 	}
 
-	if _, err := client.NotificationTemplatesService.Delete(id); err != nil {
+	if _, err := client.NotificationTemplatesService.Delete(ctx, id); err != nil {
 		return utils.DiagDelete(diagNotificationTemplateTitle, id, err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func setNotificationTemplateResourceData(d *schema.ResourceData, r *awx.NotificationTemplate) *schema.ResourceData {
+func setNotificationTemplateResourceData(ctx context.Context, d *schema.ResourceData, r *awx.NotificationTemplate) *schema.ResourceData {
 	if err := d.Set("name", r.Name); err != nil {
-		fmt.Println("Error setting name", err)
+		tflog.Warn(ctx, "Error setting name", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("description", r.Description); err != nil {
-		fmt.Println("Error setting description", err)
+		tflog.Warn(ctx, "Error setting description", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("organization", r.Organization); err != nil {
-		fmt.Println("Error setting organization", err)
+		tflog.Warn(ctx, "Error setting organization", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("notification_type", r.NotificationType); err != nil {
-		fmt.Println("Error setting notification_type", err)
+		tflog.Warn(ctx, "Error setting notification_type", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("notification_configuration", schema.NewSet(func(i interface{}) int { return len(i.(map[string]interface{})) }, []interface{}{r.NotificationConfiguration})); err != nil {
-		fmt.Println("Error setting notification_configuration", err)
+		tflog.Warn(ctx, "Error setting notification_configuration", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("messages", schema.NewSet(func(i interface{}) int { return len(i.(map[string]interface{})) }, []interface{}{r.Messages})); err != nil {
-		fmt.Println("Error setting messages", err)
+		tflog.Warn(ctx, "Error setting messages", map[string]interface{}{"error": err})
 	}
 	d.SetId(strconv.Itoa(r.ID))
 	return d
@@ -2,9 +2,9 @@ package awx
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
@@ -50,7 +50,7 @@ func resourceOrganization() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: importStateIDOrNamedURL("organizations"),
 		},
 		//
 		//Timeouts: &schema.ResourceTimeout{
@@ -63,12 +63,12 @@ func resourceOrganization() *schema.Resource {
 
 func resourceOrganizationsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
-	result, err := client.OrganizationsService.CreateOrganization(map[string]interface{}{
-		"name":                d.Get("name").(string),
-		"description":         d.Get("description").(string),
-		"max_hosts":           d.Get("max_hosts").(int),
-		"custom_virtualenv":   d.Get("description").(string),
-		"default_environment": d.Get("default_environment").(string),
+	result, err := client.OrganizationsService.CreateOrganizationFromRequest(ctx, &awx.OrganizationRequest{
+		Name:               awx.Ptr(d.Get("name").(string)),
+		Description:        awx.Ptr(d.Get("description").(string)),
+		MaxHosts:           awx.Ptr(d.Get("max_hosts").(int)),
+		CustomVirtualenv:   optionalString(d.Get("custom_virtualenv").(string)),
+		DefaultEnvironment: optionalIDFromString(d.Get("default_environment").(string)),
 	}, map[string]string{})
 	if err != nil {
 		return utils.DiagCreate(diagOrganizationTitle, err)
@@ -86,16 +86,16 @@ func resourceOrganizationsUpdate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	params := make(map[string]string)
-	if _, err := client.OrganizationsService.GetOrganizationsByID(id, params); err != nil {
+	if _, err := client.OrganizationsService.GetOrganizationsByID(ctx, id, params); err != nil {
 		return utils.DiagNotFound(diagOrganizationTitle, id, err)
 	}
 
-	if _, err := client.OrganizationsService.UpdateOrganization(id, map[string]interface{}{
-		"name":                d.Get("name").(string),
-		"description":         d.Get("description").(string),
-		"max_hosts":           d.Get("max_hosts").(int),
-		"custom_virtualenv":   d.Get("description").(string),
-		"default_environment": d.Get("default_environment").(string),
+	if _, err := client.OrganizationsService.UpdateOrganizationFromRequest(ctx, id, &awx.OrganizationRequest{
+		Name:               awx.Ptr(d.Get("name").(string)),
+		Description:        awx.Ptr(d.Get("description").(string)),
+		MaxHosts:           awx.Ptr(d.Get("max_hosts").(int)),
+		CustomVirtualenv:   optionalString(d.Get("custom_virtualenv").(string)),
+		DefaultEnvironment: optionalIDFromString(d.Get("default_environment").(string)),
 	}, map[string]string{}); err != nil {
 		return utils.DiagUpdate(diagOrganizationTitle, id, err)
 	}
@@ -103,48 +103,51 @@ func resourceOrganizationsUpdate(ctx context.Context, d *schema.ResourceData, m
 	return resourceOrganizationsRead(ctx, d, m)
 }
 
-func resourceOrganizationsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceOrganizationsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, diags := utils.StateIDToInt("Read Organizations", d)
 	if diags.HasError() {
 		return diags
 	}
 
-	res, err := client.OrganizationsService.GetOrganizationsByID(id, make(map[string]string))
+	res, err := client.OrganizationsService.GetOrganizationsByID(ctx, id, make(map[string]string))
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		return utils.DiagNotFound(diagOrganizationTitle, id, err)
 
 	}
-	d = setOrganizationsResourceData(d, res)
+	d = setOrganizationsResourceData(ctx, d, res)
 	return nil
 }
 
-func resourceOrganizationsDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceOrganizationsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, diags := utils.StateIDToInt("Delete Organization", d)
 	if diags.HasError() {
 		return diags
 	}
 
-	if _, err := client.OrganizationsService.DeleteOrganization(id); err != nil {
+	if _, err := client.OrganizationsService.DeleteOrganization(ctx, id); err != nil {
 		return utils.DiagDelete(diagOrganizationTitle, id, err)
 	}
 	d.SetId("")
 	return diags
 }
 
-func setOrganizationsResourceData(d *schema.ResourceData, r *awx.Organization) *schema.ResourceData {
+func setOrganizationsResourceData(ctx context.Context, d *schema.ResourceData, r *awx.Organization) *schema.ResourceData {
 	if err := d.Set("name", r.Name); err != nil {
-		fmt.Println("Error setting name", err)
+		tflog.Warn(ctx, "Error setting name", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("description", r.Description); err != nil {
-		fmt.Println("Error setting description", err)
+		tflog.Warn(ctx, "Error setting description", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("max_hosts", r.MaxHosts); err != nil {
-		fmt.Println("Error setting max_hosts", err)
+		tflog.Warn(ctx, "Error setting max_hosts", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("custom_virtualenv", r.CustomVirtualenv); err != nil {
-		fmt.Println("Error setting custom_virtualenv", err)
+		tflog.Warn(ctx, "Error setting custom_virtualenv", map[string]interface{}{"error": err})
 	}
 	d.SetId(strconv.Itoa(r.ID))
 	return d
@@ -37,14 +37,14 @@ func resourceOrganizationsGalaxyCredentials() *schema.Resource {
 	}
 }
 
-func resourceOrganizationsGalaxyCredentialsCreate(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceOrganizationsGalaxyCredentialsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	orgID := d.Get("organization_id").(int)
-	if _, err := client.OrganizationsService.GetOrganizationsByID(orgID, make(map[string]string)); err != nil {
+	if _, err := client.OrganizationsService.GetOrganizationsByID(ctx, orgID, make(map[string]string)); err != nil {
 		return utils.DiagNotFound(diagOrganizationGalaxyCredentialTitle, orgID, err)
 	}
 
-	result, err := client.OrganizationsService.AssociateGalaxyCredentials(orgID, map[string]interface{}{
+	result, err := client.OrganizationsService.AssociateGalaxyCredentials(ctx, orgID, map[string]interface{}{
 		"id": d.Get("credential_id").(int),
 	}, map[string]string{})
 
@@ -60,15 +60,15 @@ func resourceOrganizationsGalaxyCredentialsRead(_ context.Context, _ *schema.Res
 	return nil
 }
 
-func resourceOrganizationsGalaxyCredentialsDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceOrganizationsGalaxyCredentialsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	orgID := d.Get("organization_id").(int)
-	res, err := client.OrganizationsService.GetOrganizationsByID(orgID, make(map[string]string))
+	res, err := client.OrganizationsService.GetOrganizationsByID(ctx, orgID, make(map[string]string))
 	if err != nil {
 		return utils.DiagNotFound(diagOrganizationGalaxyCredentialTitle, orgID, err)
 	}
 
-	if _, err = client.OrganizationsService.DisAssociateGalaxyCredentials(res.ID, map[string]interface{}{
+	if _, err = client.OrganizationsService.DisAssociateGalaxyCredentials(ctx, res.ID, map[string]interface{}{
 		"id": d.Get("credential_id").(int),
 	}, map[string]string{}); err != nil {
 		return utils.DiagDelete(diagOrganizationGalaxyCredentialTitle, orgID, err)
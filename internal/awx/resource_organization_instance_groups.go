@@ -34,17 +34,17 @@ func resourceOrganizationsInstanceGroups() *schema.Resource {
 	}
 }
 
-func resourceOrganizationsInstanceGroupsCreate(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceOrganizationsInstanceGroupsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 	awxService := client.OrganizationsService
 	OrganizationID := d.Get("organization_id").(int)
-	_, err := awxService.GetOrganizationsByID(OrganizationID, make(map[string]string))
+	_, err := awxService.GetOrganizationsByID(ctx, OrganizationID, make(map[string]string))
 	if err != nil {
 		return utils.DiagNotFound("organization", OrganizationID, err)
 	}
 
-	result, err := awxService.AssociateInstanceGroups(OrganizationID, map[string]interface{}{
+	result, err := awxService.AssociateInstanceGroups(ctx, OrganizationID, map[string]interface{}{
 		"id": d.Get("instance_groups_id").(int),
 	}, map[string]string{})
 
@@ -61,17 +61,17 @@ func resourceOrganizationsInstanceGroupsRead(_ context.Context, _ *schema.Resour
 	return diags
 }
 
-func resourceOrganizationsInstanceGroupsDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceOrganizationsInstanceGroupsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 	awxService := client.OrganizationsService
 	OrganizationID := d.Get("organization_id").(int)
-	res, err := awxService.GetOrganizationsByID(OrganizationID, make(map[string]string))
+	res, err := awxService.GetOrganizationsByID(ctx, OrganizationID, make(map[string]string))
 	if err != nil {
 		return utils.DiagNotFound("organization", OrganizationID, err)
 	}
 
-	_, err = awxService.DisAssociateInstanceGroups(res.ID, map[string]interface{}{
+	_, err = awxService.DisAssociateInstanceGroups(ctx, res.ID, map[string]interface{}{
 		"id": d.Get("instance_groups_id").(int),
 	}, map[string]string{})
 	if err != nil {
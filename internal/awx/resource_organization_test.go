@@ -0,0 +1,51 @@
+package awx
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceOrganization(t *testing.T) {
+	srv := testAccServer(t)
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDestroy(srv, "awx_organization", "organizations"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceOrganizationConfig("Operations", 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_organization.test", "id"),
+					resource.TestCheckResourceAttr("awx_organization.test", "name", "Operations"),
+					resource.TestCheckResourceAttr("awx_organization.test", "max_hosts", "0"),
+				),
+			},
+			{
+				Config: testAccResourceOrganizationConfig("Operations", 50),
+				Check:  resource.TestCheckResourceAttr("awx_organization.test", "max_hosts", "50"),
+			},
+			{
+				ResourceName:      "awx_organization.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "awx_organization.test",
+				ImportState:       true,
+				ImportStateId:     "Operations",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceOrganizationConfig(name string, maxHosts int) string {
+	return fmt.Sprintf(`
+resource "awx_organization" "test" {
+  name        = %q
+  description = "Managed by Terraform"
+  max_hosts   = %d
+}
+`, name, maxHosts)
+}
@@ -2,16 +2,22 @@ package awx
 
 import (
 	"context"
-	"fmt"
-	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
 	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
+// scheduleAttributes maps the schedule fields to their attributes.
+//
+//nolint:gochecknoglobals
+var scheduleAttributes = utils.AttributeMap{
+	"unified_job_template": "unified_job_template_id",
+}
+
 func resourceSchedule() *schema.Resource {
 	return &schema.Resource{
 		Description:   "Resource Schedule for AWX (Ansible Tower)",
@@ -60,37 +66,30 @@ func resourceSchedule() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateIDOrNamedURL("schedules"),
 		},
 	}
 }
 
 func resourceScheduleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 	awxService := client.ScheduleService
 
-	scheduleData := map[string]interface{}{
-		"name":                 d.Get("name").(string),
-		"rrule":                d.Get("rrule").(string),
-		"unified_job_template": d.Get("unified_job_template_id").(int),
-		"description":          d.Get("description").(string),
-		"enabled":              d.Get("enabled").(bool),
-		"extra_data":           utils.UnmarshalYAML(d.Get("extra_data").(string)),
+	scheduleData := &awx.ScheduleRequest{
+		Name:               awx.Ptr(d.Get("name").(string)),
+		Rrule:              awx.Ptr(d.Get("rrule").(string)),
+		UnifiedJobTemplate: optionalID(d.Get("unified_job_template_id").(int)),
+		Description:        awx.Ptr(d.Get("description").(string)),
+		Enabled:            awx.Ptr(d.Get("enabled").(bool)),
+		ExtraData:          utils.UnmarshalYAML(d.Get("extra_data").(string)),
 	}
 	if _, ok := d.GetOk("inventory"); ok {
-		scheduleData["inventory"] = d.Get("inventory").(int)
+		scheduleData.Inventory = awx.NewNullable(d.Get("inventory").(int))
 	}
 
-	result, err := awxService.Create(scheduleData, map[string]string{})
+	result, err := awxService.CreateFromRequest(ctx, scheduleData, map[string]string{})
 	if err != nil {
-		log.Printf("Fail to Create Schedule %v", err)
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Unable to create Schedule",
-			Detail:   fmt.Sprintf("Schedule failed to create %s", err.Error()),
-		})
-		return diags
+		return utils.DiagCreate("Schedule", err, scheduleAttributes)
 	}
 
 	d.SetId(strconv.Itoa(result.ID))
@@ -105,80 +104,83 @@ func resourceScheduleUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	}
 
 	params := make(map[string]string)
-	if _, err := client.ScheduleService.GetByID(id, params); err != nil {
+	if _, err := client.ScheduleService.GetByID(ctx, id, params); err != nil {
 		return utils.DiagNotFound("Schedule", id, err)
 	}
 
-	payload := map[string]interface{}{
-		"name":                 d.Get("name").(string),
-		"rrule":                d.Get("rrule").(string),
-		"unified_job_template": d.Get("unified_job_template_id").(int),
-		"description":          d.Get("description").(string),
-		"enabled":              d.Get("enabled").(bool),
-		"extra_data":           utils.UnmarshalYAML(d.Get("extra_data").(string)),
+	payload := &awx.ScheduleRequest{
+		Name:               awx.Ptr(d.Get("name").(string)),
+		Rrule:              awx.Ptr(d.Get("rrule").(string)),
+		UnifiedJobTemplate: optionalID(d.Get("unified_job_template_id").(int)),
+		Description:        awx.Ptr(d.Get("description").(string)),
+		Enabled:            awx.Ptr(d.Get("enabled").(bool)),
+		ExtraData:          utils.UnmarshalYAML(d.Get("extra_data").(string)),
 	}
 	if _, ok := d.GetOk("inventory"); ok {
-		payload["inventory"] = d.Get("inventory").(int)
+		payload.Inventory = awx.NewNullable(d.Get("inventory").(int))
 	}
 
-	if _, err := client.ScheduleService.Update(id, payload, map[string]string{}); err != nil {
-		return utils.DiagUpdate("Schedule", id, err)
+	if _, err := client.ScheduleService.UpdateFromRequest(ctx, id, payload, map[string]string{}); err != nil {
+		return utils.DiagUpdate("Schedule", id, err, scheduleAttributes)
 	}
 
 	return resourceScheduleRead(ctx, d, m)
 }
 
-func resourceScheduleRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceScheduleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, diags := utils.StateIDToInt("Read schedule", d)
 	if diags.HasError() {
 		return diags
 	}
 
-	res, err := client.ScheduleService.GetByID(id, make(map[string]string))
+	res, err := client.ScheduleService.GetByID(ctx, id, make(map[string]string))
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		return utils.DiagNotFound("Schedule", id, err)
 
 	}
-	d = setScheduleResourceData(d, res)
+	d = setScheduleResourceData(ctx, d, res)
 	return nil
 }
 
-func resourceScheduleDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceScheduleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, diags := utils.StateIDToInt(diagHostTitle, d)
 	if diags.HasError() {
 		return diags
 	}
 
-	if _, err := client.ScheduleService.Delete(id); err != nil {
+	if _, err := client.ScheduleService.Delete(ctx, id); err != nil {
 		return utils.DiagDelete("Schedule", id, err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func setScheduleResourceData(d *schema.ResourceData, r *awx.Schedule) *schema.ResourceData {
+func setScheduleResourceData(ctx context.Context, d *schema.ResourceData, r *awx.Schedule) *schema.ResourceData {
 	if err := d.Set("name", r.Name); err != nil {
-		fmt.Println("Error setting name", err)
+		tflog.Warn(ctx, "Error setting name", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("rrule", r.Rrule); err != nil {
-		fmt.Println("Error setting rrule", err)
+		tflog.Warn(ctx, "Error setting rrule", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("unified_job_template_id", r.UnifiedJobTemplate); err != nil {
-		fmt.Println("Error setting unified_job_template_id", err)
+		tflog.Warn(ctx, "Error setting unified_job_template_id", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("description", r.Description); err != nil {
-		fmt.Println("Error setting description", err)
+		tflog.Warn(ctx, "Error setting description", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("enabled", r.Enabled); err != nil {
-		fmt.Println("Error setting enabled", err)
+		tflog.Warn(ctx, "Error setting enabled", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("inventory", r.Inventory); err != nil {
-		fmt.Println("Error setting inventory", err)
+		tflog.Warn(ctx, "Error setting inventory", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("extra_data", utils.MarshalYAML(r.ExtraData)); err != nil {
-		fmt.Println("Error setting extra_data", err)
+		tflog.Warn(ctx, "Error setting extra_data", map[string]interface{}{"error": err})
 	}
 	d.SetId(strconv.Itoa(r.ID))
 	return d
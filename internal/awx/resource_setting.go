@@ -49,7 +49,7 @@ func resourceSetting() *schema.Resource {
 func resourceSettingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 
-	if _, err := client.SettingService.GetSettingsBySlug("all", make(map[string]string)); err != nil {
+	if _, err := client.SettingService.GetSettingsBySlug(ctx, "all", make(map[string]string)); err != nil {
 		return utils.DiagCreate("Settings Update", err)
 	}
 
@@ -78,7 +78,7 @@ func resourceSettingUpdate(ctx context.Context, d *schema.ResourceData, m interf
 		name: formattedValue,
 	}
 
-	if _, err := client.SettingService.UpdateSettings("all", payload, make(map[string]string)); err != nil {
+	if _, err := client.SettingService.UpdateSettings(ctx, "all", payload, make(map[string]string)); err != nil {
 		return utils.DiagUpdate("Settings Update", formattedValue, err)
 	}
 
@@ -86,9 +86,9 @@ func resourceSettingUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	return resourceSettingRead(ctx, d, m)
 }
 
-func resourceSettingRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceSettingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
-	res, err := client.SettingService.GetSettingsBySlug("all", make(map[string]string))
+	res, err := client.SettingService.GetSettingsBySlug(ctx, "all", make(map[string]string))
 	if err != nil {
 		return utils.DiagFetch("Settings Read", "all", err)
 	}
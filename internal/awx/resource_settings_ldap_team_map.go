@@ -86,7 +86,7 @@ func resourceSettingsLDAPTeamMapCreate(ctx context.Context, d *schema.ResourceDa
 	defer ldapTeamMapAccessMutex.Unlock()
 
 	client := m.(*awx.AWX)
-	res, err := client.SettingService.GetSettingsBySlug("ldap", make(map[string]string))
+	res, err := client.SettingService.GetSettingsBySlug(ctx, "ldap", make(map[string]string))
 	if err != nil {
 		return utils.DiagCreate(diagSettingsTitle, err)
 	}
@@ -123,7 +123,7 @@ func resourceSettingsLDAPTeamMapCreate(ctx context.Context, d *schema.ResourceDa
 		"AUTH_LDAP_TEAM_MAP": tMaps,
 	}
 
-	if _, err = client.SettingService.UpdateSettings("ldap", payload, make(map[string]string)); err != nil {
+	if _, err = client.SettingService.UpdateSettings(ctx, "ldap", payload, make(map[string]string)); err != nil {
 		return utils.Diagf(
 			"Create: team map not created",
 			"failed to save team map data, got: %s", err.Error(),
@@ -140,7 +140,7 @@ func resourceSettingsLDAPTeamMapUpdate(ctx context.Context, d *schema.ResourceDa
 	defer ldapTeamMapAccessMutex.Unlock()
 
 	client := m.(*awx.AWX)
-	res, err := client.SettingService.GetSettingsBySlug("ldap", make(map[string]string))
+	res, err := client.SettingService.GetSettingsBySlug(ctx, "ldap", make(map[string]string))
 	if err != nil {
 		return utils.Diagf(
 			"Update: Unable to fetch settings",
@@ -178,7 +178,7 @@ func resourceSettingsLDAPTeamMapUpdate(ctx context.Context, d *schema.ResourceDa
 		"AUTH_LDAP_TEAM_MAP": tMaps,
 	}
 
-	if _, err = client.SettingService.UpdateSettings("ldap", payload, make(map[string]string)); err != nil {
+	if _, err = client.SettingService.UpdateSettings(ctx, "ldap", payload, make(map[string]string)); err != nil {
 		return utils.Diagf(
 			"Update: team map not created",
 			"failed to save team map data, got: %s", err.Error(),
@@ -189,9 +189,9 @@ func resourceSettingsLDAPTeamMapUpdate(ctx context.Context, d *schema.ResourceDa
 	return resourceSettingsLDAPTeamMapRead(ctx, d, m)
 }
 
-func resourceSettingsLDAPTeamMapRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceSettingsLDAPTeamMapRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
-	res, err := client.SettingService.GetSettingsBySlug("ldap", make(map[string]string))
+	res, err := client.SettingService.GetSettingsBySlug(ctx, "ldap", make(map[string]string))
 	if err != nil {
 		return utils.Diagf(
 			"Unable to fetch settings",
@@ -246,13 +246,13 @@ func resourceSettingsLDAPTeamMapRead(_ context.Context, d *schema.ResourceData,
 	return nil
 }
 
-func resourceSettingsLDAPTeamMapDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceSettingsLDAPTeamMapDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ldapTeamMapAccessMutex.Lock()
 	defer ldapTeamMapAccessMutex.Unlock()
 
 	client := m.(*awx.AWX)
 
-	res, err := client.SettingService.GetSettingsBySlug("ldap", make(map[string]string))
+	res, err := client.SettingService.GetSettingsBySlug(ctx, "ldap", make(map[string]string))
 	if err != nil {
 		return utils.Diagf(
 			"Delete: Unable to fetch settings",
@@ -276,7 +276,7 @@ func resourceSettingsLDAPTeamMapDelete(_ context.Context, d *schema.ResourceData
 		"AUTH_LDAP_TEAM_MAP": tmaps,
 	}
 
-	if _, err = client.SettingService.UpdateSettings("ldap", payload, make(map[string]string)); err != nil {
+	if _, err = client.SettingService.UpdateSettings(ctx, "ldap", payload, make(map[string]string)); err != nil {
 		return utils.DiagDelete("team map", id, err)
 	}
 	d.SetId("")
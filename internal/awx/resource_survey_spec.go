@@ -17,6 +17,14 @@ const workflowPrefix = "workflow_"
 const workflowSpecTitlePrefix = "Workflow "
 const diagGenericSurveySpecTitle = "%sJob Template Survey Spec"
 
+// surveySpecAttributes maps the survey spec fields to their attributes: AWX reports every error
+// of the survey under the error field.
+//
+//nolint:gochecknoglobals
+var surveySpecAttributes = utils.AttributeMap{
+	"error": "spec",
+}
+
 var diagSurveySpecTitle = ""
 
 //nolint:funlen
@@ -114,18 +122,21 @@ func resourceSurveySpec(isWorkflow bool) *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateIDOrNamedURL(prefix + "job_templates"),
 		},
 	}
 }
 
-func resourceSurveySpecRead(isWorkflow bool) func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	return func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceSurveySpecRead(isWorkflow bool) func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 		client := m.(*awx.AWX)
 		jobTemplateID := d.Get("job_template_id").(int)
 
-		surveySpec, err := client.SurveySpecService.GetSurveySpec(isWorkflow, jobTemplateID, map[string]string{})
+		surveySpec, err := client.SurveySpecService.GetSurveySpec(ctx, isWorkflow, jobTemplateID, map[string]string{})
 		if err != nil {
+			if removeFromStateIfNotFound(ctx, d, err) {
+				return nil
+			}
 			return utils.DiagNotFound(diagSurveySpecTitle, jobTemplateID, err)
 		}
 
@@ -144,17 +155,17 @@ func resourceSurveySpecRead(isWorkflow bool) func(_ context.Context, d *schema.R
 }
 
 func resourceSurveySpecCreate(isWorkflow bool) func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	return func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 		client := m.(*awx.AWX)
 		jobTemplateID := d.Get("job_template_id").(int)
 
-		_, err := client.SurveySpecService.CreateSurveySpec(isWorkflow, jobTemplateID, map[string]interface{}{
+		_, err := client.SurveySpecService.CreateSurveySpec(ctx, isWorkflow, jobTemplateID, map[string]interface{}{
 			"name":        d.Get("name").(string),
 			"description": d.Get("description").(string),
 			"spec":        d.Get("spec").([]interface{}),
 		})
 		if err != nil {
-			return utils.DiagCreate(diagSurveySpecTitle, err)
+			return utils.DiagCreate(diagSurveySpecTitle, err, surveySpecAttributes)
 		}
 
 		resourceSurveySpecRead(isWorkflow)
@@ -163,12 +174,12 @@ func resourceSurveySpecCreate(isWorkflow bool) func(ctx context.Context, d *sche
 	}
 }
 
-func resourceSurveySpecDelete(isWorkflow bool) func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	return func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceSurveySpecDelete(isWorkflow bool) func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 		client := m.(*awx.AWX)
 		jobTemplateID := d.Get("job_template_id").(int)
 
-		if err := client.SurveySpecService.DeleteSurveySpec(isWorkflow, jobTemplateID); err != nil {
+		if err := client.SurveySpecService.DeleteSurveySpec(ctx, isWorkflow, jobTemplateID); err != nil {
 			return utils.DiagDelete(diagSurveySpecTitle, jobTemplateID, err)
 		}
 		d.SetId("")
@@ -2,16 +2,23 @@ package awx
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
 	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
+// teamAttributes maps the team fields to their attributes.
+//
+//nolint:gochecknoglobals
+var teamAttributes = utils.AttributeMap{
+	"organization": "organization_id",
+}
+
 func resourceTeam() *schema.Resource {
 	return &schema.Resource{
 		Description:   "Resource `awx_team` manages teams within an organization.",
@@ -52,7 +59,7 @@ func resourceTeam() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateIDOrNamedURL("teams"),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -67,7 +74,7 @@ func resourceTeamCreate(ctx context.Context, d *schema.ResourceData, m interface
 	client := m.(*awx.AWX)
 	orgID := d.Get("organization_id").(int)
 	teamName := d.Get("name").(string)
-	_, res, err := client.TeamService.ListTeams(map[string]string{
+	_, res, err := client.TeamService.ListTeams(ctx, map[string]string{
 		"name":         teamName,
 		"organization": strconv.Itoa(orgID),
 	})
@@ -78,20 +85,20 @@ func resourceTeamCreate(ctx context.Context, d *schema.ResourceData, m interface
 		return utils.Diagf("Create: Already exist", "Team with name %s  already exists in the Organization ID %v", teamName, orgID)
 	}
 
-	result, err := client.TeamService.CreateTeam(map[string]interface{}{
-		"name":         teamName,
-		"description":  d.Get("description").(string),
-		"organization": d.Get("organization_id").(int),
+	result, err := client.TeamService.CreateTeamFromRequest(ctx, &awx.TeamRequest{
+		Name:         awx.Ptr(teamName),
+		Description:  awx.Ptr(d.Get("description").(string)),
+		Organization: optionalID(d.Get("organization_id").(int)),
 	}, map[string]string{})
 	if err != nil {
-		return utils.Diagf("Create: Team not created", "Team with name %s  in the Organization ID %v not created, %s", teamName, orgID, err)
+		return utils.DiagCreate("Team", err, teamAttributes)
 	}
 
 	d.SetId(strconv.Itoa(result.ID))
 
 	if rent, entOk := d.GetOk("role_entitlement"); entOk {
 		entset := rent.(*schema.Set).List()
-		if err := roleTeamEntitlementUpdate(m, result.ID, entset, false); err != nil {
+		if err := roleTeamEntitlementUpdate(ctx, m, result.ID, entset, false); err != nil {
 			return utils.Diagf("Create: team role entitlement not created", "Role entitlement for team %s not created: %s", teamName, err)
 		}
 	}
@@ -99,7 +106,7 @@ func resourceTeamCreate(ctx context.Context, d *schema.ResourceData, m interface
 	return resourceTeamRead(ctx, d, m)
 }
 
-func roleTeamEntitlementUpdate(m interface{}, teamID int, roles []interface{}, remove bool) error {
+func roleTeamEntitlementUpdate(ctx context.Context, m interface{}, teamID int, roles []interface{}, remove bool) error {
 	client := m.(*awx.AWX)
 	for _, v := range roles {
 		emap := v.(map[string]interface{})
@@ -110,7 +117,7 @@ func roleTeamEntitlementUpdate(m interface{}, teamID int, roles []interface{}, r
 			payload["disassociate"] = true // presence of key triggers removal
 		}
 
-		if _, err := client.TeamService.UpdateTeamRoleEntitlement(teamID, payload, make(map[string]string)); err != nil {
+		if _, err := client.TeamService.UpdateTeamRoleEntitlement(ctx, teamID, payload, make(map[string]string)); err != nil {
 			return err
 		}
 	}
@@ -139,17 +146,17 @@ func resourceTeamUpdate(ctx context.Context, d *schema.ResourceData, m interface
 		remove := oe.Difference(ne).List()
 		add := ne.Difference(oe).List()
 
-		if err := roleTeamEntitlementUpdate(m, id, remove, true); err != nil {
+		if err := roleTeamEntitlementUpdate(ctx, m, id, remove, true); err != nil {
 			return utils.DiagUpdate("Team Role Entitlement", id, err)
 		}
-		if err := roleTeamEntitlementUpdate(m, id, add, false); err != nil {
+		if err := roleTeamEntitlementUpdate(ctx, m, id, add, false); err != nil {
 			return utils.DiagUpdate("Team Role Entitlement", id, err)
 		}
 	}
-	if _, err := awxService.UpdateTeam(id, map[string]interface{}{
-		"name":         d.Get("name").(string),
-		"description":  d.Get("description").(string),
-		"organization": d.Get("organization_id").(int),
+	if _, err := awxService.UpdateTeamFromRequest(ctx, id, &awx.TeamRequest{
+		Name:         awx.Ptr(d.Get("name").(string)),
+		Description:  awx.Ptr(d.Get("description").(string)),
+		Organization: optionalID(d.Get("organization_id").(int)),
 	}, map[string]string{}); err != nil {
 		return utils.DiagUpdate("Team Role Entitlement", id, err)
 	}
@@ -157,27 +164,30 @@ func resourceTeamUpdate(ctx context.Context, d *schema.ResourceData, m interface
 	return resourceTeamRead(ctx, d, m)
 }
 
-func resourceTeamRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceTeamRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, diags := utils.StateIDToInt("Read Team", d)
 	if diags.HasError() {
 		return diags
 	}
 
-	team, err := client.TeamService.GetTeamByID(id, make(map[string]string))
+	team, err := client.TeamService.GetTeamByID(ctx, id, make(map[string]string))
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		return utils.DiagNotFound("team", id, err)
 	}
-	entitlements, _, err := client.TeamService.ListTeamRoleEntitlements(id, make(map[string]string))
+	entitlements, _, err := client.TeamService.ListTeamRoleEntitlements(ctx, id, make(map[string]string))
 	if err != nil {
 		return utils.DiagNotFound("team roles", id, err)
 	}
 
-	d = setTeamResourceData(d, team, entitlements)
+	d = setTeamResourceData(ctx, d, team, entitlements)
 	return diags
 }
 
-func resourceTeamDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceTeamDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 
 	id, diags := utils.StateIDToInt("Delete Team", d)
@@ -185,22 +195,22 @@ func resourceTeamDelete(_ context.Context, d *schema.ResourceData, m interface{}
 		return diags
 	}
 
-	if _, err := client.TeamService.DeleteTeam(id); err != nil {
+	if _, err := client.TeamService.DeleteTeam(ctx, id); err != nil {
 		return utils.DiagDelete("Team", id, err)
 	}
 	d.SetId("")
 	return diags
 }
 
-func setTeamResourceData(d *schema.ResourceData, r *awx.Team, e []*awx.ApplyRole) *schema.ResourceData {
+func setTeamResourceData(ctx context.Context, d *schema.ResourceData, r *awx.Team, e []*awx.ApplyRole) *schema.ResourceData {
 	if err := d.Set("name", r.Name); err != nil {
-		fmt.Println("Error setting name", err)
+		tflog.Warn(ctx, "Error setting name", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("description", r.Description); err != nil {
-		fmt.Println("Error setting description", err)
+		tflog.Warn(ctx, "Error setting description", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("organization_id", r.Organization); err != nil {
-		fmt.Println("Error setting organization_id", err)
+		tflog.Warn(ctx, "Error setting organization_id", map[string]interface{}{"error": err})
 	}
 
 	var entlist []interface{}
@@ -217,7 +227,7 @@ func setTeamResourceData(d *schema.ResourceData, r *awx.Team, e []*awx.ApplyRole
 	ent := schema.NewSet(f, entlist)
 
 	if err := d.Set("role_entitlement", ent); err != nil {
-		fmt.Println("Error setting role_entitlement", err)
+		tflog.Warn(ctx, "Error setting role_entitlement", map[string]interface{}{"error": err})
 	}
 
 	d.SetId(strconv.Itoa(r.ID))
@@ -0,0 +1,59 @@
+package awx
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceTeam(t *testing.T) {
+	srv := testAccServer(t)
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckDestroy(srv, "awx_team", "teams"),
+			testAccCheckDestroy(srv, "awx_user", "users"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTeamConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_team.test", "name", "Operators"),
+					resource.TestCheckResourceAttr("awx_team.test", "role_entitlement.#", "1"),
+					resource.TestCheckResourceAttr("awx_user.test", "username", "operator"),
+					resource.TestCheckResourceAttr("awx_user.test", "role_entitlement.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccResourceTeamConfig = `
+data "awx_organization" "default" {
+  name = "Default"
+}
+
+data "awx_organization_role" "member" {
+  name            = "Member"
+  organization_id = data.awx_organization.default.id
+}
+
+resource "awx_team" "test" {
+  name            = "Operators"
+  organization_id = data.awx_organization.default.id
+
+  role_entitlement {
+    role_id = data.awx_organization_role.member.id
+  }
+}
+
+resource "awx_user" "test" {
+  username = "operator"
+  password = "operator-password"
+  email    = "operator@example.com"
+
+  role_entitlement {
+    role_id = data.awx_organization_role.member.id
+  }
+}
+`
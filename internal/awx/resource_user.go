@@ -71,7 +71,7 @@ func resourceUser() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateIDOrNamedURL("users"),
 		},
 	}
 }
@@ -80,14 +80,14 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 	client := m.(*awx.AWX)
 	userName := d.Get("username").(string)
 
-	result, err := client.UserService.CreateUser(map[string]interface{}{
-		"username":          userName,
-		"password":          d.Get("password").(string),
-		"first_name":        d.Get("first_name").(string),
-		"last_name":         d.Get("last_name").(string),
-		"email":             d.Get("email").(string),
-		"is_superuser":      d.Get("is_superuser").(bool),
-		"is_system_auditor": d.Get("is_system_auditor").(bool),
+	result, err := client.UserService.CreateUserFromRequest(ctx, &awx.UserRequest{
+		Username:        awx.Ptr(userName),
+		Password:        awx.Ptr(d.Get("password").(string)),
+		FirstName:       awx.Ptr(d.Get("first_name").(string)),
+		LastName:        awx.Ptr(d.Get("last_name").(string)),
+		Email:           awx.Ptr(d.Get("email").(string)),
+		IsSuperuser:     awx.Ptr(d.Get("is_superuser").(bool)),
+		IsSystemAuditor: awx.Ptr(d.Get("is_system_auditor").(bool)),
 	}, map[string]string{})
 	if err != nil {
 		return utils.DiagCreate("Username", err)
@@ -97,7 +97,7 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 
 	if rent, entOk := d.GetOk("role_entitlement"); entOk {
 		entset := rent.(*schema.Set).List()
-		if err := roleUserEntitlementUpdate(m, result.ID, entset, false); err != nil {
+		if err := roleUserEntitlementUpdate(ctx, m, result.ID, entset, false); err != nil {
 			return utils.DiagCreate("Role entitlement", err)
 		}
 	}
@@ -105,7 +105,7 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 	return resourceUserRead(ctx, d, m)
 }
 
-func roleUserEntitlementUpdate(m interface{}, userID int, roles []interface{}, remove bool) error {
+func roleUserEntitlementUpdate(ctx context.Context, m interface{}, userID int, roles []interface{}, remove bool) error {
 	client := m.(*awx.AWX)
 	awxService := client.UserService
 
@@ -118,7 +118,7 @@ func roleUserEntitlementUpdate(m interface{}, userID int, roles []interface{}, r
 			payload["disassociate"] = true // presence of key triggers removal
 		}
 
-		_, err := awxService.UpdateUserRoleEntitlement(userID, payload, make(map[string]string))
+		_, err := awxService.UpdateUserRoleEntitlement(ctx, userID, payload, make(map[string]string))
 		if err != nil {
 			return err
 		}
@@ -146,23 +146,23 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 		remove := oe.Difference(ne).List()
 		add := ne.Difference(oe).List()
 
-		err := roleUserEntitlementUpdate(m, id, remove, true)
+		err := roleUserEntitlementUpdate(ctx, m, id, remove, true)
 		if err != nil {
 			return utils.DiagUpdate("User Role Entitlement", id, err)
 		}
-		err = roleUserEntitlementUpdate(m, id, add, false)
+		err = roleUserEntitlementUpdate(ctx, m, id, add, false)
 		if err != nil {
 			return utils.DiagUpdate("User Role Entitlement", id, err)
 		}
 	}
-	if _, err := client.UserService.UpdateUser(id, map[string]interface{}{
-		"username":          d.Get("username").(string),
-		"password":          d.Get("password").(string),
-		"first_name":        d.Get("first_name").(string),
-		"last_name":         d.Get("last_name").(string),
-		"email":             d.Get("email").(string),
-		"is_superuser":      d.Get("is_superuser").(bool),
-		"is_system_auditor": d.Get("is_system_auditor").(bool),
+	if _, err := client.UserService.UpdateUserFromRequest(ctx, id, &awx.UserRequest{
+		Username:        awx.Ptr(d.Get("username").(string)),
+		Password:        awx.Ptr(d.Get("password").(string)),
+		FirstName:       awx.Ptr(d.Get("first_name").(string)),
+		LastName:        awx.Ptr(d.Get("last_name").(string)),
+		Email:           awx.Ptr(d.Get("email").(string)),
+		IsSuperuser:     awx.Ptr(d.Get("is_superuser").(bool)),
+		IsSystemAuditor: awx.Ptr(d.Get("is_system_auditor").(bool)),
 	}, nil); err != nil {
 		return utils.DiagUpdate("User", id, err)
 	}
@@ -170,17 +170,20 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 	return resourceUserRead(ctx, d, m)
 }
 
-func resourceUserRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	res, err := client.UserService.GetUserByID(id, make(map[string]string))
+	res, err := client.UserService.GetUserByID(ctx, id, make(map[string]string))
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		return utils.DiagNotFound("User", id, err)
 	}
-	entitlements, _, err := client.UserService.ListUserRoleEntitlements(id, make(map[string]string))
+	entitlements, _, err := client.UserService.ListUserRoleEntitlements(ctx, id, make(map[string]string))
 	if err != nil {
 		return utils.DiagNotFound("User Roles", id, err)
 	}
@@ -227,7 +230,7 @@ func resourceUserRead(_ context.Context, d *schema.ResourceData, m interface{})
 	return nil
 }
 
-func resourceUserDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	awxService := client.UserService
 	id, diags := utils.StateIDToInt("Delete User", d)
@@ -236,7 +239,7 @@ func resourceUserDelete(_ context.Context, d *schema.ResourceData, m interface{}
 		return diags
 	}
 
-	if _, err := awxService.DeleteUser(id); err != nil {
+	if _, err := awxService.DeleteUser(ctx, id); err != nil {
 		return utils.DiagDelete("User", id, err)
 	}
 	d.SetId("")
@@ -2,16 +2,24 @@ package awx
 
 import (
 	"context"
-	"fmt"
-	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
 	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
+// workflowJobTemplateAttributes maps the workflow job template fields to their attributes.
+//
+//nolint:gochecknoglobals
+var workflowJobTemplateAttributes = utils.AttributeMap{
+	"organization": "organization_id",
+	"inventory":    "inventory_id",
+	"extra_vars":   "variables",
+}
+
 func resourceWorkflowJobTemplate() *schema.Resource {
 	return &schema.Resource{
 		Description:   "Resource `awx_workflow_job_template` manages workflow job templates within AWX.",
@@ -19,6 +27,7 @@ func resourceWorkflowJobTemplate() *schema.Resource {
 		ReadContext:   resourceWorkflowJobTemplateRead,
 		UpdateContext: resourceWorkflowJobTemplateUpdate,
 		DeleteContext: resourceWorkflowJobTemplateDelete,
+		CustomizeDiff: customizeDiffFeatures(workflowJobTemplateFeatures),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -91,6 +100,33 @@ func resourceWorkflowJobTemplate() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"ask_labels_on_launch": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"ask_tags_on_launch": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"ask_skip_tags_on_launch": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"job_tags": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Tags applied as a prompt, assuming job templates prompt for job tags.",
+			},
+			"skip_tags": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Tags to skip applied as a prompt, assuming job templates prompt for skip tags.",
+			},
 			"webhook_service": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -103,50 +139,37 @@ func resourceWorkflowJobTemplate() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateIDOrNamedURL("workflow_job_templates"),
 		},
 	}
 }
 
-func resourceWorkflowJobTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*awx.AWX)
-	awxService := client.WorkflowJobTemplateService
+// workflowJobTemplateExtendedPromptFields are the prompts on launch added along with awx.FeatureExtendedLaunchPrompts.
+//
+//nolint:gochecknoglobals
+var workflowJobTemplateExtendedPromptFields = []string{
+	"ask_labels_on_launch",
+	"ask_tags_on_launch",
+	"ask_skip_tags_on_launch",
+	"job_tags",
+	"skip_tags",
+}
 
-	payload := map[string]interface{}{
-		"name":                     d.Get("name").(string),
-		"description":              d.Get("description").(string),
-		"organization":             d.Get("organization_id").(int),
-		"inventory":                utils.AtoiDefault(d.Get("inventory_id").(string), nil),
-		"extra_vars":               d.Get("variables").(string),
-		"survey_enabled":           d.Get("survey_enabled").(bool),
-		"allow_simultaneous":       d.Get("allow_simultaneous").(bool),
-		"ask_variables_on_launch":  d.Get("ask_variables_on_launch").(bool),
-		"scm_branch":               d.Get("scm_branch").(string),
-		"ask_inventory_on_launch":  d.Get("ask_inventory_on_launch").(bool),
-		"ask_scm_branch_on_launch": d.Get("ask_scm_branch_on_launch").(bool),
-		"ask_limit_on_launch":      d.Get("ask_limit_on_launch").(bool),
-		"webhook_service":          d.Get("webhook_service").(string),
-		"webhook_credential":       d.Get("webhook_credential").(string),
-	}
+// workflowJobTemplateFeatures rejects the attributes the AWX server does not support.
+func workflowJobTemplateFeatures(d attributeGetter, info *awx.ServerInfo) diag.Diagnostics {
+	return checkFeatureFields(d, info, awx.FeatureExtendedLaunchPrompts, workflowJobTemplateExtendedPromptFields...)
+}
 
-	// Workaround limitation mentioned in https://github.com/ansible/awx/issues/12991
-	limit := d.Get("limit").(string)
-	if limit == "" {
-		payload["limit"] = nil
-	} else {
-		payload["limit"] = limit
+func resourceWorkflowJobTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	if diags := checkFeatures(ctx, d, client, workflowJobTemplateFeatures); diags.HasError() {
+		return diags
 	}
+	awxService := client.WorkflowJobTemplateService
 
-	result, err := awxService.CreateWorkflowJobTemplate(payload, map[string]string{})
+	result, err := awxService.CreateWorkflowJobTemplateFromRequest(ctx, workflowJobTemplateRequest(d), map[string]string{})
 	if err != nil {
-		log.Printf("Fail to Create Template %v", err)
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Unable to create WorkflowJobTemplate",
-			Detail:   fmt.Sprintf("WorkflowJobTemplate with name %s failed to create %s", d.Get("name").(string), err.Error()),
-		})
-		return diags
+		return utils.DiagCreate("Workflow Job Template", err, workflowJobTemplateAttributes)
 	}
 
 	d.SetId(strconv.Itoa(result.ID))
@@ -159,131 +182,154 @@ func resourceWorkflowJobTemplateUpdate(ctx context.Context, d *schema.ResourceDa
 	if diags.HasError() {
 		return diags
 	}
+	if diags := checkFeatures(ctx, d, client, workflowJobTemplateFeatures); diags.HasError() {
+		return diags
+	}
 
 	params := make(map[string]string)
-	if _, err := client.WorkflowJobTemplateService.GetWorkflowJobTemplateByID(id, params); err != nil {
+	if _, err := client.WorkflowJobTemplateService.GetWorkflowJobTemplateByID(ctx, id, params); err != nil {
 		return utils.DiagNotFound("job Workflow template", id, err)
 	}
 
-	payload := map[string]interface{}{
-		"name":                     d.Get("name").(string),
-		"description":              d.Get("description").(string),
-		"organization":             d.Get("organization_id").(int),
-		"inventory":                utils.AtoiDefault(d.Get("inventory_id").(string), nil),
-		"extra_vars":               d.Get("variables").(string),
-		"survey_enabled":           d.Get("survey_enabled").(bool),
-		"allow_simultaneous":       d.Get("allow_simultaneous").(bool),
-		"ask_variables_on_launch":  d.Get("ask_variables_on_launch").(bool),
-		"scm_branch":               d.Get("scm_branch").(string),
-		"ask_inventory_on_launch":  d.Get("ask_inventory_on_launch").(bool),
-		"ask_scm_branch_on_launch": d.Get("ask_scm_branch_on_launch").(bool),
-		"ask_limit_on_launch":      d.Get("ask_limit_on_launch").(bool),
-		"webhook_service":          d.Get("webhook_service").(string),
-		"webhook_credential":       d.Get("webhook_credential").(string),
+	if _, err := client.WorkflowJobTemplateService.UpdateWorkflowJobTemplateFromRequest(ctx, id, workflowJobTemplateRequest(d), map[string]string{}); err != nil {
+		return utils.DiagUpdate("Job Workflow template", d.Get("name").(string), err, workflowJobTemplateAttributes)
 	}
 
-	// Workaround limitation mentioned in https://github.com/ansible/awx/issues/12991
-	limit := d.Get("limit").(string)
-	if limit == "" {
-		payload["limit"] = nil
-	} else {
-		payload["limit"] = limit
-	}
+	return resourceWorkflowJobTemplateRead(ctx, d, m)
+}
 
-	if _, err := client.WorkflowJobTemplateService.UpdateWorkflowJobTemplate(id, payload, map[string]string{}); err != nil {
-		return utils.DiagUpdate("Job Workflow template", d.Get("name").(string), err)
+// workflowJobTemplateRequest builds the workflow job template payload from the configuration.
+func workflowJobTemplateRequest(d *schema.ResourceData) *awx.WorkflowJobTemplateRequest {
+	return &awx.WorkflowJobTemplateRequest{
+		Name:                 awx.Ptr(d.Get("name").(string)),
+		Description:          awx.Ptr(d.Get("description").(string)),
+		Organization:         optionalID(d.Get("organization_id").(int)),
+		Inventory:            optionalIDFromString(d.Get("inventory_id").(string)),
+		ExtraVars:            awx.Ptr(d.Get("variables").(string)),
+		SurveyEnabled:        awx.Ptr(d.Get("survey_enabled").(bool)),
+		AllowSimultaneous:    awx.Ptr(d.Get("allow_simultaneous").(bool)),
+		AskVariablesOnLaunch: awx.Ptr(d.Get("ask_variables_on_launch").(bool)),
+		ScmBranch:            awx.Ptr(d.Get("scm_branch").(string)),
+		AskInventoryOnLaunch: awx.Ptr(d.Get("ask_inventory_on_launch").(bool)),
+		AskScmBranchOnLaunch: awx.Ptr(d.Get("ask_scm_branch_on_launch").(bool)),
+		AskLimitOnLaunch:     awx.Ptr(d.Get("ask_limit_on_launch").(bool)),
+		AskLabelsOnLaunch:    awx.Ptr(d.Get("ask_labels_on_launch").(bool)),
+		AskTagsOnLaunch:      awx.Ptr(d.Get("ask_tags_on_launch").(bool)),
+		AskSkipTagsOnLaunch:  awx.Ptr(d.Get("ask_skip_tags_on_launch").(bool)),
+		JobTags:              awx.Ptr(d.Get("job_tags").(string)),
+		SkipTags:             awx.Ptr(d.Get("skip_tags").(string)),
+		WebhookService:       awx.Ptr(d.Get("webhook_service").(string)),
+		WebhookCredential:    optionalIDFromString(d.Get("webhook_credential").(string)),
+		// Workaround limitation mentioned in https://github.com/ansible/awx/issues/12991
+		Limit: optionalString(d.Get("limit").(string)),
 	}
-
-	return resourceWorkflowJobTemplateRead(ctx, d, m)
 }
 
-func resourceWorkflowJobTemplateRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceWorkflowJobTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, diags := utils.StateIDToInt("Read WorkflowJobTemplate", d)
 	if diags.HasError() {
 		return diags
 	}
 
-	res, err := client.WorkflowJobTemplateService.GetWorkflowJobTemplateByID(id, make(map[string]string))
+	res, err := client.WorkflowJobTemplateService.GetWorkflowJobTemplateByID(ctx, id, make(map[string]string))
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		return utils.DiagNotFound("workflow job template", id, err)
 
 	}
-	d = setWorkflowJobTemplateResourceData(d, res)
+	d = setWorkflowJobTemplateResourceData(ctx, d, res)
 	return nil
 }
 
-func resourceWorkflowJobTemplateDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceWorkflowJobTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, diags := utils.StateIDToInt("Workflow Job Template", d)
 	if diags.HasError() {
 		return diags
 	}
 
-	if _, err := client.WorkflowJobTemplateService.DeleteWorkflowJobTemplate(id); err != nil {
+	if _, err := client.WorkflowJobTemplateService.DeleteWorkflowJobTemplate(ctx, id); err != nil {
 		return utils.DiagDelete("Workflow Job Template", id, err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func setWorkflowJobTemplateResourceData(d *schema.ResourceData, r *awx.WorkflowJobTemplate) *schema.ResourceData {
+func setWorkflowJobTemplateResourceData(ctx context.Context, d *schema.ResourceData, r *awx.WorkflowJobTemplate) *schema.ResourceData {
 
 	if err := d.Set("name", r.Name); err != nil {
-		fmt.Println("Error setting name", err)
+		tflog.Warn(ctx, "Error setting name", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("description", r.Description); err != nil {
-		fmt.Println("Error setting description", err)
+		tflog.Warn(ctx, "Error setting description", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("organization_id", strconv.Itoa(r.Organization)); err != nil {
-		fmt.Println("Error setting organization_id", err)
+		tflog.Warn(ctx, "Error setting organization_id", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("inventory_id", utils.ItoaDefault(r.Inventory, "")); err != nil {
-		fmt.Println("Error setting inventory_id", err)
+		tflog.Warn(ctx, "Error setting inventory_id", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("survey_enabled", r.SurveyEnabled); err != nil {
-		fmt.Println("Error setting survey_enabled", err)
+		tflog.Warn(ctx, "Error setting survey_enabled", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("allow_simultaneous", r.AllowSimultaneous); err != nil {
-		fmt.Println("Error setting allow_simultaneous", err)
+		tflog.Warn(ctx, "Error setting allow_simultaneous", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("ask_variables_on_launch", r.AskVariablesOnLaunch); err != nil {
-		fmt.Println("Error setting ask_variables_on_launch", err)
+		tflog.Warn(ctx, "Error setting ask_variables_on_launch", map[string]interface{}{"error": err})
 	}
 	// Workaround limitation mentioned in https://github.com/ansible/awx/issues/12991
 	if r.Limit != nil {
 		if limitStr, ok := r.Limit.(string); ok {
 			if err := d.Set("limit", limitStr); err != nil {
-				fmt.Println("Error setting limit", err)
+				tflog.Warn(ctx, "Error setting limit", map[string]interface{}{"error": err})
 			}
 		} else {
-			fmt.Println("Error converting limit to string")
+			tflog.Warn(ctx, "Error converting limit to string", map[string]interface{}{"limit": r.Limit})
 		}
 	} else {
 		if err := d.Set("limit", ""); err != nil {
-			fmt.Println("Error setting limit", err)
+			tflog.Warn(ctx, "Error setting limit", map[string]interface{}{"error": err})
 		}
 	}
 	if err := d.Set("scm_branch", r.ScmBranch); err != nil {
-		fmt.Println("Error setting scm_branch", err)
+		tflog.Warn(ctx, "Error setting scm_branch", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("ask_inventory_on_launch", r.AskInventoryOnLaunch); err != nil {
-		fmt.Println("Error setting ask_inventory_on_launch", err)
+		tflog.Warn(ctx, "Error setting ask_inventory_on_launch", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("ask_scm_branch_on_launch", r.AskScmBranchOnLaunch); err != nil {
-		fmt.Println("Error setting ask_scm_branch_on_launch", err)
+		tflog.Warn(ctx, "Error setting ask_scm_branch_on_launch", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("ask_limit_on_launch", r.AskLimitOnLaunch); err != nil {
-		fmt.Println("Error setting ask_limit_on_launch", err)
+		tflog.Warn(ctx, "Error setting ask_limit_on_launch", map[string]interface{}{"error": err})
+	}
+	if err := d.Set("ask_labels_on_launch", r.AskLabelsOnLaunch); err != nil {
+		tflog.Warn(ctx, "Error setting ask_labels_on_launch", map[string]interface{}{"error": err})
+	}
+	if err := d.Set("ask_tags_on_launch", r.AskTagsOnLaunch); err != nil {
+		tflog.Warn(ctx, "Error setting ask_tags_on_launch", map[string]interface{}{"error": err})
+	}
+	if err := d.Set("ask_skip_tags_on_launch", r.AskSkipTagsOnLaunch); err != nil {
+		tflog.Warn(ctx, "Error setting ask_skip_tags_on_launch", map[string]interface{}{"error": err})
+	}
+	if err := d.Set("job_tags", r.JobTags); err != nil {
+		tflog.Warn(ctx, "Error setting job_tags", map[string]interface{}{"error": err})
+	}
+	if err := d.Set("skip_tags", r.SkipTags); err != nil {
+		tflog.Warn(ctx, "Error setting skip_tags", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("webhook_service", r.WebhookService); err != nil {
-		fmt.Println("Error setting webhook_service", err)
+		tflog.Warn(ctx, "Error setting webhook_service", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("webhook_credential", r.WebhookCredential); err != nil {
-		fmt.Println("Error setting webhook_credential", err)
+		tflog.Warn(ctx, "Error setting webhook_credential", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("variables", utils.Normalize(r.ExtraVars)); err != nil {
-		fmt.Println("Error setting variables", err)
+		tflog.Warn(ctx, "Error setting variables", map[string]interface{}{"error": err})
 	}
 
 	d.SetId(strconv.Itoa(r.ID))
@@ -47,15 +47,15 @@ func resourceWorkflowJobTemplateLabel() *schema.Resource {
 	}
 }
 
-func resourceWorkflowJobTemplateLabelCreate(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceWorkflowJobTemplateLabelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	wjtID := d.Get("workflow_job_template_id").(int)
 
-	if _, err := client.WorkflowJobTemplateService.GetWorkflowJobTemplateByID(wjtID, make(map[string]string)); err != nil {
+	if _, err := client.WorkflowJobTemplateService.GetWorkflowJobTemplateByID(ctx, wjtID, make(map[string]string)); err != nil {
 		return utils.DiagNotFound(diagWorkflowJobTemplateLabelTitle, wjtID, err)
 	}
 
-	label, err := client.WorkflowJobTemplateService.AssociateLabel(wjtID, map[string]interface{}{
+	label, err := client.WorkflowJobTemplateService.AssociateLabel(ctx, wjtID, map[string]interface{}{
 		"name":         d.Get("name").(string),
 		"organization": d.Get("organization_id").(int),
 	})
@@ -66,14 +66,17 @@ func resourceWorkflowJobTemplateLabelCreate(_ context.Context, d *schema.Resourc
 	return syncLabelAssociationCreateState(d, "workflow_job_template_id", label)
 }
 
-func resourceWorkflowJobTemplateLabelRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceWorkflowJobTemplateLabelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	wjtID := d.Get("workflow_job_template_id").(int)
 	name := d.Get("name").(string)
 	organizationID := d.Get("organization_id").(int)
 
-	labels, err := client.WorkflowJobTemplateService.ListWorkflowJobTemplateLabels(wjtID)
+	labels, err := client.WorkflowJobTemplateService.ListWorkflowJobTemplateLabels(ctx, wjtID)
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		return utils.DiagNotFound(diagWorkflowJobTemplateLabelTitle, wjtID, err)
 	}
 
@@ -87,12 +90,12 @@ func resourceWorkflowJobTemplateLabelRead(_ context.Context, d *schema.ResourceD
 	return nil
 }
 
-func resourceWorkflowJobTemplateLabelDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceWorkflowJobTemplateLabelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	wjtID := d.Get("workflow_job_template_id").(int)
 
 	if labelID, ok := storedLabelAssociationLabelID(d); ok {
-		if err := client.WorkflowJobTemplateService.DisAssociateLabel(wjtID, labelID); err != nil {
+		if err := client.WorkflowJobTemplateService.DisAssociateLabel(ctx, wjtID, labelID); err != nil {
 			return utils.DiagDelete(diagWorkflowJobTemplateLabelTitle, wjtID, err)
 		}
 
@@ -103,7 +106,7 @@ func resourceWorkflowJobTemplateLabelDelete(_ context.Context, d *schema.Resourc
 	name := d.Get("name").(string)
 	organizationID := d.Get("organization_id").(int)
 
-	labels, err := client.WorkflowJobTemplateService.ListWorkflowJobTemplateLabels(wjtID)
+	labels, err := client.WorkflowJobTemplateService.ListWorkflowJobTemplateLabels(ctx, wjtID)
 	if err != nil {
 		return utils.DiagDelete(diagWorkflowJobTemplateLabelTitle, wjtID, err)
 	}
@@ -114,7 +117,7 @@ func resourceWorkflowJobTemplateLabelDelete(_ context.Context, d *schema.Resourc
 		return nil
 	}
 
-	if err := client.WorkflowJobTemplateService.DisAssociateLabel(wjtID, label.ID); err != nil {
+	if err := client.WorkflowJobTemplateService.DisAssociateLabel(ctx, wjtID, label.ID); err != nil {
 		return utils.DiagDelete(diagWorkflowJobTemplateLabelTitle, wjtID, err)
 	}
 
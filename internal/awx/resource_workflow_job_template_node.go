@@ -2,16 +2,24 @@ package awx
 
 import (
 	"context"
-	"fmt"
-	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
 	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
+// workflowJobTemplateNodeAttributes maps the workflow job template node fields to their attributes.
+//
+//nolint:gochecknoglobals
+var workflowJobTemplateNodeAttributes = utils.AttributeMap{
+	"inventory":             "inventory_id",
+	"workflow_job_template": "workflow_job_template_id",
+	"unified_job_template":  "unified_job_template_id",
+}
+
 func resourceWorkflowJobTemplateNode() *schema.Resource {
 	return &schema.Resource{
 		Description:   "Resource WorkflowJobTemplateNode manages the workflow job template node in AWX.",
@@ -94,33 +102,26 @@ func resourceWorkflowJobTemplateNode() *schema.Resource {
 }
 
 func resourceWorkflowJobTemplateNodeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 	awxService := client.WorkflowJobTemplateNodeService
 
-	result, err := awxService.CreateWorkflowJobTemplateNode(map[string]interface{}{
-		"extra_data":                d.Get("extra_data"),
-		"inventory":                 d.Get("inventory_id").(int),
-		"scm_branch":                d.Get("scm_branch").(string),
-		"skip_tags":                 d.Get("skip_tags").(string),
-		"job_type":                  d.Get("job_type").(string),
-		"job_tags":                  d.Get("job_tags").(string),
-		"limit":                     d.Get("limit").(string),
-		"diff_mode":                 d.Get("diff_mode").(bool),
-		"verbosity":                 d.Get("verbosity").(int),
-		"workflow_job_template":     d.Get("workflow_job_template_id").(int),
-		"unified_job_template":      d.Get("unified_job_template_id").(int),
-		"all_parents_must_converge": d.Get("all_parents_must_converge").(bool),
-		"identifier":                d.Get("identifier").(string),
+	result, err := awxService.CreateWorkflowJobTemplateNodeFromRequest(ctx, &awx.WorkflowJobTemplateNodeRequest{
+		ExtraData:              d.Get("extra_data"),
+		Inventory:              optionalID(d.Get("inventory_id").(int)),
+		ScmBranch:              awx.Ptr(d.Get("scm_branch").(string)),
+		SkipTags:               awx.Ptr(d.Get("skip_tags").(string)),
+		JobType:                awx.Ptr(d.Get("job_type").(string)),
+		JobTags:                awx.Ptr(d.Get("job_tags").(string)),
+		Limit:                  awx.Ptr(d.Get("limit").(string)),
+		DiffMode:               awx.Ptr(d.Get("diff_mode").(bool)),
+		Verbosity:              awx.Ptr(d.Get("verbosity").(int)),
+		WorkflowJobTemplate:    optionalID(d.Get("workflow_job_template_id").(int)),
+		UnifiedJobTemplate:     optionalID(d.Get("unified_job_template_id").(int)),
+		AllParentsMustConverge: awx.Ptr(d.Get("all_parents_must_converge").(bool)),
+		Identifier:             awx.Ptr(d.Get("identifier").(string)),
 	}, map[string]string{})
 	if err != nil {
-		log.Printf("Fail to Create Template %v", err)
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Unable to create WorkflowJobTemplateNode",
-			Detail:   fmt.Sprintf("WorkflowJobTemplateNode with JobTemplateID %d and WorkflowID: %d failed to create %s", d.Get("unified_job_template_id").(int), d.Get("workflow_job_template_id").(int), err.Error()),
-		})
-		return diags
+		return utils.DiagCreate("Workflow Job Template Node", err, workflowJobTemplateNodeAttributes)
 	}
 
 	d.SetId(strconv.Itoa(result.ID))
@@ -135,102 +136,105 @@ func resourceWorkflowJobTemplateNodeUpdate(ctx context.Context, d *schema.Resour
 	}
 
 	params := make(map[string]string)
-	if _, err := client.WorkflowJobTemplateNodeService.GetWorkflowJobTemplateNodeByID(id, params); err != nil {
+	if _, err := client.WorkflowJobTemplateNodeService.GetWorkflowJobTemplateNodeByID(ctx, id, params); err != nil {
 		return utils.DiagNotFound("workflow job template node", id, err)
 	}
 
-	if _, err := client.WorkflowJobTemplateNodeService.UpdateWorkflowJobTemplateNode(id, map[string]interface{}{
-		"extra_data":                d.Get("extra_data"),
-		"inventory":                 d.Get("inventory_id").(int),
-		"scm_branch":                d.Get("scm_branch").(string),
-		"skip_tags":                 d.Get("skip_tags").(string),
-		"job_type":                  d.Get("job_type").(string),
-		"job_tags":                  d.Get("job_tags").(string),
-		"limit":                     d.Get("limit").(string),
-		"diff_mode":                 d.Get("diff_mode").(bool),
-		"verbosity":                 d.Get("verbosity").(int),
-		"workflow_job_template":     d.Get("workflow_job_template_id").(int),
-		"unified_job_template":      d.Get("unified_job_template_id").(int),
-		"all_parents_must_converge": d.Get("all_parents_must_converge").(bool),
-		"identifier":                d.Get("identifier").(string),
+	if _, err := client.WorkflowJobTemplateNodeService.UpdateWorkflowJobTemplateNodeFromRequest(ctx, id, &awx.WorkflowJobTemplateNodeRequest{
+		ExtraData:              d.Get("extra_data"),
+		Inventory:              optionalID(d.Get("inventory_id").(int)),
+		ScmBranch:              awx.Ptr(d.Get("scm_branch").(string)),
+		SkipTags:               awx.Ptr(d.Get("skip_tags").(string)),
+		JobType:                awx.Ptr(d.Get("job_type").(string)),
+		JobTags:                awx.Ptr(d.Get("job_tags").(string)),
+		Limit:                  awx.Ptr(d.Get("limit").(string)),
+		DiffMode:               awx.Ptr(d.Get("diff_mode").(bool)),
+		Verbosity:              awx.Ptr(d.Get("verbosity").(int)),
+		WorkflowJobTemplate:    optionalID(d.Get("workflow_job_template_id").(int)),
+		UnifiedJobTemplate:     optionalID(d.Get("unified_job_template_id").(int)),
+		AllParentsMustConverge: awx.Ptr(d.Get("all_parents_must_converge").(bool)),
+		Identifier:             awx.Ptr(d.Get("identifier").(string)),
 	}, map[string]string{}); err != nil {
-		return utils.DiagUpdate("workflow job template node", d.Get("name").(string), err)
+		return utils.DiagUpdate("workflow job template node", d.Get("name").(string), err, workflowJobTemplateNodeAttributes)
 	}
 
 	return resourceWorkflowJobTemplateNodeRead(ctx, d, m)
 }
 
-func resourceWorkflowJobTemplateNodeRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceWorkflowJobTemplateNodeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, diags := utils.StateIDToInt("Read WorkflowJobTemplateNode", d)
 	if diags.HasError() {
 		return diags
 	}
 
-	res, err := client.WorkflowJobTemplateNodeService.GetWorkflowJobTemplateNodeByID(id, make(map[string]string))
+	res, err := client.WorkflowJobTemplateNodeService.GetWorkflowJobTemplateNodeByID(ctx, id, make(map[string]string))
 	if err != nil {
+		if removeFromStateIfNotFound(ctx, d, err) {
+			return nil
+		}
 		return utils.DiagNotFound("workflow job template node", id, err)
 
 	}
-	d = setWorkflowJobTemplateNodeResourceData(d, res)
+	d = setWorkflowJobTemplateNodeResourceData(ctx, d, res)
 	return nil
 }
 
-func resourceWorkflowJobTemplateNodeDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceWorkflowJobTemplateNodeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	id, diags := utils.StateIDToInt("Workflow Job Template Node", d)
 	if diags.HasError() {
 		return diags
 	}
 
-	if _, err := client.WorkflowJobTemplateNodeService.DeleteWorkflowJobTemplateNode(id); err != nil {
+	if _, err := client.WorkflowJobTemplateNodeService.DeleteWorkflowJobTemplateNode(ctx, id); err != nil {
 		return utils.DiagDelete("workflow job template node", id, err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func setWorkflowJobTemplateNodeResourceData(d *schema.ResourceData, r *awx.WorkflowJobTemplateNode) *schema.ResourceData {
+func setWorkflowJobTemplateNodeResourceData(ctx context.Context, d *schema.ResourceData, r *awx.WorkflowJobTemplateNode) *schema.ResourceData {
 
 	if err := d.Set("extra_data", r.ExtraData); err != nil {
-		fmt.Println("Error setting extra_data", err)
+		tflog.Warn(ctx, "Error setting extra_data", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("inventory_id", strconv.Itoa(r.Inventory)); err != nil {
-		fmt.Println("Error setting inventory_id", err)
+		tflog.Warn(ctx, "Error setting inventory_id", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("scm_branch", r.ScmBranch); err != nil {
-		fmt.Println("Error setting scm_branch", err)
+		tflog.Warn(ctx, "Error setting scm_branch", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("job_type", r.JobType); err != nil {
-		fmt.Println("Error setting job_type", err)
+		tflog.Warn(ctx, "Error setting job_type", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("job_tags", r.JobTags); err != nil {
-		fmt.Println("Error setting job_tags", err)
+		tflog.Warn(ctx, "Error setting job_tags", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("skip_tags", r.SkipTags); err != nil {
-		fmt.Println("Error setting skip_tags", err)
+		tflog.Warn(ctx, "Error setting skip_tags", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("limit", r.Limit); err != nil {
-		fmt.Println("Error setting limit", err)
+		tflog.Warn(ctx, "Error setting limit", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("diff_mode", r.DiffMode); err != nil {
-		fmt.Println("Error setting diff_mode", err)
+		tflog.Warn(ctx, "Error setting diff_mode", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("verbosity", r.Verbosity); err != nil {
-		fmt.Println("Error setting verbosity", err)
+		tflog.Warn(ctx, "Error setting verbosity", map[string]interface{}{"error": err})
 	}
 
 	if err := d.Set("workflow_job_template_id", strconv.Itoa(r.WorkflowJobTemplate)); err != nil {
-		fmt.Println("Error setting workflow_job_template_id", err)
+		tflog.Warn(ctx, "Error setting workflow_job_template_id", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("unified_job_template_id", strconv.Itoa(r.UnifiedJobTemplate)); err != nil {
-		fmt.Println("Error setting unified_job_template_id", err)
+		tflog.Warn(ctx, "Error setting unified_job_template_id", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("all_parents_must_converge", r.AllParentsMustConverge); err != nil {
-		fmt.Println("Error setting all_parents_must_converge", err)
+		tflog.Warn(ctx, "Error setting all_parents_must_converge", map[string]interface{}{"error": err})
 	}
 	if err := d.Set("identifier", r.Identifier); err != nil {
-		fmt.Println("Error setting identifier", err)
+		tflog.Warn(ctx, "Error setting identifier", map[string]interface{}{"error": err})
 	}
 
 	d.SetId(strconv.Itoa(r.ID))
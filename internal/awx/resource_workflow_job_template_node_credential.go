@@ -33,16 +33,16 @@ func resourceWorkflowJobTemplateNodeCredential() *schema.Resource {
 	}
 }
 
-func resourceWorkflowJobTemplateNodeCredentialCreate(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceWorkflowJobTemplateNodeCredentialCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	workflowJobTemplateNodeID := d.Get("workflow_job_template_node_id").(int)
-	res, err := client.WorkflowJobTemplateNodeService.GetWorkflowJobTemplateNodeByID(workflowJobTemplateNodeID, make(map[string]string))
+	res, err := client.WorkflowJobTemplateNodeService.GetWorkflowJobTemplateNodeByID(ctx, workflowJobTemplateNodeID, make(map[string]string))
 
 	if err != nil {
 		return utils.DiagNotFound("Workflow Job Template Node", workflowJobTemplateNodeID, err)
 	}
 
-	if err = client.WorkflowJobTemplateNodeService.AssociateCredential(res.ID, d.Get("credential_id").(int)); err != nil {
+	if err = client.WorkflowJobTemplateNodeService.AssociateCredential(ctx, res.ID, d.Get("credential_id").(int)); err != nil {
 		return utils.DiagCreate("JobTemplate AssociateCredentials", err)
 	}
 
@@ -54,15 +54,15 @@ func resourceWorkflowJobTemplateNodeCredentialRead(_ context.Context, _ *schema.
 	return nil
 }
 
-func resourceWorkflowJobTemplateNodeCredentialDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceWorkflowJobTemplateNodeCredentialDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	workflowJobTemplateNodeID := d.Get("workflow_job_template_node_id").(int)
-	res, err := client.WorkflowJobTemplateNodeService.GetWorkflowJobTemplateNodeByID(workflowJobTemplateNodeID, make(map[string]string))
+	res, err := client.WorkflowJobTemplateNodeService.GetWorkflowJobTemplateNodeByID(ctx, workflowJobTemplateNodeID, make(map[string]string))
 	if err != nil {
 		return utils.DiagNotFound("Workflow Job Template Node", workflowJobTemplateNodeID, err)
 	}
 
-	if err = client.WorkflowJobTemplateNodeService.DisassociateCredential(res.ID, d.Get("credential_id").(int)); err != nil {
+	if err = client.WorkflowJobTemplateNodeService.DisassociateCredential(ctx, res.ID, d.Get("credential_id").(int)); err != nil {
 		return utils.DiagDelete("JobTemplate DisassociateCredentials", workflowJobTemplateNodeID, err)
 	}
 
@@ -41,13 +41,13 @@ func resourceWorkflowJobTemplateNodeLink() *schema.Resource {
 	}
 }
 
-func resourceWorkflowJobTemplateNodeLinkCreate(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceWorkflowJobTemplateNodeLinkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	originNodeID := d.Get("origin_node_id").(int)
 	nextNodeID := d.Get("next_node_id").(int)
 	linkType := d.Get("type").(string)
 
 	client := m.(*awx.AWX)
-	if err := client.WorkflowJobTemplateNodeService.AssociateNode(originNodeID, nextNodeID, linkType); err != nil {
+	if err := client.WorkflowJobTemplateNodeService.AssociateNode(ctx, originNodeID, nextNodeID, linkType); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -59,14 +59,14 @@ func resourceWorkflowJobTemplateNodeLinkRead(_ context.Context, _ *schema.Resour
 	return nil
 }
 
-func resourceWorkflowJobTemplateNodeLinkDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceWorkflowJobTemplateNodeLinkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	originNodeID := d.Get("origin_node_id").(int)
 	nextNodeID := d.Get("next_node_id").(int)
 	linkType := d.Get("type").(string)
 
 	client := m.(*awx.AWX)
 
-	if err := client.WorkflowJobTemplateNodeService.DisassociateNode(originNodeID, nextNodeID, linkType); err != nil {
+	if err := client.WorkflowJobTemplateNodeService.DisassociateNode(ctx, originNodeID, nextNodeID, linkType); err != nil {
 		return diag.FromErr(err)
 	}
 
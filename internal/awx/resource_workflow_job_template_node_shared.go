@@ -2,13 +2,12 @@ package awx
 
 import (
 	"context"
-	"fmt"
-	"log"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
 var workflowJobNodeSchema = map[string]*schema.Schema{
@@ -90,35 +89,28 @@ var workflowJobNodeSchema = map[string]*schema.Schema{
 }
 
 func createNodeForWorkflowJob(ctx context.Context, awxService *awx.WorkflowJobTemplateNodeStepService, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
 	templateNodeID := d.Get("workflow_job_template_node_id").(int)
-	result, err := awxService.CreateWorkflowJobTemplateNodeStep(templateNodeID, map[string]interface{}{
-		"extra_data":            d.Get("extra_data"),
-		"inventory":             d.Get("inventory_id").(int),
-		"scm_branch":            d.Get("scm_branch").(string),
-		"skip_tags":             d.Get("skip_tags").(string),
-		"job_type":              d.Get("job_type").(string),
-		"job_tags":              d.Get("job_tags").(string),
-		"limit":                 d.Get("limit").(string),
-		"diff_mode":             d.Get("diff_mode").(bool),
-		"verbosity":             d.Get("verbosity").(int),
-		"workflow_job_template": d.Get("workflow_job_template_id").(int),
-		"unified_job_template":  d.Get("unified_job_template_id").(int),
+	result, err := awxService.CreateWorkflowJobTemplateNodeStepFromRequest(ctx, templateNodeID, &awx.WorkflowJobTemplateNodeRequest{
+		ExtraData:           d.Get("extra_data"),
+		Inventory:           optionalID(d.Get("inventory_id").(int)),
+		ScmBranch:           awx.Ptr(d.Get("scm_branch").(string)),
+		SkipTags:            awx.Ptr(d.Get("skip_tags").(string)),
+		JobType:             awx.Ptr(d.Get("job_type").(string)),
+		JobTags:             awx.Ptr(d.Get("job_tags").(string)),
+		Limit:               awx.Ptr(d.Get("limit").(string)),
+		DiffMode:            awx.Ptr(d.Get("diff_mode").(bool)),
+		Verbosity:           awx.Ptr(d.Get("verbosity").(int)),
+		WorkflowJobTemplate: optionalID(d.Get("workflow_job_template_id").(int)),
+		UnifiedJobTemplate:  optionalID(d.Get("unified_job_template_id").(int)),
 		//"failure_nodes":         d.Get("failure_nodes").([]interface{}),
 		//"success_nodes":         d.Get("success_nodes").([]interface{}),
 		//"always_nodes":          d.Get("always_nodes").([]interface{}),
 
-		"all_parents_must_converge": d.Get("all_parents_must_converge").(bool),
-		"identifier":                d.Get("identifier").(string),
+		AllParentsMustConverge: awx.Ptr(d.Get("all_parents_must_converge").(bool)),
+		Identifier:             awx.Ptr(d.Get("identifier").(string)),
 	}, map[string]string{})
 	if err != nil {
-		log.Printf("Fail to Create Template %v", err)
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Unable to create WorkflowJobTemplateNodeSuccess",
-			Detail:   fmt.Sprintf("WorkflowJobTemplateNodeSuccess with JobTemplateID %d failed to create %s", d.Get("unified_job_template_id").(int), err.Error()),
-		})
-		return diags
+		return utils.DiagCreate("Workflow Job Template Node", err, workflowJobTemplateNodeAttributes)
 	}
 	d.SetId(strconv.Itoa(result.ID))
 	return resourceWorkflowJobTemplateNodeRead(ctx, d, m)
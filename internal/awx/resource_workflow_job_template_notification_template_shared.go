@@ -10,7 +10,7 @@ import (
 	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
-func getResourceWorkflowJobTemplateNotificationTemplateAssociateFuncForType(client *awx.WorkflowJobTemplateNotificationTemplatesService, typ string) func(workflowJobTemplateID int, notificationTemplateID int) (*awx.NotificationTemplate, error) {
+func getResourceWorkflowJobTemplateNotificationTemplateAssociateFuncForType(client *awx.WorkflowJobTemplateNotificationTemplatesService, typ string) func(ctx context.Context, workflowJobTemplateID int, notificationTemplateID int) (*awx.NotificationTemplate, error) {
 	switch typ {
 	case "error":
 		return client.AssociateWorkflowJobTemplateNotificationTemplatesError
@@ -24,7 +24,7 @@ func getResourceWorkflowJobTemplateNotificationTemplateAssociateFuncForType(clie
 	return nil
 }
 
-func getResourceWorkflowJobTemplateNotificationTemplateDisassociateFuncForType(client *awx.WorkflowJobTemplateNotificationTemplatesService, typ string) func(workflowJobTemplateID int, notificationTemplateID int) (*awx.NotificationTemplate, error) {
+func getResourceWorkflowJobTemplateNotificationTemplateDisassociateFuncForType(client *awx.WorkflowJobTemplateNotificationTemplatesService, typ string) func(ctx context.Context, workflowJobTemplateID int, notificationTemplateID int) (*awx.NotificationTemplate, error) {
 	switch typ {
 	case "error":
 		return client.DisassociateWorkflowJobTemplateNotificationTemplatesError
@@ -39,10 +39,10 @@ func getResourceWorkflowJobTemplateNotificationTemplateDisassociateFuncForType(c
 }
 
 func resourceWorkflowJobTemplateNotificationTemplateCreateForType(typ string) func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	return func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 		client := m.(*awx.AWX)
 		wjtID := d.Get("workflow_job_template_id").(int)
-		if _, err := client.WorkflowJobTemplateService.GetWorkflowJobTemplateByID(wjtID, make(map[string]string)); err != nil {
+		if _, err := client.WorkflowJobTemplateService.GetWorkflowJobTemplateByID(ctx, wjtID, make(map[string]string)); err != nil {
 			return utils.DiagNotFound("Workflow Job Template", wjtID, err)
 		}
 
@@ -55,7 +55,7 @@ func resourceWorkflowJobTemplateNotificationTemplateCreateForType(typ string) fu
 			)
 		}
 
-		result, err := associationFunc(wjtID, ntID)
+		result, err := associationFunc(ctx, wjtID, ntID)
 		if err != nil {
 			return utils.Diagf(
 				"Create: WorkflowJobTemplate not AssociateWorkflowJobTemplateNotificationTemplates",
@@ -74,10 +74,10 @@ func resourceWorkflowJobTemplateNotificationTemplateRead(_ context.Context, _ *s
 }
 
 func resourceWorkflowJobTemplateNotificationTemplateDeleteForType(typ string) func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	return func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 		client := m.(*awx.AWX)
 		wjtID := d.Get("workflow_job_template_id").(int)
-		if _, err := client.WorkflowJobTemplateService.GetWorkflowJobTemplateByID(wjtID, make(map[string]string)); err != nil {
+		if _, err := client.WorkflowJobTemplateService.GetWorkflowJobTemplateByID(ctx, wjtID, make(map[string]string)); err != nil {
 			return utils.DiagNotFound("workflow job template", wjtID, err)
 		}
 
@@ -90,7 +90,7 @@ func resourceWorkflowJobTemplateNotificationTemplateDeleteForType(typ string) fu
 			)
 		}
 
-		if _, err := disassociationFunc(wjtID, ntID); err != nil {
+		if _, err := disassociationFunc(ctx, wjtID, ntID); err != nil {
 			return utils.Diagf(
 				"Create: WorkflowJobTemplate not DisassociateWorkflowJobTemplateNotificationTemplates",
 				"Fail to associate notification_template credentials with ID %v, for job_template ID %v, got error: %s",
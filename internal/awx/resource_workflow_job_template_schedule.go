@@ -3,7 +3,6 @@ package awx
 import (
 	"context"
 	"fmt"
-	"log"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -74,16 +73,15 @@ func resourceWorkflowJobTemplateScheduleCreate(ctx context.Context, d *schema.Re
 
 	workflowJobTemplateID := d.Get("workflow_job_template_id").(int)
 
-	result, err := awxService.CreateWorkflowJobTemplateSchedule(workflowJobTemplateID, map[string]interface{}{
-		"name":        d.Get("name").(string),
-		"rrule":       d.Get("rrule").(string),
-		"description": d.Get("description").(string),
-		"enabled":     d.Get("enabled").(bool),
-		"inventory":   utils.AtoiDefault(d.Get("inventory").(string), nil),
-		"extra_data":  utils.UnmarshalYAML(d.Get("extra_data").(string)),
+	result, err := awxService.CreateWorkflowJobTemplateScheduleFromRequest(ctx, workflowJobTemplateID, &awx.ScheduleRequest{
+		Name:        awx.Ptr(d.Get("name").(string)),
+		Rrule:       awx.Ptr(d.Get("rrule").(string)),
+		Description: awx.Ptr(d.Get("description").(string)),
+		Enabled:     awx.Ptr(d.Get("enabled").(bool)),
+		Inventory:   optionalIDFromString(d.Get("inventory").(string)),
+		ExtraData:   utils.UnmarshalYAML(d.Get("extra_data").(string)),
 	}, map[string]string{})
 	if err != nil {
-		log.Printf("Fail to Create Schedule for WorkflowJobTemplate %d: %v", workflowJobTemplateID, err)
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to create Schedule",
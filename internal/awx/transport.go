@@ -0,0 +1,99 @@
+package awx
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// newTransport clones http.DefaultTransport and applies the TLS and proxy settings of the provider block.
+func newTransport(d *schema.ResourceData, insecure bool) (*http.Transport, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	customTransport := http.DefaultTransport.(*http.Transport).Clone()
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: d.Get("tls_server_name").(string),
+	}
+
+	if insecure {
+		tlsConfig.InsecureSkipVerify = true
+	} else if caPem := d.Get("ca_pem").(string); caPem != "" {
+		caCertPem, err := readPEM(caPem)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read file",
+				Detail:   fmt.Sprintf("Unable to read certificate file located at %s: %s", caPem, err),
+			})
+			return nil, diags
+		}
+		certPool := x509.NewCertPool()
+		if ok := certPool.AppendCertsFromPEM(caCertPem); !ok {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to parse certificate.",
+				Detail:   "Unable to parse certificate. Check that the certificate is in a valid PEM format.",
+			})
+			return nil, diags
+		}
+		tlsConfig.RootCAs = certPool
+	}
+
+	if certPem := d.Get("client_cert_pem").(string); certPem != "" {
+		cert, err := loadClientCertificate(certPem, d.Get("client_key_pem").(string))
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to load client certificate",
+				Detail:   fmt.Sprintf("Unable to load the client certificate and key used for mutual TLS: %s", err),
+			})
+			return nil, diags
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	customTransport.TLSClientConfig = tlsConfig
+
+	if proxyURL := d.Get("proxy_url").(string); proxyURL != "" {
+		proxyFunc := (&httpproxy.Config{
+			HTTPProxy:  proxyURL,
+			HTTPSProxy: proxyURL,
+			NoProxy:    d.Get("no_proxy").(string),
+		}).ProxyFunc()
+		customTransport.Proxy = func(r *http.Request) (*url.URL, error) {
+			return proxyFunc(r.URL)
+		}
+	}
+
+	return customTransport, diags
+}
+
+// readPEM returns value itself when it holds inline PEM content, and the content of the file
+// it points to otherwise.
+func readPEM(value string) ([]byte, error) {
+	if strings.HasPrefix(strings.TrimSpace(value), "-----BEGIN") {
+		return []byte(value), nil
+	}
+	return os.ReadFile(value)
+}
+
+// loadClientCertificate builds the certificate presented to AWX for mutual TLS.
+func loadClientCertificate(certPem, keyPem string) (tls.Certificate, error) {
+	cert, err := readPEM(certPem)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("reading client_cert_pem: %w", err)
+	}
+	key, err := readPEM(keyPem)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("reading client_key_pem: %w", err)
+	}
+	return tls.X509KeyPair(cert, key)
+}
@@ -0,0 +1,151 @@
+package awx
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testCertificate returns a self-signed certificate and its private key, PEM encoded.
+func testCertificate(t *testing.T) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "awx.example.com"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
+
+func Test_newTransport(t *testing.T) {
+	cert, key := testCertificate(t)
+	otherCert, _ := testCertificate(t)
+	certFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(certFile, []byte(cert), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name   string
+		config map[string]interface{}
+		err    string
+		check  func(t *testing.T, tr *http.Transport)
+	}{
+		{
+			name:   "inline CA",
+			config: map[string]interface{}{"ca_pem": cert},
+			check: func(t *testing.T, tr *http.Transport) {
+				if tr.TLSClientConfig.RootCAs == nil {
+					t.Error("Expecting the inline CA to be trusted")
+				}
+			},
+		},
+		{
+			name:   "CA file",
+			config: map[string]interface{}{"ca_pem": certFile},
+			check: func(t *testing.T, tr *http.Transport) {
+				if tr.TLSClientConfig.RootCAs == nil {
+					t.Error("Expecting the CA file to be trusted")
+				}
+			},
+		},
+		{
+			name:   "missing CA file",
+			config: map[string]interface{}{"ca_pem": filepath.Join(t.TempDir(), "missing.pem")},
+			err:    "no such file or directory",
+		},
+		{
+			name:   "invalid CA",
+			config: map[string]interface{}{"ca_pem": "-----BEGIN CERTIFICATE-----\ninvalid\n-----END CERTIFICATE-----\n"},
+			err:    "valid PEM format",
+		},
+		{
+			name:   "client certificate",
+			config: map[string]interface{}{"client_cert_pem": cert, "client_key_pem": key},
+			check: func(t *testing.T, tr *http.Transport) {
+				if len(tr.TLSClientConfig.Certificates) != 1 {
+					t.Errorf("Expecting the client certificate to be presented, got %d certificates", len(tr.TLSClientConfig.Certificates))
+				}
+			},
+		},
+		{
+			name:   "mismatched client certificate and key",
+			config: map[string]interface{}{"client_cert_pem": otherCert, "client_key_pem": key},
+			err:    "private key does not match public key",
+		},
+		{
+			name: "proxy",
+			config: map[string]interface{}{
+				"proxy_url": "http://proxy.example.com:3128",
+				"no_proxy":  ".internal.example.com",
+			},
+			check: func(t *testing.T, tr *http.Transport) {
+				for target, want := range map[string]string{
+					"https://awx.example.com/api/":          "http://proxy.example.com:3128",
+					"https://awx.internal.example.com/api/": "",
+				} {
+					req, err := http.NewRequest(http.MethodGet, target, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+					proxy, err := tr.Proxy(req)
+					if err != nil {
+						t.Fatal(err)
+					}
+					got := ""
+					if proxy != nil {
+						got = proxy.String()
+					}
+					if got != want {
+						t.Errorf("Expecting %s to go through %q but got %q", target, want, got)
+					}
+				}
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, Provider().Schema, tc.config)
+
+			tr, diags := newTransport(d, false)
+			if tc.err != "" {
+				if !diags.HasError() || !strings.Contains(diags[0].Detail, tc.err) {
+					t.Fatalf("Expecting an error containing %q but got %+v", tc.err, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("Unexpected error %+v", diags)
+			}
+			tc.check(t, tr)
+		})
+	}
+}
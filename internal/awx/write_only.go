@@ -0,0 +1,69 @@
+package awx
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// writeOnlySuffix and writeOnlyVersionSuffix name the write-only argument of a secret field, and the
+// argument to change for its value to be sent again to AWX, as Terraform cannot diff write-only values.
+const (
+	writeOnlySuffix        = "_wo"
+	writeOnlyVersionSuffix = "_wo_version"
+)
+
+// withWriteOnlySecrets adds to the credential resource r, for each of the secret fields, the
+// <field>_wo argument setting it without storing it in the state (Terraform 1.11+), and the
+// <field>_wo_version argument triggering its update. A required field becomes required as either.
+func withWriteOnlySecrets(r *schema.Resource, fields ...string) *schema.Resource {
+	for _, field := range fields {
+		s := r.Schema[field]
+		wo := field + writeOnlySuffix
+		s.ConflictsWith = append(s.ConflictsWith, wo)
+		if s.Required {
+			s.Required = false
+			s.Optional = true
+			s.ConflictsWith = nil
+			s.ExactlyOneOf = []string{field, wo}
+		}
+
+		r.Schema[wo] = &schema.Schema{
+			Type:          schema.TypeString,
+			Optional:      true,
+			WriteOnly:     true,
+			ConflictsWith: []string{field},
+			Description:   fmt.Sprintf("Write-only alternative to `%s`, never stored in the state. Requires Terraform 1.11 or later.", field),
+		}
+		r.Schema[field+writeOnlyVersionSuffix] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			RequiredWith: []string{wo},
+			Description:  fmt.Sprintf("Version of `%s`, to be changed for a new value to be applied.", wo),
+		}
+		r.ValidateRawResourceConfigFuncs = append(r.ValidateRawResourceConfigFuncs,
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath(field), cty.GetAttrPath(wo)))
+	}
+	return r
+}
+
+// secretInput returns the value of a secret credential field, taken from its write-only argument
+// when set.
+func secretInput(d *schema.ResourceData, field string) string {
+	if value, ok := writeOnlyString(d, field+writeOnlySuffix); ok {
+		return value
+	}
+	return d.Get(field).(string)
+}
+
+// writeOnlyString returns the value of a write-only string argument, which is only available in the
+// configuration.
+func writeOnlyString(d *schema.ResourceData, name string) (string, bool) {
+	value, diags := d.GetRawConfigAt(cty.GetAttrPath(name))
+	if diags.HasError() || !value.Type().Equals(cty.String) || value.IsNull() || !value.IsKnown() {
+		return "", false
+	}
+	return value.AsString(), true
+}
@@ -0,0 +1,31 @@
+package awx
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func Test_withWriteOnlySecrets_validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		config  map[string]interface{}
+		wantErr bool
+	}{
+		{name: "secret", config: map[string]interface{}{"token": "glpat"}},
+		{name: "write-only secret", config: map[string]interface{}{"token_wo": "glpat", "token_wo_version": 1}},
+		{name: "neither", config: map[string]interface{}{}, wantErr: true},
+		{name: "both", config: map[string]interface{}{"token": "glpat", "token_wo": "glpat"}, wantErr: true},
+		{name: "version without write-only secret", config: map[string]interface{}{"token": "glpat", "token_wo_version": 1}, wantErr: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.config["name"] = "gitlab"
+			tc.config["organization_id"] = 1
+			diags := resourceCredentialGitlab().Validate(terraform.NewResourceConfigRaw(tc.config))
+			if diags.HasError() != tc.wantErr {
+				t.Errorf("Expecting an error: %t, got %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
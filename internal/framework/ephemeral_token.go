@@ -0,0 +1,239 @@
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+// tokenPrivateKey is the private state key holding the ID of the token, for Renew and Close.
+const tokenPrivateKey = "token"
+
+// tokenRenewMargin is how long before its expiration a token is checked again.
+const tokenRenewMargin = 5 * time.Minute
+
+var (
+	_ ephemeral.EphemeralResource                   = &tokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &tokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &tokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithRenew          = &tokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose          = &tokenEphemeralResource{}
+)
+
+// tokenEphemeralResource issues an OAuth2 access token for the duration of a Terraform run: a
+// personal access token of the provider user, or a token of an application.
+type tokenEphemeralResource struct {
+	client *awx.AWX
+}
+
+// tokenEphemeralResourceModel is the configuration and the result of awx_token.
+type tokenEphemeralResourceModel struct {
+	ApplicationID types.Int64  `tfsdk:"application_id"`
+	Description   types.String `tfsdk:"description"`
+	Scope         types.String `tfsdk:"scope"`
+	ID            types.Int64  `tfsdk:"id"`
+	Token         types.String `tfsdk:"token"`
+	Expires       types.String `tfsdk:"expires"`
+}
+
+// tokenPrivateState is what Renew and Close need to know about the token.
+type tokenPrivateState struct {
+	ID int `json:"id"`
+}
+
+// NewTokenEphemeralResource returns the awx_token ephemeral resource.
+func NewTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &tokenEphemeralResource{}
+}
+
+// Metadata implements ephemeral.EphemeralResource.
+func (r *tokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_token"
+}
+
+// Schema implements ephemeral.EphemeralResource.
+func (r *tokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates an OAuth2 access token for the provider user when Terraform opens it, and deletes it " +
+			"when Terraform closes it. The token is never stored in the plan or the state.",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "ID of the application issuing the token. A personal access token is created when unset.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description of the token.",
+			},
+			"scope": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Scope of the token, `read` or `write`. Defaults to `write`.",
+			},
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "ID of the token.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The access token, to be sent as a bearer token.",
+			},
+			"expires": schema.StringAttribute{
+				Computed:    true,
+				Description: "Expiration time of the token, in RFC 3339 format. Null when the token does not expire.",
+			},
+		},
+	}
+}
+
+// Configure implements ephemeral.EphemeralResourceWithConfigure.
+func (r *tokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*awx.AWX)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("Expecting an *awx.AWX client, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+// ValidateConfig implements ephemeral.EphemeralResourceWithValidateConfig.
+func (r *tokenEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var config tokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Scope.IsNull() || config.Scope.IsUnknown() {
+		return
+	}
+	if scope := config.Scope.ValueString(); scope != "read" && scope != "write" {
+		resp.Diagnostics.AddAttributeError(path.Root("scope"), "Invalid token scope",
+			fmt.Sprintf("Expecting read or write, got %q.", scope))
+	}
+}
+
+// Open implements ephemeral.EphemeralResource, creating the token.
+func (r *tokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data tokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || !checkClient(r.client, &resp.Diagnostics) {
+		return
+	}
+
+	payload := map[string]interface{}{
+		"description": data.Description.ValueString(),
+		"scope":       "write",
+	}
+	if !data.Scope.IsNull() {
+		payload["scope"] = data.Scope.ValueString()
+	}
+
+	var token *awx.Token
+	var err error
+	if data.ApplicationID.IsNull() {
+		token, err = r.client.TokenService.CreateToken(ctx, payload, nil)
+	} else {
+		token, err = r.client.ApplicationService.CreateApplicationToken(ctx, int(data.ApplicationID.ValueInt64()), payload, nil)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create the token", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "created token", map[string]interface{}{"token_id": token.ID, "expires": token.Expires})
+
+	data.ID = types.Int64Value(int64(token.ID))
+	data.Token = types.StringValue(token.Token)
+	data.Scope = types.StringValue(token.Scope)
+	data.Expires = types.StringNull()
+	if !token.Expires.IsZero() {
+		data.Expires = types.StringValue(token.Expires.Format(time.RFC3339))
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+
+	private, err := json.Marshal(tokenPrivateState{ID: token.ID})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to save the token ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, tokenPrivateKey, private)...)
+	resp.RenewAt = tokenRenewAt(token)
+}
+
+// Renew implements ephemeral.EphemeralResourceWithRenew. AWX cannot extend the lifetime of a
+// token, so Renew checks that the token has not been revoked, and warns once when it is about to
+// expire.
+func (r *tokenEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	state, ok := readTokenPrivateState(ctx, req.Private, &resp.Diagnostics)
+	if !ok || !checkClient(r.client, &resp.Diagnostics) {
+		return
+	}
+
+	token, err := r.client.TokenService.GetToken(ctx, state.ID, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to renew the token", fmt.Sprintf("Token %d: %s", state.ID, err))
+		return
+	}
+	if !token.Expires.IsZero() && time.Until(token.Expires) <= tokenRenewMargin {
+		resp.Diagnostics.AddWarning("Token about to expire",
+			fmt.Sprintf("Token %d expires at %s and cannot be extended by AWX.", token.ID, token.Expires.Format(time.RFC3339)))
+		return
+	}
+	resp.RenewAt = tokenRenewAt(token)
+}
+
+// Close implements ephemeral.EphemeralResourceWithClose, deleting the token.
+func (r *tokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	state, ok := readTokenPrivateState(ctx, req.Private, &resp.Diagnostics)
+	if !ok || !checkClient(r.client, &resp.Diagnostics) {
+		return
+	}
+
+	if err := r.client.TokenService.DeleteToken(ctx, state.ID); err != nil && !awx.IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to delete the token", fmt.Sprintf("Token %d: %s", state.ID, err))
+		return
+	}
+	tflog.Debug(ctx, "deleted token", map[string]interface{}{"token_id": state.ID})
+}
+
+// tokenRenewAt returns when Terraform must renew token, shortly before it expires. Tokens without
+// an expiration time are never renewed.
+func tokenRenewAt(token *awx.Token) time.Time {
+	if token.Expires.IsZero() {
+		return time.Time{}
+	}
+	return token.Expires.Add(-tokenRenewMargin)
+}
+
+// privateState reads the private data of an ephemeral resource.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// readTokenPrivateState returns the token saved by Open.
+func readTokenPrivateState(ctx context.Context, private privateState, diags *diag.Diagnostics) (tokenPrivateState, bool) {
+	var state tokenPrivateState
+	raw, d := private.GetKey(ctx, tokenPrivateKey)
+	diags.Append(d...)
+	if diags.HasError() {
+		return state, false
+	}
+	if len(raw) == 0 {
+		diags.AddError("Missing token ID", "The ID of the token was not saved when it was created.")
+		return state, false
+	}
+	if err := json.Unmarshal(raw, &state); err != nil {
+		diags.AddError("Unable to read the token ID", err.Error())
+		return state, false
+	}
+	return state, true
+}
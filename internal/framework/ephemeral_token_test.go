@@ -0,0 +1,27 @@
+package framework
+
+import (
+	"testing"
+	"time"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+func Test_tokenRenewAt(t *testing.T) {
+	expires := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name    string
+		expires time.Time
+		want    time.Time
+	}{
+		{name: "expiring", expires: expires, want: expires.Add(-tokenRenewMargin)},
+		{name: "never expiring", expires: time.Time{}, want: time.Time{}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tokenRenewAt(&awx.Token{Expires: tc.expires}); !got.Equal(tc.want) {
+				t.Errorf("Expecting %s but got %s", tc.want, got)
+			}
+		})
+	}
+}
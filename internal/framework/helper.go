@@ -0,0 +1,109 @@
+package framework
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+// Default timeouts of the resource operations, as set by the SDKv2 resources before their port.
+const (
+	defaultCreateTimeout = time.Minute
+	defaultUpdateTimeout = time.Minute
+	defaultDeleteTimeout = 5 * time.Minute
+)
+
+// checkClient reports an error when the provider has not configured the AWX client, e.g. when
+// Terraform calls a resource before the provider could be configured.
+func checkClient(client *awx.AWX, diags *diag.Diagnostics) bool {
+	if client == nil {
+		diags.AddError("Unconfigured AWX client",
+			"The provider has not been configured yet. Please report this issue to the provider developers.")
+		return false
+	}
+	return true
+}
+
+// stateID returns the numeric ID of an AWX object stored as a string in the state.
+func stateID(id types.String, diags *diag.Diagnostics) (int, bool) {
+	value, err := strconv.Atoi(id.ValueString())
+	if err != nil {
+		diags.AddError("Invalid ID", fmt.Sprintf("Expecting a numeric AWX ID in the state, got %q.", id.ValueString()))
+		return 0, false
+	}
+	return value, true
+}
+
+// nullableID returns the request value of an optional foreign key: a null attribute clears it.
+func nullableID(id types.Int64) *awx.Nullable[int] {
+	if id.IsNull() || id.IsUnknown() {
+		return awx.Null[int]()
+	}
+	return awx.NewNullable(int(id.ValueInt64()))
+}
+
+// idValue returns the attribute value of an optional foreign key read from AWX, where 0 stands for
+// no object.
+func idValue(id int) types.Int64 {
+	if id == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(id))
+}
+
+// timeoutsModel is the timeouts block of the resources ported from the SDKv2 provider.
+type timeoutsModel struct {
+	Create types.String `tfsdk:"create"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+}
+
+// timeoutsBlock returns the timeouts block the SDKv2 provider declared for the resource, so that
+// the configurations and states written before its port remain valid.
+func timeoutsBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Attributes: map[string]schema.Attribute{
+			"create": schema.StringAttribute{Optional: true},
+			"update": schema.StringAttribute{Optional: true},
+			"delete": schema.StringAttribute{Optional: true},
+		},
+	}
+}
+
+func (t *timeoutsModel) create() time.Duration {
+	if t == nil {
+		return defaultCreateTimeout
+	}
+	return parseTimeout(t.Create, defaultCreateTimeout)
+}
+
+func (t *timeoutsModel) update() time.Duration {
+	if t == nil {
+		return defaultUpdateTimeout
+	}
+	return parseTimeout(t.Update, defaultUpdateTimeout)
+}
+
+func (t *timeoutsModel) delete() time.Duration {
+	if t == nil {
+		return defaultDeleteTimeout
+	}
+	return parseTimeout(t.Delete, defaultDeleteTimeout)
+}
+
+// parseTimeout returns the duration of a timeout attribute, or fallback when unset or invalid.
+func parseTimeout(value types.String, fallback time.Duration) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return fallback
+	}
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		return fallback
+	}
+	return d
+}
@@ -0,0 +1,189 @@
+// Package framework implements the resources of the AWX provider ported to terraform-plugin-framework.
+// It is served alongside the SDKv2 provider of internal/awx by internal/provider, and shares its
+// provider block and AWX client, so that resources can be ported one at a time.
+package framework
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+var (
+	_ provider.Provider                       = &awxProvider{}
+	_ provider.ProviderWithEphemeralResources = &awxProvider{}
+)
+
+// awxProvider is the terraform-plugin-framework provider. Its provider block is the one of the
+// SDKv2 provider, which configures the AWX client of both.
+type awxProvider struct {
+	sdk *sdkschema.Provider
+}
+
+// New returns the framework provider sharing the provider block and the AWX client of sdk, the
+// SDKv2 provider served alongside it.
+func New(sdk *sdkschema.Provider) func() provider.Provider {
+	return func() provider.Provider {
+		return &awxProvider{sdk: sdk}
+	}
+}
+
+// Metadata implements provider.Provider.
+func (p *awxProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "awx"
+}
+
+// Schema implements provider.Provider, with the provider block of the SDKv2 provider: the muxed
+// servers must report the same provider schema.
+func (p *awxProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	s, err := providerSchema(p.sdk.Schema)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to build the provider schema", err.Error())
+		return
+	}
+	resp.Schema = s
+}
+
+// Configure implements provider.Provider. The SDKv2 provider is configured first by the mux
+// server, and its client is reused. Otherwise the SDKv2 provider is configured with the same
+// provider block.
+func (p *awxProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	meta := p.sdk.Meta()
+	if meta == nil {
+		config, err := sdkResourceConfig(p.sdk, req.Config.Raw)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to read the provider configuration", err.Error())
+			return
+		}
+		diags := p.sdk.Configure(ctx, config)
+		appendSDKDiagnostics(resp, diags)
+		if diags.HasError() {
+			return
+		}
+		meta = p.sdk.Meta()
+	}
+
+	client, ok := meta.(*awx.AWX)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("Expecting an *awx.AWX client, got %T.", meta))
+		return
+	}
+	resp.DataSourceData = client
+	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+}
+
+// Resources implements provider.Provider.
+func (p *awxProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewProjectResource,
+	}
+}
+
+// DataSources implements provider.Provider.
+func (p *awxProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+// EphemeralResources implements provider.ProviderWithEphemeralResources.
+func (p *awxProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewTokenEphemeralResource,
+	}
+}
+
+// providerSchema translates the provider block of the SDKv2 provider to the framework.
+func providerSchema(sdk map[string]*sdkschema.Schema) (schema.Schema, error) {
+	names := make([]string, 0, len(sdk))
+	for name := range sdk {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	attributes := make(map[string]schema.Attribute, len(sdk))
+	for _, name := range names {
+		s := sdk[name]
+		optional := s.Optional || s.Default != nil || s.DefaultFunc != nil
+		required := s.Required && !optional
+		switch s.Type {
+		case sdkschema.TypeString:
+			attributes[name] = schema.StringAttribute{Required: required, Optional: optional, Sensitive: s.Sensitive, Description: s.Description}
+		case sdkschema.TypeBool:
+			attributes[name] = schema.BoolAttribute{Required: required, Optional: optional, Sensitive: s.Sensitive, Description: s.Description}
+		case sdkschema.TypeInt:
+			attributes[name] = schema.Int64Attribute{Required: required, Optional: optional, Sensitive: s.Sensitive, Description: s.Description}
+		case sdkschema.TypeFloat:
+			attributes[name] = schema.Float64Attribute{Required: required, Optional: optional, Sensitive: s.Sensitive, Description: s.Description}
+		case sdkschema.TypeMap:
+			elem, err := mapElementType(s)
+			if err != nil {
+				return schema.Schema{}, fmt.Errorf("%s: %w", name, err)
+			}
+			attributes[name] = schema.MapAttribute{ElementType: elem, Required: required, Optional: optional, Sensitive: s.Sensitive, Description: s.Description}
+		default:
+			return schema.Schema{}, fmt.Errorf("%s: unsupported provider argument type %s", name, s.Type)
+		}
+	}
+	return schema.Schema{Attributes: attributes}, nil
+}
+
+// mapElementType returns the framework type of the elements of an SDKv2 map, which are strings
+// unless set otherwise.
+func mapElementType(s *sdkschema.Schema) (attr.Type, error) {
+	elem, ok := s.Elem.(*sdkschema.Schema)
+	if !ok {
+		return types.StringType, nil
+	}
+	switch elem.Type {
+	case sdkschema.TypeString:
+		return types.StringType, nil
+	case sdkschema.TypeBool:
+		return types.BoolType, nil
+	case sdkschema.TypeInt:
+		return types.Int64Type, nil
+	case sdkschema.TypeFloat:
+		return types.Float64Type, nil
+	}
+	return nil, fmt.Errorf("unsupported map element type %s", elem.Type)
+}
+
+// sdkResourceConfig converts the provider block received by the framework to the configuration
+// of the SDKv2 provider.
+func sdkResourceConfig(sdk *sdkschema.Provider, raw tftypes.Value) (*terraform.ResourceConfig, error) {
+	block := sdkschema.InternalMap(sdk.Schema).CoreConfigSchema()
+	value, err := tfprotov5.NewDynamicValue(raw.Type(), raw)
+	if err != nil {
+		return nil, err
+	}
+	config, err := msgpack.Unmarshal(value.MsgPack, block.ImpliedType())
+	if err != nil {
+		return nil, err
+	}
+	return terraform.NewResourceConfigShimmed(config, block), nil
+}
+
+// appendSDKDiagnostics adds the diagnostics of the SDKv2 provider to the framework response.
+func appendSDKDiagnostics(resp *provider.ConfigureResponse, diags diag.Diagnostics) {
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			resp.Diagnostics.AddError(d.Summary, d.Detail)
+		} else {
+			resp.Diagnostics.AddWarning(d.Summary, d.Detail)
+		}
+	}
+}
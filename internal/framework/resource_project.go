@@ -0,0 +1,393 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+// projectUpdatePollInterval is how often Delete checks that the canceled project update finished.
+const projectUpdatePollInterval = time.Second
+
+var (
+	_ resource.Resource                = &projectResource{}
+	_ resource.ResourceWithConfigure   = &projectResource{}
+	_ resource.ResourceWithImportState = &projectResource{}
+)
+
+// projectResource manages an AWX project. It was ported from the SDKv2 provider, with the same
+// schema, so that the state of existing projects is read as is.
+type projectResource struct {
+	client *awx.AWX
+}
+
+// projectResourceModel is the configuration and the state of awx_project.
+type projectResourceModel struct {
+	ID                    types.String   `tfsdk:"id"`
+	Name                  types.String   `tfsdk:"name"`
+	Description           types.String   `tfsdk:"description"`
+	LocalPath             types.String   `tfsdk:"local_path"`
+	ScmType               types.String   `tfsdk:"scm_type"`
+	ScmURL                types.String   `tfsdk:"scm_url"`
+	ScmCredentialID       types.Int64    `tfsdk:"scm_credential_id"`
+	ScmBranch             types.String   `tfsdk:"scm_branch"`
+	ScmClean              types.Bool     `tfsdk:"scm_clean"`
+	ScmDeleteOnUpdate     types.Bool     `tfsdk:"scm_delete_on_update"`
+	OrganizationID        types.Int64    `tfsdk:"organization_id"`
+	ScmUpdateOnLaunch     types.Bool     `tfsdk:"scm_update_on_launch"`
+	ScmUpdateCacheTimeout types.Int64    `tfsdk:"scm_update_cache_timeout"`
+	AllowOverride         types.Bool     `tfsdk:"allow_override"`
+	Timeouts              *timeoutsModel `tfsdk:"timeouts"`
+}
+
+// NewProjectResource returns the awx_project resource.
+func NewProjectResource() resource.Resource {
+	return &projectResource{}
+}
+
+// Metadata implements resource.Resource.
+func (r *projectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+// Schema implements resource.Resource.
+//
+//nolint:funlen
+func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource `awx_project` manages projects within an organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The ID of this resource.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of this project",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Optional description of this project.",
+			},
+			"local_path": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Local path (relative to PROJECTS_ROOT) containing playbooks and related files for this project.",
+			},
+			"scm_type": schema.StringAttribute{
+				Required:    true,
+				Description: "One of \"\" (manual), git, hg, svn",
+			},
+			"scm_url": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+			},
+			"scm_credential_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Numeric ID of the scm used credential. The credential is removed from the project when unset.",
+			},
+			"scm_branch": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Specific branch, tag or commit to checkout.",
+			},
+			"scm_clean": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"scm_delete_on_update": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"organization_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Numeric ID of the project organization",
+			},
+			"scm_update_on_launch": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"scm_update_cache_timeout": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(0),
+			},
+			"allow_override": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Allow SCM branch override",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *projectResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*awx.AWX)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("Expecting an *awx.AWX client, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+// Create implements resource.Resource.
+func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data projectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || !checkClient(r.client, &resp.Diagnostics) {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, data.Timeouts.create())
+	defer cancel()
+
+	orgID := int(data.OrganizationID.ValueInt64())
+	_, existing, err := r.client.ProjectService.ListProjects(ctx, map[string]string{
+		"name":         data.Name.ValueString(),
+		"organization": strconv.Itoa(orgID),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to fetch Project", fmt.Sprintf("Unable to list the projects of organization %d: %s", orgID, err))
+		return
+	}
+	if len(existing.Results) >= 1 {
+		resp.Diagnostics.AddError("Create: Always exist",
+			fmt.Sprintf("Project with name %s  already exists in the Organization ID %v", data.Name.ValueString(), orgID))
+		return
+	}
+
+	payload := data.request()
+	payload.LocalPath = awx.Ptr(data.LocalPath.ValueString())
+	result, err := r.client.ProjectService.CreateProjectFromRequest(ctx, payload, map[string]string{})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create Project", fmt.Sprintf("Project failed to create: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(strconv.Itoa(result.ID))
+	resp.Diagnostics.Append(r.read(ctx, result.ID, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read implements resource.Resource.
+func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data projectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || !checkClient(r.client, &resp.Diagnostics) {
+		return
+	}
+	id, ok := stateID(data.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	project, err := r.client.ProjectService.GetProjectByID(ctx, id, map[string]string{})
+	if awx.IsNotFound(err) {
+		tflog.Warn(ctx, "Project not found in AWX, removing it from the state", map[string]interface{}{"id": id})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to fetch Project", fmt.Sprintf("Unable to load Project with id %d: %s", id, err))
+		return
+	}
+	data.set(project)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data projectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || !checkClient(r.client, &resp.Diagnostics) {
+		return
+	}
+	id, ok := stateID(data.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, data.Timeouts.update())
+	defer cancel()
+
+	update := data.request()
+	// Cannot change local_path for git-based projects
+	if data.LocalPath.ValueString() != "" && data.ScmType.ValueString() != "git" {
+		update.LocalPath = awx.Ptr(data.LocalPath.ValueString())
+	}
+	if _, err := r.client.ProjectService.UpdateProjectFromRequest(ctx, id, update, map[string]string{}); err != nil {
+		resp.Diagnostics.AddError("Unable to update Project", fmt.Sprintf("Project with id %d failed to update: %s", id, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, id, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource. The running update of the project is canceled first, as
+// AWX refuses to delete a project being updated.
+func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data projectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || !checkClient(r.client, &resp.Diagnostics) {
+		return
+	}
+	id, ok := stateID(data.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, data.Timeouts.delete())
+	defer cancel()
+
+	project, err := r.client.ProjectService.GetProjectByID(ctx, id, map[string]string{})
+	if awx.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to fetch Project", fmt.Sprintf("Unable to load Project with id %d: %s", id, err))
+		return
+	}
+
+	if jobID := projectJobID(project); jobID != 0 {
+		if _, err := r.client.ProjectUpdatesService.ProjectUpdateCancel(ctx, jobID); err != nil {
+			resp.Diagnostics.AddError("Delete: Failed to cancel Job",
+				fmt.Sprintf("Failed to cancel the Job %v for Project with ID %v, got %s", jobID, id, err))
+			return
+		}
+		if err := r.waitProjectUpdate(ctx, jobID); err != nil {
+			resp.Diagnostics.AddError("Delete: failed to update project job",
+				fmt.Sprintf("Failed to refresh job status for job %v of project %v, got %s", jobID, id, err))
+			return
+		}
+	}
+
+	if _, err := r.client.ProjectService.DeleteProject(ctx, id); err != nil && !awx.IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to delete Project", fmt.Sprintf("Project with id %d failed to delete: %s", id, err))
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState, by numeric ID or by AWX named URL.
+func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := req.ID
+	if _, err := strconv.Atoi(id); err != nil {
+		if !checkClient(r.client, &resp.Diagnostics) {
+			return
+		}
+		resolved, err := r.client.NamedURLService.ResolveID(ctx, "projects", id)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to import Project",
+				fmt.Sprintf("unable to resolve the projects named URL %q: %s", id, err))
+			return
+		}
+		id = strconv.Itoa(resolved)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// read refreshes data with the project id after a change.
+func (r *projectResource) read(ctx context.Context, id int, data *projectResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	project, err := r.client.ProjectService.GetProjectByID(ctx, id, map[string]string{})
+	if err != nil {
+		diags.AddError("Unable to fetch Project", fmt.Sprintf("Unable to load Project with id %d: %s", id, err))
+		return diags
+	}
+	data.set(project)
+	return diags
+}
+
+// waitProjectUpdate waits for the project update jobID to finish.
+func (r *projectResource) waitProjectUpdate(ctx context.Context, jobID int) error {
+	for {
+		job, err := r.client.ProjectUpdatesService.ProjectUpdateGet(ctx, jobID)
+		if err != nil {
+			return err
+		}
+		if !job.Finished.IsZero() {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(projectUpdatePollInterval):
+		}
+	}
+}
+
+// request returns the payload of the project, without local_path, which AWX only accepts on creation
+// for source control projects. A null scm_credential_id removes the credential of the project.
+func (m *projectResourceModel) request() *awx.ProjectRequest {
+	return &awx.ProjectRequest{
+		Name:                  awx.Ptr(m.Name.ValueString()),
+		Description:           awx.Ptr(m.Description.ValueString()),
+		Organization:          awx.NewNullable(int(m.OrganizationID.ValueInt64())),
+		Credential:            nullableID(m.ScmCredentialID),
+		ScmType:               awx.Ptr(m.ScmType.ValueString()),
+		ScmURL:                awx.Ptr(m.ScmURL.ValueString()),
+		ScmBranch:             awx.Ptr(m.ScmBranch.ValueString()),
+		ScmClean:              awx.Ptr(m.ScmClean.ValueBool()),
+		ScmDeleteOnUpdate:     awx.Ptr(m.ScmDeleteOnUpdate.ValueBool()),
+		ScmUpdateOnLaunch:     awx.Ptr(m.ScmUpdateOnLaunch.ValueBool()),
+		ScmUpdateCacheTimeout: awx.Ptr(int(m.ScmUpdateCacheTimeout.ValueInt64())),
+		AllowOverride:         awx.Ptr(m.AllowOverride.ValueBool()),
+	}
+}
+
+// set copies the fields of project to the model.
+func (m *projectResourceModel) set(project *awx.Project) {
+	m.ID = types.StringValue(strconv.Itoa(project.ID))
+	m.Name = types.StringValue(project.Name)
+	m.Description = types.StringValue(project.Description)
+	m.ScmType = types.StringValue(project.ScmType)
+	m.ScmURL = types.StringValue(project.ScmURL)
+	m.ScmBranch = types.StringValue(project.ScmBranch)
+	m.ScmClean = types.BoolValue(project.ScmClean)
+	m.ScmDeleteOnUpdate = types.BoolValue(project.ScmDeleteOnUpdate)
+	m.OrganizationID = types.Int64Value(int64(project.Organization))
+	m.ScmCredentialID = idValue(project.Credential)
+	m.ScmUpdateOnLaunch = types.BoolValue(project.ScmUpdateOnLaunch)
+	m.ScmUpdateCacheTimeout = types.Int64Value(int64(project.ScmUpdateCacheTimeout))
+	m.AllowOverride = types.BoolValue(project.AllowOverride)
+}
+
+// projectJobID returns the ID of the running update of project, or of its last update.
+func projectJobID(project *awx.Project) int {
+	if project.SummaryFields == nil {
+		return 0
+	}
+	for _, job := range []map[string]interface{}{project.SummaryFields.CurrentJob, project.SummaryFields.LastJob} {
+		if id, ok := job["id"].(float64); ok {
+			return int(id)
+		}
+	}
+	return 0
+}
@@ -0,0 +1,28 @@
+// Package provider serves the AWX provider: the SDKv2 provider of internal/awx and the
+// terraform-plugin-framework provider of internal/framework, muxed into a single provider server.
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/josh-silvas/terraform-provider-awx/internal/awx"
+	"github.com/josh-silvas/terraform-provider-awx/internal/framework"
+)
+
+// NewServer returns the factory of the provider server, serving the resources of both providers.
+// The SDKv2 provider comes first, so that it is configured before the framework provider, which
+// reuses its AWX client.
+func NewServer(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	sdk := awx.Provider()
+	mux, err := tf5muxserver.NewMuxServer(ctx,
+		sdk.GRPCProvider,
+		providerserver.NewProtocol5(framework.New(sdk)()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return mux.ProviderServer, nil
+}
@@ -0,0 +1,407 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/josh-silvas/terraform-provider-awx/internal/awx"
+	"github.com/josh-silvas/terraform-provider-awx/internal/framework"
+	goawx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/goawx/awxtest"
+)
+
+// providerConfig returns a provider block of server setting the given string arguments, leaving
+// the others null.
+func providerConfig(t *testing.T, server tfprotov5.ProviderServer, values map[string]string) *tfprotov5.DynamicValue {
+	t.Helper()
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("%s: %s", d.Summary, d.Detail)
+	}
+
+	typ := resp.Provider.ValueType().(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attrType := range typ.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+		if v, ok := values[name]; ok {
+			attrs[name] = tftypes.NewValue(tftypes.String, v)
+		}
+	}
+	config, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, attrs))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &config
+}
+
+// testConfigure configures server against a fake AWX server, which is returned.
+func testConfigure(t *testing.T, server tfprotov5.ProviderServer) *awxtest.Server {
+	t.Helper()
+	srv := awxtest.NewServer()
+	t.Cleanup(srv.Close)
+
+	resp, err := server.ConfigureProvider(context.Background(), &tfprotov5.ConfigureProviderRequest{
+		TerraformVersion: "1.10.0",
+		Config: providerConfig(t, server, map[string]string{
+			"hostname": srv.URL,
+			"username": srv.Username,
+			"password": srv.Password,
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Errorf("%s: %s", d.Summary, d.Detail)
+		}
+	}
+	return srv
+}
+
+func TestNewServer(t *testing.T) {
+	factory, err := NewServer(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	testConfigure(t, factory())
+}
+
+func TestFrameworkProvider_ConfiguresSDKProvider(t *testing.T) {
+	server := providerserver.NewProtocol5(framework.New(awx.Provider())())()
+	testConfigure(t, server)
+}
+
+func TestTokenEphemeralResource(t *testing.T) {
+	ctx := context.Background()
+	factory, err := NewServer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := factory()
+	srv := testConfigure(t, server)
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	typ := schemas.EphemeralResourceSchemas["awx_token"].ValueType().(tftypes.Object)
+	config, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, map[string]tftypes.Value{
+		"application_id": tftypes.NewValue(tftypes.Number, nil),
+		"description":    tftypes.NewValue(tftypes.String, "ci"),
+		"scope":          tftypes.NewValue(tftypes.String, "read"),
+		"id":             tftypes.NewValue(tftypes.Number, nil),
+		"token":          tftypes.NewValue(tftypes.String, nil),
+		"expires":        tftypes.NewValue(tftypes.String, nil),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opened, err := server.OpenEphemeralResource(ctx, &tfprotov5.OpenEphemeralResourceRequest{TypeName: "awx_token", Config: &config})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range opened.Diagnostics {
+		t.Fatalf("%s: %s", d.Summary, d.Detail)
+	}
+	result, err := opened.Result.Unmarshal(typ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var attrs map[string]tftypes.Value
+	if err := result.As(&attrs); err != nil {
+		t.Fatal(err)
+	}
+	var token string
+	if err := attrs["token"].As(&token); err != nil || token == "" {
+		t.Errorf("Expecting a token, got %q (%v)", token, err)
+	}
+	if opened.RenewAt.IsZero() || opened.RenewAt.Before(time.Now()) {
+		t.Errorf("Expecting the token to be renewed before it expires, got %s", opened.RenewAt)
+	}
+
+	// The token authenticates until it is closed.
+	client, err := goawx.NewAWXWithAuthenticator(ctx, srv.URL, &goawx.TokenAuth{Token: token}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	renewed, err := server.RenewEphemeralResource(ctx, &tfprotov5.RenewEphemeralResourceRequest{TypeName: "awx_token", Private: opened.Private})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range renewed.Diagnostics {
+		t.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+
+	closed, err := server.CloseEphemeralResource(ctx, &tfprotov5.CloseEphemeralResourceRequest{TypeName: "awx_token", Private: opened.Private})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range closed.Diagnostics {
+		t.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+	if _, err := client.OrganizationsService.ListOrganizations(ctx, nil); err == nil {
+		t.Error("Expecting the token to be deleted on close")
+	}
+}
+
+func TestTokenEphemeralResource_Unconfigured(t *testing.T) {
+	ctx := context.Background()
+	server := providerserver.NewProtocol5(framework.New(awx.Provider())())()
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	typ := schemas.EphemeralResourceSchemas["awx_token"].ValueType().(tftypes.Object)
+	opened, err := server.OpenEphemeralResource(ctx, &tfprotov5.OpenEphemeralResourceRequest{
+		TypeName: "awx_token",
+		Config:   objectValue(t, typ, nil),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(opened.Diagnostics) != 1 || opened.Diagnostics[0].Summary != "Unconfigured AWX client" {
+		t.Errorf("Expecting an unconfigured client error, got %+v", opened.Diagnostics)
+	}
+}
+
+// objectValue returns an object of typ setting the given attributes, leaving the others null.
+func objectValue(t *testing.T, typ tftypes.Object, values map[string]tftypes.Value) *tfprotov5.DynamicValue {
+	t.Helper()
+	attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attrType := range typ.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+		}
+	}
+	value, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, attrs))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &value
+}
+
+// applyResource plans and applies config over prior, returning the new state.
+func applyResource(t *testing.T, server tfprotov5.ProviderServer, typeName string, typ tftypes.Object, prior, config, proposed *tfprotov5.DynamicValue) *tfprotov5.DynamicValue {
+	t.Helper()
+	ctx := context.Background()
+	plan, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         typeName,
+		PriorState:       prior,
+		ProposedNewState: proposed,
+		Config:           config,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range plan.Diagnostics {
+		t.Fatalf("%s: %s", d.Summary, d.Detail)
+	}
+	applied, err := server.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+		TypeName:       typeName,
+		PriorState:     prior,
+		PlannedState:   plan.PlannedState,
+		Config:         config,
+		PlannedPrivate: plan.PlannedPrivate,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range applied.Diagnostics {
+		t.Fatalf("%s: %s", d.Summary, d.Detail)
+	}
+	return applied.NewState
+}
+
+func TestCredentialWriteOnlySecret(t *testing.T) {
+	ctx := context.Background()
+	factory, err := NewServer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := factory()
+	srv := testConfigure(t, server)
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	typ := schemas.ResourceSchemas["awx_credential_gitlab"].ValueType().(tftypes.Object)
+	configValues := func(token string, version int64) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"name":             tftypes.NewValue(tftypes.String, "gitlab"),
+			"organization_id":  tftypes.NewValue(tftypes.Number, 1),
+			"token_wo":         tftypes.NewValue(tftypes.String, token),
+			"token_wo_version": tftypes.NewValue(tftypes.Number, version),
+		}
+	}
+
+	state := objectValue(t, typ, nil)
+	for i, token := range []string{"glpat-first", "glpat-rotated"} {
+		values := configValues(token, int64(i+1))
+		config := objectValue(t, typ, values)
+		delete(values, "token_wo")
+		if i > 0 {
+			values["id"] = tftypes.NewValue(tftypes.String, "1")
+		}
+		state = applyResource(t, server, "awx_credential_gitlab", typ, state, config, objectValue(t, typ, values))
+
+		newState, err := state.Unmarshal(typ)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var attrs map[string]tftypes.Value
+		if err := newState.As(&attrs); err != nil {
+			t.Fatal(err)
+		}
+		if !attrs["token_wo"].IsNull() {
+			t.Error("Expecting the write-only token to be left out of the state")
+		}
+		var stateToken *string
+		if err := attrs["token"].As(&stateToken); err != nil {
+			t.Fatal(err)
+		}
+		if stateToken != nil && *stateToken != "" {
+			t.Errorf("Expecting no token in the state, got %q", *stateToken)
+		}
+		var id string
+		if err := attrs["id"].As(&id); err != nil {
+			t.Fatal(err)
+		}
+		if id != "1" {
+			t.Fatalf("Expecting credential 1, got %s", id)
+		}
+		if got, _ := srv.CredentialInput(1, "token"); got != token {
+			t.Errorf("Expecting AWX to store token %q, got %v", token, got)
+		}
+	}
+}
+
+func TestCredentialWriteOnlyInputs(t *testing.T) {
+	ctx := context.Background()
+	factory, err := NewServer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := factory()
+	srv := testConfigure(t, server)
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	typ := schemas.ResourceSchemas["awx_credential"].ValueType().(tftypes.Object)
+	values := map[string]tftypes.Value{
+		"name":               tftypes.NewValue(tftypes.String, "machine"),
+		"organization_id":    tftypes.NewValue(tftypes.Number, 1),
+		"credential_type_id": tftypes.NewValue(tftypes.Number, 1),
+		"inputs": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"username": tftypes.NewValue(tftypes.String, "deploy"),
+		}),
+		"inputs_wo_version": tftypes.NewValue(tftypes.Number, 1),
+	}
+	proposed := objectValue(t, typ, values)
+	values["inputs_wo"] = tftypes.NewValue(tftypes.String, `{"password": "hunter2"}`)
+	state := applyResource(t, server, "awx_credential", typ, objectValue(t, typ, nil), objectValue(t, typ, values), proposed)
+
+	newState, err := state.Unmarshal(typ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var attrs map[string]tftypes.Value
+	if err := newState.As(&attrs); err != nil {
+		t.Fatal(err)
+	}
+	var inputs map[string]tftypes.Value
+	if err := attrs["inputs"].As(&inputs); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := inputs["password"]; ok || len(inputs) != 1 {
+		t.Errorf("Expecting only the username in the state inputs, got %v", inputs)
+	}
+	if got, _ := srv.CredentialInput(1, "password"); got != "hunter2" {
+		t.Errorf("Expecting AWX to store the write-only password, got %v", got)
+	}
+	if got, _ := srv.CredentialInput(1, "username"); got != "deploy" {
+		t.Errorf("Expecting AWX to store the username, got %v", got)
+	}
+}
+
+func TestProjectCredential(t *testing.T) {
+	ctx := context.Background()
+	factory, err := NewServer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := factory()
+	srv := testConfigure(t, server)
+	credentialID, err := srv.Create("credentials", awxtest.Object{"name": "scm", "credential_type": 2, "organization": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	typ := schemas.ResourceSchemas["awx_project"].ValueType().(tftypes.Object)
+	values := map[string]tftypes.Value{
+		"name":            tftypes.NewValue(tftypes.String, "playbooks"),
+		"organization_id": tftypes.NewValue(tftypes.Number, 1),
+		"scm_type":        tftypes.NewValue(tftypes.String, "git"),
+		"scm_url":         tftypes.NewValue(tftypes.String, "https://github.com/ansible/ansible-tower-samples"),
+	}
+
+	// scm_credential_id goes from unset to a credential, and back to unset.
+	state, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	prior := &state
+	for i, credential := range []interface{}{nil, credentialID, nil} {
+		values["scm_credential_id"] = tftypes.NewValue(tftypes.Number, credential)
+		if i > 0 {
+			values["id"] = tftypes.NewValue(tftypes.String, "1")
+		}
+		config := objectValue(t, typ, values)
+		prior = applyResource(t, server, "awx_project", typ, prior, config, config)
+
+		newState, err := prior.Unmarshal(typ)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var attrs map[string]tftypes.Value
+		if err := newState.As(&attrs); err != nil {
+			t.Fatal(err)
+		}
+		project, ok := srv.Object("projects", 1)
+		if !ok {
+			t.Fatal("Expecting project 1 to exist")
+		}
+		if credential == nil {
+			if !attrs["scm_credential_id"].IsNull() {
+				t.Errorf("Step %d: expecting a null scm_credential_id, got %s", i, attrs["scm_credential_id"])
+			}
+			if project["credential"] != nil {
+				t.Errorf("Step %d: expecting AWX to store no credential, got %v", i, project["credential"])
+			}
+			continue
+		}
+		if !attrs["scm_credential_id"].Equal(tftypes.NewValue(tftypes.Number, credential)) {
+			t.Errorf("Step %d: expecting scm_credential_id %d, got %s", i, credentialID, attrs["scm_credential_id"])
+		}
+		if got, _ := project["credential"].(int); got != credentialID {
+			t.Errorf("Step %d: expecting AWX to store credential %d, got %v", i, credentialID, project["credential"])
+		}
+	}
+}
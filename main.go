@@ -2,9 +2,13 @@
 package main
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 	"github.com/josh-silvas/terraform-provider-awx/internal/awx"
+	"github.com/josh-silvas/terraform-provider-awx/internal/provider"
 )
 
 // Run "go generate" to format example terraform files and generate the docs for the registry/website
@@ -17,11 +21,20 @@ import (
 // can be customized.
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs
 
+const shutdownTimeout = 2 * time.Second
+
 func main() {
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderAddr: "registry.terraform.io/josh-silvas/awx",
-		ProviderFunc: func() *schema.Provider {
-			return awx.Provider()
-		},
-	})
+	server, err := provider.NewServer(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := tf5server.Serve("registry.terraform.io/josh-silvas/awx", server); err != nil {
+		log.Fatal(err)
+	}
+
+	// Revoke the credentials created during the run, Terraform gives the
+	// plugin a couple of seconds to exit once the server is stopped.
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	awx.Shutdown(ctx)
 }
@@ -0,0 +1,125 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// API base paths under which the controller API is served.
+const (
+	// DefaultAPIBasePath is used by upstream AWX and Automation Controller up to AAP 2.4.
+	DefaultAPIBasePath = "/api/v2/"
+	// GatewayAPIBasePath is used by Automation Controller behind the AAP 2.5 platform gateway.
+	GatewayAPIBasePath = "/api/controller/v2/"
+)
+
+// gatewayAPIPrefix is the prefix of the AAP platform gateway API, which issues the tokens
+// and sessions accepted by the controller API behind it.
+const gatewayAPIPrefix = "/api/gateway/v1/"
+
+// WithAPIBasePath serves every service endpoint, written as /api/v2/..., under path instead.
+// Use GatewayAPIBasePath for AAP 2.5, or DetectAPIBasePath to find it out.
+func WithAPIBasePath(path string) RequesterOption {
+	return func(r *Requester) {
+		if path != "" && !strings.HasSuffix(path, "/") {
+			path += "/"
+		}
+		r.APIBasePath = path
+	}
+}
+
+// apiEndpoint rewrites an endpoint written against DefaultAPIBasePath onto the configured base path.
+// Endpoints already using another prefix, such as `next` links returned by the server, are kept.
+func (r *Requester) apiEndpoint(endpoint string) string {
+	if r.APIBasePath == "" || r.APIBasePath == DefaultAPIBasePath || !strings.HasPrefix(endpoint, DefaultAPIBasePath) {
+		return endpoint
+	}
+	return r.APIBasePath + strings.TrimPrefix(endpoint, DefaultAPIBasePath)
+}
+
+// behindGateway reports whether the controller API is served by the AAP platform gateway.
+func (r *Requester) behindGateway() bool {
+	return strings.HasPrefix(r.APIBasePath, "/api/controller/")
+}
+
+// tokensEndpoint returns the endpoint issuing personal access tokens: the gateway issues the
+// tokens accepted by the controller API on AAP 2.5.
+func (r *Requester) tokensEndpoint() string {
+	if r.behindGateway() {
+		return gatewayAPIPrefix + "tokens/"
+	}
+	return tokensAPIEndpoint
+}
+
+// sessionEndpoints returns the login and logout endpoints for SessionAuth.
+func (r *Requester) sessionEndpoints() (login, logout string) {
+	if r.behindGateway() {
+		return gatewayAPIPrefix + "login/", gatewayAPIPrefix + "logout/"
+	}
+	return loginEndpoint, logoutEndpoint
+}
+
+// DetectAPIBasePath finds out where the controller API is served, by reading the unauthenticated
+// API root of the AAP 2.5 platform gateway first, and of AWX otherwise.
+func DetectAPIBasePath(ctx context.Context, baseURL string, client *http.Client) (string, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	var lastErr error
+	for _, root := range []string{"/api/controller/", "/api/"} {
+		version, err := readCurrentVersion(ctx, client, strings.TrimSuffix(baseURL, "/")+root)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if version != "" {
+			return version, nil
+		}
+	}
+	if lastErr == nil {
+		lastErr = fmt.Errorf("no API version advertised")
+	}
+	return "", fmt.Errorf("unable to detect the AWX API base path of %s: %w", baseURL, lastErr)
+}
+
+// readCurrentVersion returns the `current_version` path advertised by an API root.
+// A missing root is not an error and returns an empty path.
+func readCurrentVersion(ctx context.Context, client *http.Client, rootURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rootURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			logCloseError(ctx, err)
+		}
+	}()
+
+	if resp.StatusCode == http.StatusNotFound {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return "", nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", newAPIError(resp, body)
+	}
+
+	root := struct {
+		CurrentVersion string `json:"current_version"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&root); err != nil {
+		return "", nil //nolint:nilerr // not an API root, e.g. a gateway landing page
+	}
+	return root.CurrentVersion, nil
+}
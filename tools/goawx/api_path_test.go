@@ -0,0 +1,140 @@
+package awx_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+func TestRequester_APIEndpoint(t *testing.T) {
+	cases := []struct {
+		name     string
+		basePath string
+		endpoint string
+		want     string
+	}{
+		{name: "unset", basePath: "", endpoint: "/api/v2/hosts/", want: "/api/v2/hosts/"},
+		{name: "default", basePath: awx.DefaultAPIBasePath, endpoint: "/api/v2/hosts/1/", want: "/api/v2/hosts/1/"},
+		{name: "gateway", basePath: awx.GatewayAPIBasePath, endpoint: "/api/v2/hosts/1/", want: "/api/controller/v2/hosts/1/"},
+		{name: "gateway without trailing slash", basePath: "/api/controller/v2", endpoint: "/api/v2/ping/", want: "/api/controller/v2/ping/"},
+		{name: "gateway next link", basePath: awx.GatewayAPIBasePath, endpoint: "/api/controller/v2/hosts/", want: "/api/controller/v2/hosts/"},
+		{name: "default next link", basePath: awx.DefaultAPIBasePath, endpoint: "/api/v2/hosts/", want: "/api/v2/hosts/"},
+		{name: "gateway API", basePath: awx.GatewayAPIBasePath, endpoint: "/api/gateway/v1/tokens/", want: "/api/gateway/v1/tokens/"},
+		{name: "outside of the API", basePath: awx.GatewayAPIBasePath, endpoint: "/api/login/", want: "/api/login/"},
+		{name: "custom", basePath: "/awx/api/v2/", endpoint: "/api/v2/hosts/", want: "/awx/api/v2/hosts/"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &awx.Requester{}
+			awx.WithAPIBasePath(tc.basePath)(r)
+			if got := r.APIEndpoint(tc.endpoint); got != tc.want {
+				t.Errorf("Expecting %s to be served at %s but got %s", tc.endpoint, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRequester_AuthEndpoints(t *testing.T) {
+	cases := []struct {
+		basePath   string
+		wantTokens string
+		wantLogin  string
+		wantLogout string
+	}{
+		{basePath: "", wantTokens: "/api/v2/tokens/", wantLogin: "/api/login/", wantLogout: "/api/logout/"},
+		{basePath: awx.DefaultAPIBasePath, wantTokens: "/api/v2/tokens/", wantLogin: "/api/login/", wantLogout: "/api/logout/"},
+		{basePath: awx.GatewayAPIBasePath, wantTokens: "/api/gateway/v1/tokens/", wantLogin: "/api/gateway/v1/login/", wantLogout: "/api/gateway/v1/logout/"},
+		{basePath: "/api/controller/v2", wantTokens: "/api/gateway/v1/tokens/", wantLogin: "/api/gateway/v1/login/", wantLogout: "/api/gateway/v1/logout/"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.basePath, func(t *testing.T) {
+			r := &awx.Requester{}
+			awx.WithAPIBasePath(tc.basePath)(r)
+			if got := r.TokensEndpoint(); got != tc.wantTokens {
+				t.Errorf("Expecting the tokens endpoint %s but got %s", tc.wantTokens, got)
+			}
+			if login, logout := r.SessionEndpoints(); login != tc.wantLogin || logout != tc.wantLogout {
+				t.Errorf("Expecting the session endpoints %s and %s but got %s and %s", tc.wantLogin, tc.wantLogout, login, logout)
+			}
+		})
+	}
+}
+
+func TestDetectAPIBasePath(t *testing.T) {
+	root := func(version string) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"current_version": "` + version + `"}`))
+		}
+	}
+	landingPage := func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte("<html>Ansible Automation Platform</html>"))
+	}
+	failing := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+	cases := []struct {
+		name    string
+		routes  map[string]http.HandlerFunc
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "gateway",
+			routes: map[string]http.HandlerFunc{"/api/controller/": root("/api/controller/v2/"), "/api/": root("/api/v2/")},
+			want:   awx.GatewayAPIBasePath,
+		},
+		{
+			name:   "AWX",
+			routes: map[string]http.HandlerFunc{"/api/": root("/api/v2/")},
+			want:   awx.DefaultAPIBasePath,
+		},
+		{
+			name:   "landing page",
+			routes: map[string]http.HandlerFunc{"/api/controller/": landingPage, "/api/": root("/api/v2/")},
+			want:   awx.DefaultAPIBasePath,
+		},
+		{
+			name:   "failing gateway",
+			routes: map[string]http.HandlerFunc{"/api/controller/": failing, "/api/": root("/api/v2/")},
+			want:   awx.DefaultAPIBasePath,
+		},
+		{
+			name:    "failing",
+			routes:  map[string]http.HandlerFunc{"/api/controller/": failing, "/api/": failing},
+			wantErr: true,
+		},
+		{
+			name:    "no API",
+			routes:  map[string]http.HandlerFunc{},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if handler, ok := tc.routes[r.URL.Path]; ok {
+					handler(w, r)
+					return
+				}
+				http.NotFound(w, r)
+			}))
+			defer srv.Close()
+
+			// A trailing slash on the base URL is not doubled.
+			for _, baseURL := range []string{srv.URL, srv.URL + "/"} {
+				got, err := awx.DetectAPIBasePath(context.Background(), baseURL, srv.Client())
+				if (err != nil) != tc.wantErr {
+					t.Fatalf("Expecting an error: %t, got %v", tc.wantErr, err)
+				}
+				if got != tc.want {
+					t.Errorf("Expecting the base path %q but got %q", tc.want, got)
+				}
+			}
+		})
+	}
+}
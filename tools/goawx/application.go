@@ -2,6 +2,7 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -20,36 +21,24 @@ type ListApplicationResponse struct {
 const applicationAPIEndpoint = "/api/v2/applications/"
 
 // ListApplication shows list of awx authentication applications.
-func (c *ApplicationService) ListApplication(params map[string]string) ([]*Application, *ListApplicationResponse, error) {
-	result := new(ListApplicationResponse)
-	resp, err := c.client.Requester.GetJSON(applicationAPIEndpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
+func (c *ApplicationService) ListApplication(ctx context.Context, params map[string]string) ([]*Application, *ListApplicationResponse, error) {
+	results, pagination, err := listAll[*Application](ctx, c.client.Requester, applicationAPIEndpoint, params)
 	if err != nil {
-		return nil, result, err
+		return nil, nil, err
 	}
 
-	if err := CheckResponse(resp); err != nil {
-		return nil, result, err
-	}
-
-	return result.Results, result, nil
+	return results, &ListApplicationResponse{Pagination: pagination, Results: results}, nil
 }
 
 // GetApplicationByID shows an of awx application by its ID.
-func (c *ApplicationService) GetApplicationByID(id int, params map[string]string) (*Application, error) {
+func (c *ApplicationService) GetApplicationByID(ctx context.Context, id int, params map[string]string) (*Application, error) {
 	result := new(Application)
 	endpoint := fmt.Sprintf("%s%d", applicationAPIEndpoint, id)
-	resp, err := c.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := c.client.Requester.GetJSON(ctx, endpoint, result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -66,7 +55,7 @@ func (c *ApplicationService) GetApplicationByID(id int, params map[string]string
 }
 
 // CreateApplication creates an awx authentication application.
-func (c *ApplicationService) CreateApplication(data map[string]interface{}, params map[string]string) (*Application, error) {
+func (c *ApplicationService) CreateApplication(ctx context.Context, data map[string]interface{}, params map[string]string) (*Application, error) {
 	mandatoryFields = []string{"name", "client_type", "authorization_grant_type", "organization"}
 	validate, status := ValidateParams(data, mandatoryFields)
 
@@ -83,11 +72,11 @@ func (c *ApplicationService) CreateApplication(data map[string]interface{}, para
 
 	// Add check if Application exists and return proper error
 
-	resp, err := c.client.Requester.PostJSON(applicationAPIEndpoint, bytes.NewReader(payload), result, params)
+	resp, err := c.client.Requester.PostJSON(ctx, applicationAPIEndpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -102,8 +91,17 @@ func (c *ApplicationService) CreateApplication(data map[string]interface{}, para
 	return result, nil
 }
 
+// CreateApplicationFromRequest is CreateApplication with a typed ApplicationRequest payload.
+func (c *ApplicationService) CreateApplicationFromRequest(ctx context.Context, req *ApplicationRequest, params map[string]string) (*Application, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return c.CreateApplication(ctx, data, params)
+}
+
 // UpdateApplication update an awx application.
-func (c *ApplicationService) UpdateApplication(id int, data map[string]interface{}, _ map[string]string) (*Application, error) {
+func (c *ApplicationService) UpdateApplication(ctx context.Context, id int, data map[string]interface{}, _ map[string]string) (*Application, error) {
 	result := new(Application)
 	endpoint := fmt.Sprintf("%s%d", applicationAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -111,11 +109,49 @@ func (c *ApplicationService) UpdateApplication(id int, data map[string]interface
 		return nil, err
 	}
 
-	resp, err := c.client.Requester.PutJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := c.client.Requester.PutJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				logCloseError(ctx, err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// UpdateApplicationFromRequest is UpdateApplication with a typed ApplicationRequest payload.
+func (c *ApplicationService) UpdateApplicationFromRequest(ctx context.Context, id int, req *ApplicationRequest, params map[string]string) (*Application, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return c.UpdateApplication(ctx, id, data, params)
+}
+
+// CreateApplicationToken creates an OAuth2 access token of an awx application for the
+// authenticated user.
+func (c *ApplicationService) CreateApplicationToken(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Token, error) {
+	result := new(Token)
+	endpoint := fmt.Sprintf("%s%d/tokens/", applicationAPIEndpoint, id)
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -131,15 +167,15 @@ func (c *ApplicationService) UpdateApplication(id int, data map[string]interface
 }
 
 // DeleteApplication delete an awx application.
-func (c *ApplicationService) DeleteApplication(id int) (*Application, error) {
+func (c *ApplicationService) DeleteApplication(ctx context.Context, id int) (*Application, error) {
 	result := new(Application)
 	endpoint := fmt.Sprintf("%s%d", applicationAPIEndpoint, id)
 
-	resp, err := c.client.Requester.Delete(endpoint, result, nil)
+	resp, err := c.client.Requester.Delete(ctx, endpoint, result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
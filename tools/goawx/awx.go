@@ -1,8 +1,12 @@
 package awx
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"sync/atomic"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // This variable is mandatory and to be populated for creating services API.
@@ -13,15 +17,19 @@ var mandatoryFields []string
 // AWX represents awx api endpoints with services, and using
 // client to communicate with awx server.
 type AWX struct {
-	client *Client
+	client     *Client
+	serverInfo atomic.Pointer[ServerInfo]
 
 	ApplicationService                              *ApplicationService
+	BulkService                                     *BulkService
+	ConfigService                                   *ConfigService
 	ExecutionEnvironmentsService                    *ExecutionEnvironmentsService
 	PingService                                     *PingService
 	InventoriesService                              *InventoriesService
 	JobService                                      *JobService
 	JobTemplateService                              *JobTemplateService
 	JobTemplateNotificationTemplatesService         *JobTemplateNotificationTemplatesService
+	NamedURLService                                 *NamedURLService
 	ProjectService                                  *ProjectService
 	ProjectUpdatesService                           *ProjectUpdatesService
 	UserService                                     *UserService
@@ -39,6 +47,8 @@ type AWX struct {
 	SettingService                                  *SettingService
 	SurveySpecService                               *SurveySpecService
 	TeamService                                     *TeamService
+	TokenService                                    *TokenService
+	WebSocketService                                *WebSocketService
 	WorkflowJobTemplateScheduleService              *WorkflowJobTemplateScheduleService
 	WorkflowJobTemplateService                      *WorkflowJobTemplateService
 	WorkflowJobTemplateNodeService                  *WorkflowJobTemplateNodeService
@@ -54,13 +64,13 @@ type Client struct {
 	Requester *Requester
 }
 
-// CheckResponse do http response check, and return err if not in [200, 300).
+// CheckResponse do http response check, and return an *APIError if not in [200, 300).
 func CheckResponse(resp *http.Response) error {
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
 		return nil
 	}
 
-	return fmt.Errorf("responsed with %d, resp: %v", resp.StatusCode, resp)
+	return newAPIError(resp, nil)
 }
 
 // ValidateParams is to validate the input to use the services.
@@ -79,58 +89,122 @@ func ValidateParams(data map[string]interface{}, mandatoryFields []string) (notf
 
 // NewAWX news an awx handler with basic auth support, you could customize the http
 // transport by passing custom client.
-func NewAWX(baseURL, userName, passwd string, client *http.Client) (*AWX, error) {
-	r := &Requester{Base: baseURL, Authenticator: &BasicAuth{Username: userName, Password: passwd}, Client: client}
-	if r.Client == nil {
-		r.Client = http.DefaultClient
-	}
-
-	awxClient := &Client{
-		BaseURL:   baseURL,
-		Requester: r,
-	}
+func NewAWX(ctx context.Context, baseURL, userName, passwd string, client *http.Client, opts ...RequesterOption) (*AWX, error) {
+	return NewAWXWithAuthenticator(ctx, baseURL, &BasicAuth{Username: userName, Password: passwd}, client, opts...)
+}
 
-	newAWX := newAWX(awxClient)
+// NewAWXToken creates an AWX handler with token support.
+func NewAWXToken(ctx context.Context, baseURL, token string, client *http.Client, opts ...RequesterOption) (*AWX, error) {
+	return NewAWXWithAuthenticator(ctx, baseURL, &TokenAuth{Token: token}, client, opts...)
+}
 
-	// test the connection and return and error if there's an issue
-	_, err := newAWX.PingService.Ping()
-	if err != nil {
+// NewAWXWithAuthenticator creates an AWX handler authenticating with the given Authenticator.
+// Authenticators holding a server-side session (e.g. PersonalTokenAuth) are logged in before
+// the connection test, and must be released with Close.
+func NewAWXWithAuthenticator(ctx context.Context, baseURL string, auth Authenticator, client *http.Client, opts ...RequesterOption) (*AWX, error) {
+	newAWX := newAWXClient(baseURL, auth, client, opts...)
+	if err := newAWX.connect(ctx); err != nil {
 		return nil, err
 	}
-
 	return newAWX, nil
 }
 
-// NewAWXToken creates an AWX handler with token support.
-func NewAWXToken(baseURL, token string, client *http.Client) (*AWX, error) {
-	r := &Requester{Base: baseURL, Authenticator: &TokenAuth{Token: token}, Client: client}
+// NewAWXDeferred creates an AWX handler like NewAWXWithAuthenticator, without sending any request:
+// the authenticator is logged in, the connection tested and the server detected on the first
+// request, whose error reports any failure. The API base path is detected then with
+// DetectAPIBasePath, unless set with WithAPIBasePath. ServerInfo is empty until then.
+func NewAWXDeferred(baseURL string, auth Authenticator, client *http.Client, opts ...RequesterOption) *AWX {
+	newAWX := newAWXClient(baseURL, auth, client, opts...)
+	r := newAWX.client.Requester
+	detectBasePath := r.APIBasePath == ""
+	r.deferred = &deferredConnection{connect: func(ctx context.Context) error {
+		if detectBasePath {
+			path, err := DetectAPIBasePath(ctx, baseURL, r.Client)
+			if err != nil {
+				tflog.Warn(ctx, "Unable to detect the AWX API base path, using the default", map[string]interface{}{
+					"default": DefaultAPIBasePath,
+					"error":   err,
+				})
+				path = DefaultAPIBasePath
+			}
+			WithAPIBasePath(path)(r)
+		}
+		return newAWX.connect(ctx)
+	}}
+	return newAWX
+}
+
+// Connect connects a client created by NewAWXDeferred, if not done yet. It does nothing for the
+// other clients, which are connected when created.
+func (a *AWX) Connect(ctx context.Context) error {
+	return a.client.Requester.deferred.ensure(ctx)
+}
+
+// newAWXClient creates an AWX handler, without sending any request.
+func newAWXClient(baseURL string, auth Authenticator, client *http.Client, opts ...RequesterOption) *AWX {
+	r := &Requester{Base: baseURL, Authenticator: auth, Client: client}
 	if r.Client == nil {
 		r.Client = http.DefaultClient
 	}
+	for _, opt := range opts {
+		opt(r)
+	}
 
-	awxClient := &Client{
+	return newAWX(&Client{
 		BaseURL:   baseURL,
 		Requester: r,
-	}
+	})
+}
 
-	newAWX := newAWX(awxClient)
+// connect logs the authenticator in, tests the connection and detects the server. The
+// credentials are released when the connection test fails.
+func (a *AWX) connect(ctx context.Context) error {
+	r := a.client.Requester
+	if sa, ok := r.Authenticator.(sessionAuthenticator); ok {
+		if err := sa.login(ctx, r); err != nil {
+			return err
+		}
+	}
 
 	// test the connection and return and error if there's an issue
-	_, err := newAWX.PingService.Ping()
+	ping, err := a.PingService.Ping(ctx)
 	if err != nil {
-		return nil, err
+		if closeErr := a.Close(ctx); closeErr != nil {
+			tflog.Warn(ctx, "Unable to release AWX credentials", map[string]interface{}{"error": closeErr})
+		}
+		return err
 	}
+	a.serverInfo.Store(detectServerInfo(ctx, a, ping))
+	return nil
+}
 
-	return newAWX, nil
+// ServerInfo describes the AWX server the client is connected to.
+func (a *AWX) ServerInfo() *ServerInfo {
+	return a.serverInfo.Load()
+}
+
+// Close releases the server-side session opened by the authenticator, if any.
+// For PersonalTokenAuth, the personal access token is revoked.
+func (a *AWX) Close(ctx context.Context) error {
+	if sa, ok := a.client.Requester.Authenticator.(sessionAuthenticator); ok {
+		return sa.logout(ctx, a.client.Requester)
+	}
+	return nil
 }
 
 func newAWX(c *Client) *AWX { //nolint: funlen
-	return &AWX{
+	a := &AWX{
 		client: c,
 
 		ApplicationService: &ApplicationService{
 			client: c,
 		},
+		BulkService: &BulkService{
+			client: c,
+		},
+		ConfigService: &ConfigService{
+			client: c,
+		},
 		ExecutionEnvironmentsService: &ExecutionEnvironmentsService{
 			client: c,
 		},
@@ -152,6 +226,9 @@ func newAWX(c *Client) *AWX { //nolint: funlen
 		JobTemplateNotificationTemplatesService: &JobTemplateNotificationTemplatesService{
 			client: c,
 		},
+		NamedURLService: &NamedURLService{
+			client: c,
+		},
 		ProjectService: &ProjectService{
 			client: c,
 		},
@@ -200,6 +277,12 @@ func newAWX(c *Client) *AWX { //nolint: funlen
 		TeamService: &TeamService{
 			client: c,
 		},
+		TokenService: &TokenService{
+			client: c,
+		},
+		WebSocketService: &WebSocketService{
+			client: c,
+		},
 		WorkflowJobTemplateScheduleService: &WorkflowJobTemplateScheduleService{
 			client: c,
 		},
@@ -225,4 +308,6 @@ func newAWX(c *Client) *AWX { //nolint: funlen
 			client: c,
 		},
 	}
+	a.serverInfo.Store(&ServerInfo{})
+	return a
 }
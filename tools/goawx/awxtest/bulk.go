@@ -0,0 +1,156 @@
+package awxtest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+// Limits of the bulk endpoints, matching the AWX defaults.
+const (
+	bulkHostCreateLimit = 100
+	bulkHostDeleteLimit = 250
+)
+
+// serveBulk serves the bulk/ endpoints.
+func (s *Server) serveBulk(method string, parts []string, data Object, userID int) (int, interface{}) {
+	if len(parts) != 1 {
+		return notFound()
+	}
+	if method != http.MethodPost {
+		return methodNotAllowed(method)
+	}
+	switch parts[0] {
+	case "host_create":
+		return s.bulkHostCreate(data)
+	case "host_delete":
+		return s.bulkHostDelete(data)
+	case "job_launch":
+		return s.bulkJobLaunch(data, userID)
+	}
+	return notFound()
+}
+
+// bulkHostCreate creates every host of the request in an inventory, or none.
+func (s *Server) bulkHostCreate(data Object) (int, interface{}) {
+	inventory, ok := asID(data["inventory"])
+	if !ok {
+		return http.StatusBadRequest, fieldErrors{"inventory": {"This field is required."}}
+	}
+	if _, ok := s.get("inventories", inventory); !ok {
+		return http.StatusBadRequest, fieldErrors{"inventory": {fmt.Sprintf("Invalid pk \"%d\" - object does not exist.", inventory)}}
+	}
+	hosts, _ := data["hosts"].([]interface{})
+	if len(hosts) == 0 {
+		return http.StatusBadRequest, fieldErrors{"hosts": {"This field is required."}}
+	}
+	if len(hosts) > bulkHostCreateLimit {
+		return http.StatusBadRequest, fieldErrors{"hosts": {fmt.Sprintf("Number of hosts exceeds system setting BULK_HOST_MAX_CREATE (%d).", bulkHostCreateLimit)}}
+	}
+
+	var created []int
+	rendered := make([]interface{}, 0, len(hosts))
+	for _, raw := range hosts {
+		host, _ := raw.(Object)
+		if host == nil {
+			host = make(Object)
+		}
+		host = copyValue(host).(Object)
+		host["inventory"] = inventory
+		status, body := s.create("hosts", host)
+		if status != http.StatusCreated {
+			// A bulk creation is atomic: roll back the hosts created so far.
+			for _, id := range created {
+				s.remove("hosts", id)
+			}
+			return http.StatusBadRequest, fieldErrors{"hosts": {fmt.Sprintf("Host %q: %v", host["name"], body)}}
+		}
+		id, _ := asID(body.(Object)["id"])
+		created = append(created, id)
+		rendered = append(rendered, body)
+	}
+
+	return http.StatusCreated, Object{
+		"url":   fmt.Sprintf("%s%s/%d/hosts/", apiPrefix, "inventories", inventory),
+		"hosts": rendered,
+	}
+}
+
+// bulkHostDelete deletes every host of the request, or none when one of them does not exist.
+func (s *Server) bulkHostDelete(data Object) (int, interface{}) {
+	raw, _ := data["hosts"].([]interface{})
+	if len(raw) == 0 {
+		return http.StatusBadRequest, fieldErrors{"hosts": {"This field is required."}}
+	}
+	if len(raw) > bulkHostDeleteLimit {
+		return http.StatusBadRequest, fieldErrors{"hosts": {fmt.Sprintf("Number of hosts exceeds system setting BULK_HOST_MAX_DELETE (%d).", bulkHostDeleteLimit)}}
+	}
+
+	ids := make([]int, 0, len(raw))
+	var missing []int
+	for _, value := range raw {
+		id, _ := asID(value)
+		if _, ok := s.get("hosts", id); !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	if len(missing) > 0 {
+		sort.Ints(missing)
+		return http.StatusBadRequest, fieldErrors{"hosts": {fmt.Sprintf("Hosts do not exist or you lack permission to delete it: %v", missing)}}
+	}
+
+	deleted := make(Object, len(ids))
+	for _, id := range ids {
+		deleted[strconv.Itoa(id)] = fmt.Sprintf("The host %s was deleted", formatValue(s.objects["hosts"][id]["name"]))
+		s.remove("hosts", id)
+	}
+	return http.StatusCreated, Object{"hosts": deleted}
+}
+
+// bulkJobLaunch starts a workflow job running a job for every job template of the request.
+func (s *Server) bulkJobLaunch(data Object, userID int) (int, interface{}) {
+	jobs, _ := data["jobs"].([]interface{})
+	if len(jobs) == 0 {
+		return http.StatusBadRequest, fieldErrors{"jobs": {"This field is required."}}
+	}
+
+	templates := make([]int, 0, len(jobs))
+	for _, raw := range jobs {
+		job, _ := raw.(Object)
+		id, _ := asID(job["unified_job_template"])
+		if coll, _ := s.lookup(unifiedJobTemplates, id); coll == "" {
+			return http.StatusBadRequest, fieldErrors{"jobs": {fmt.Sprintf("Job Templates %v not found.", []int{id})}}
+		}
+		templates = append(templates, id)
+	}
+
+	name, _ := data["name"].(string)
+	if name == "" {
+		name = "Bulk Job Launch"
+	}
+	status, body := s.startJob("workflow_jobs", Object{
+		"name":        name,
+		"is_bulk_job": true,
+		"launched_by": Object{"id": userID, "type": "user"},
+	})
+	if status != http.StatusCreated {
+		return status, body
+	}
+	for _, id := range templates {
+		if template, ok := s.get("job_templates", id); ok {
+			s.startJob("jobs", Object{
+				"name":                 template["name"],
+				"job_template":         id,
+				"unified_job_template": id,
+				"inventory":            template["inventory"],
+				"project":              template["project"],
+				"playbook":             template["playbook"],
+				"launched_by":          Object{"id": userID, "type": "user"},
+			})
+		}
+	}
+	return status, body
+}
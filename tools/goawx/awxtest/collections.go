@@ -0,0 +1,393 @@
+package awxtest
+
+import "strings"
+
+// collection describes how the fake server stores and validates the objects of an API endpoint.
+type collection struct {
+	// typ is the object type reported by AWX, e.g. "job_template".
+	typ string
+	// nameField is the field identifying the object, required on creation.
+	nameField string
+	// uniqueWith lists the fields the name must be unique with, nil when names may repeat.
+	// An empty, non nil slice makes the name unique in the whole collection. The name and these
+	// fields, in order, make up the named URL of the object.
+	uniqueWith []string
+	// foreignKeys maps a field to the collections it may reference.
+	foreignKeys map[string][]string
+	// required lists the fields, other than nameField, required on creation.
+	required []string
+	// roles lists the object roles created along with every object.
+	roles []string
+	// associations maps a sub-endpoint to the collection of the objects it associates.
+	associations map[string]string
+	// children maps a sub-endpoint to the collection and the foreign key of the objects it lists.
+	children map[string]child
+	// secretFields are write-only fields, never rendered.
+	secretFields []string
+	// defaults are the values of the fields not given on creation.
+	defaults Object
+}
+
+// child is a sub-endpoint listing the objects referencing the parent object.
+type child struct {
+	collection string
+	foreignKey string
+}
+
+// unifiedJobTemplates are the collections a schedule or a workflow node may run.
+//
+//nolint:gochecknoglobals
+var unifiedJobTemplates = []string{"job_templates", "workflow_job_templates", "projects", "inventory_sources"}
+
+// notificationAssociations are the notification template sub-endpoints of job templates and organizations.
+//
+//nolint:gochecknoglobals
+var notificationAssociations = map[string]string{
+	"notification_templates_started":   "notification_templates",
+	"notification_templates_success":   "notification_templates",
+	"notification_templates_error":     "notification_templates",
+	"notification_templates_approvals": "notification_templates",
+}
+
+func withNotifications(associations map[string]string) map[string]string {
+	for sub, target := range notificationAssociations {
+		associations[sub] = target
+	}
+	return associations
+}
+
+// collections lists the endpoints served by the fake server.
+//
+//nolint:gochecknoglobals
+var collections = map[string]*collection{
+	"applications": {
+		typ:         "o_auth2_application",
+		nameField:   "name",
+		uniqueWith:  []string{"organization"},
+		foreignKeys: map[string][]string{"organization": {"organizations"}},
+		required:    []string{"organization", "authorization_grant_type", "client_type"},
+		children:    map[string]child{"tokens": {collection: "tokens", foreignKey: "application"}},
+		defaults:    Object{"description": "", "redirect_uris": "", "skip_authorization": false},
+	},
+	"credential_input_sources": {
+		typ: "credential_input_source",
+		foreignKeys: map[string][]string{
+			"target_credential": {"credentials"},
+			"source_credential": {"credentials"},
+		},
+		required: []string{"target_credential", "source_credential", "input_field_name"},
+		defaults: Object{"description": "", "metadata": Object{}},
+	},
+	"credential_types": {
+		typ:        "credential_type",
+		nameField:  "name",
+		uniqueWith: []string{"kind"},
+		required:   []string{"kind"},
+		defaults:   Object{"description": "", "managed": false, "namespace": nil, "inputs": Object{}, "injectors": Object{}},
+	},
+	"credentials": {
+		typ:        "credential",
+		nameField:  "name",
+		uniqueWith: []string{"credential_type", "organization"},
+		foreignKeys: map[string][]string{
+			"organization":    {"organizations"},
+			"credential_type": {"credential_types"},
+		},
+		required: []string{"credential_type"},
+		roles:    []string{"admin_role", "use_role", "read_role"},
+		defaults: Object{"description": "", "inputs": Object{}, "organization": nil},
+	},
+	"execution_environments": {
+		typ:       "execution_environment",
+		nameField: "name",
+		foreignKeys: map[string][]string{
+			"organization": {"organizations"},
+			"credential":   {"credentials"},
+		},
+		required: []string{"image"},
+		defaults: Object{"description": "", "pull": "", "managed": false, "organization": nil, "credential": nil},
+	},
+	"groups": {
+		typ:          "group",
+		nameField:    "name",
+		uniqueWith:   []string{"inventory"},
+		foreignKeys:  map[string][]string{"inventory": {"inventories"}},
+		required:     []string{"inventory"},
+		associations: map[string]string{"hosts": "hosts", "children": "groups"},
+		defaults:     Object{"description": "", "variables": ""},
+	},
+	"hosts": {
+		typ:          "host",
+		nameField:    "name",
+		uniqueWith:   []string{"inventory"},
+		foreignKeys:  map[string][]string{"inventory": {"inventories"}},
+		required:     []string{"inventory"},
+		associations: map[string]string{"groups": "groups"},
+		defaults:     Object{"description": "", "enabled": true, "instance_id": "", "variables": ""},
+	},
+	"instance_groups": {
+		typ:         "instance_group",
+		nameField:   "name",
+		uniqueWith:  []string{},
+		foreignKeys: map[string][]string{"credential": {"credentials"}},
+		roles:       []string{"admin_role", "use_role", "read_role"},
+		defaults: Object{
+			"is_container_group": false, "credential": nil, "pod_spec_override": "",
+			"policy_instance_minimum": 0, "policy_instance_percentage": 0,
+		},
+	},
+	"inventories": {
+		typ:          "inventory",
+		nameField:    "name",
+		uniqueWith:   []string{"organization"},
+		foreignKeys:  map[string][]string{"organization": {"organizations"}},
+		required:     []string{"organization"},
+		roles:        []string{"admin_role", "update_role", "adhoc_role", "use_role", "read_role"},
+		associations: map[string]string{"instance_groups": "instance_groups"},
+		children: map[string]child{
+			"hosts":             {collection: "hosts", foreignKey: "inventory"},
+			"groups":            {collection: "groups", foreignKey: "inventory"},
+			"inventory_sources": {collection: "inventory_sources", foreignKey: "inventory"},
+		},
+		defaults: Object{"description": "", "kind": "", "host_filter": nil, "variables": ""},
+	},
+	"inventory_sources": {
+		typ:        "inventory_source",
+		nameField:  "name",
+		uniqueWith: []string{"inventory"},
+		foreignKeys: map[string][]string{
+			"inventory":             {"inventories"},
+			"source_project":        {"projects"},
+			"credential":            {"credentials"},
+			"execution_environment": {"execution_environments"},
+		},
+		required:     []string{"inventory"},
+		associations: withNotifications(map[string]string{}),
+		children:     map[string]child{"schedules": {collection: "schedules", foreignKey: "unified_job_template"}},
+		defaults: Object{
+			"description": "", "source": "", "source_path": "", "source_vars": "", "enabled_var": "", "enabled_value": "",
+			"host_filter": "", "overwrite": false, "overwrite_vars": false, "update_on_launch": false,
+			"update_cache_timeout": 0, "verbosity": 1, "credential": nil, "source_project": nil, "execution_environment": nil,
+		},
+	},
+	"job_templates": {
+		typ:        "job_template",
+		nameField:  "name",
+		uniqueWith: []string{"organization"},
+		foreignKeys: map[string][]string{
+			"inventory":             {"inventories"},
+			"project":               {"projects"},
+			"organization":          {"organizations"},
+			"execution_environment": {"execution_environments"},
+			"webhook_credential":    {"credentials"},
+		},
+		roles: []string{"admin_role", "execute_role", "read_role"},
+		associations: withNotifications(map[string]string{
+			"credentials":     "credentials",
+			"labels":          "labels",
+			"instance_groups": "instance_groups",
+		}),
+		children: map[string]child{
+			"jobs":      {collection: "jobs", foreignKey: "job_template"},
+			"schedules": {collection: "schedules", foreignKey: "unified_job_template"},
+		},
+		defaults: Object{
+			"description": "", "job_type": "run", "inventory": nil, "project": nil, "playbook": "", "scm_branch": "",
+			"forks": 0, "limit": "", "verbosity": 0, "extra_vars": "", "job_tags": "", "force_handlers": false,
+			"skip_tags": "", "start_at_task": "", "timeout": 0, "use_fact_cache": false, "host_config_key": "",
+			"survey_enabled": false, "become_enabled": false, "diff_mode": false, "allow_simultaneous": false,
+			"custom_virtualenv": nil, "execution_environment": nil, "job_slice_count": 1, "status": "never updated",
+		},
+	},
+	"jobs": {
+		typ:       "job",
+		nameField: "name",
+		foreignKeys: map[string][]string{
+			"job_template": {"job_templates"},
+			"inventory":    {"inventories"},
+			"project":      {"projects"},
+		},
+		children: map[string]child{
+			"job_events":         {collection: "job_events", foreignKey: "job"},
+			"job_host_summaries": {collection: "job_host_summaries", foreignKey: "job"},
+		},
+		defaults: Object{"launch_type": "manual", "failed": false, "extra_vars": "", "job_explanation": ""},
+	},
+	"job_events": {
+		typ:         "job_event",
+		foreignKeys: map[string][]string{"job": {"jobs"}},
+	},
+	"job_host_summaries": {
+		typ:         "job_host_summary",
+		foreignKeys: map[string][]string{"job": {"jobs"}},
+	},
+	"labels": {
+		typ:         "label",
+		nameField:   "name",
+		uniqueWith:  []string{"organization"},
+		foreignKeys: map[string][]string{"organization": {"organizations"}},
+		required:    []string{"organization"},
+	},
+	"notification_templates": {
+		typ:         "notification_template",
+		nameField:   "name",
+		uniqueWith:  []string{"organization"},
+		foreignKeys: map[string][]string{"organization": {"organizations"}},
+		required:    []string{"organization", "notification_type"},
+		defaults:    Object{"description": "", "notification_configuration": Object{}, "messages": nil},
+	},
+	"organizations": {
+		typ:         "organization",
+		nameField:   "name",
+		uniqueWith:  []string{},
+		foreignKeys: map[string][]string{"default_environment": {"execution_environments"}},
+		roles: []string{
+			"admin_role", "execute_role", "project_admin_role", "inventory_admin_role", "credential_admin_role",
+			"workflow_admin_role", "notification_admin_role", "job_template_admin_role",
+			"execution_environment_admin_role", "auditor_role", "member_role", "read_role", "approval_role",
+		},
+		associations: withNotifications(map[string]string{
+			"instance_groups":    "instance_groups",
+			"galaxy_credentials": "credentials",
+			"users":              "users",
+			"admins":             "users",
+		}),
+		children: map[string]child{
+			"teams":       {collection: "teams", foreignKey: "organization"},
+			"inventories": {collection: "inventories", foreignKey: "organization"},
+			"projects":    {collection: "projects", foreignKey: "organization"},
+		},
+		defaults: Object{"description": "", "max_hosts": 0, "custom_virtualenv": nil, "default_environment": nil},
+	},
+	"projects": {
+		typ:        "project",
+		nameField:  "name",
+		uniqueWith: []string{"organization"},
+		foreignKeys: map[string][]string{
+			"organization":        {"organizations"},
+			"credential":          {"credentials"},
+			"default_environment": {"execution_environments"},
+		},
+		required:     []string{"organization"},
+		roles:        []string{"admin_role", "use_role", "update_role", "read_role"},
+		associations: withNotifications(map[string]string{}),
+		children:     map[string]child{"schedules": {collection: "schedules", foreignKey: "unified_job_template"}},
+		defaults: Object{
+			"description": "", "local_path": "", "scm_type": "", "scm_url": "", "scm_branch": "", "scm_clean": false,
+			"scm_delete_on_update": false, "credential": nil, "scm_update_on_launch": false,
+			"scm_update_cache_timeout": 0, "allow_override": false, "status": "successful",
+		},
+	},
+	"project_updates": {
+		typ:         "project_update",
+		nameField:   "name",
+		foreignKeys: map[string][]string{"project": {"projects"}},
+	},
+	"roles": {
+		typ: "role",
+		associations: map[string]string{
+			"users": "users",
+			"teams": "teams",
+		},
+	},
+	"schedules": {
+		typ:        "schedule",
+		nameField:  "name",
+		uniqueWith: []string{"unified_job_template"},
+		foreignKeys: map[string][]string{
+			"unified_job_template": unifiedJobTemplates,
+			"inventory":            {"inventories"},
+		},
+		required: []string{"unified_job_template", "rrule"},
+		defaults: Object{"description": "", "enabled": true, "extra_data": Object{}, "inventory": nil},
+	},
+	"teams": {
+		typ:          "team",
+		nameField:    "name",
+		uniqueWith:   []string{"organization"},
+		foreignKeys:  map[string][]string{"organization": {"organizations"}},
+		required:     []string{"organization"},
+		roles:        []string{"admin_role", "member_role", "read_role"},
+		associations: map[string]string{"roles": "roles", "users": "users"},
+		defaults:     Object{"description": ""},
+	},
+	"tokens": {
+		typ:          "o_auth2_access_token",
+		foreignKeys:  map[string][]string{"application": {"applications"}, "user": {"users"}},
+		secretFields: []string{"token"},
+		defaults:     Object{"description": "", "scope": "write", "application": nil},
+	},
+	"users": {
+		typ:          "user",
+		nameField:    "username",
+		uniqueWith:   []string{},
+		associations: map[string]string{"roles": "roles", "teams": "teams"},
+		secretFields: []string{"password"},
+		defaults: Object{
+			"first_name": "", "last_name": "", "email": "", "is_superuser": false, "is_system_auditor": false,
+		},
+	},
+	"workflow_job_templates": {
+		typ:        "workflow_job_template",
+		nameField:  "name",
+		uniqueWith: []string{"organization"},
+		foreignKeys: map[string][]string{
+			"organization":       {"organizations"},
+			"inventory":          {"inventories"},
+			"webhook_credential": {"credentials"},
+		},
+		roles:        []string{"admin_role", "execute_role", "read_role", "approval_role"},
+		associations: withNotifications(map[string]string{"labels": "labels"}),
+		children: map[string]child{
+			"workflow_nodes": {collection: "workflow_job_template_nodes", foreignKey: "workflow_job_template"},
+			"schedules":      {collection: "schedules", foreignKey: "unified_job_template"},
+		},
+		defaults: Object{
+			"description": "", "extra_vars": "", "survey_enabled": false, "allow_simultaneous": false,
+			"ask_variables_on_launch": false, "ask_inventory_on_launch": false, "ask_scm_branch_on_launch": false,
+			"ask_limit_on_launch": false, "scm_branch": "", "limit": nil, "webhook_service": "", "webhook_credential": nil,
+			"ask_labels_on_launch": false, "ask_tags_on_launch": false, "ask_skip_tags_on_launch": false,
+			"job_tags": "", "skip_tags": "", "status": "never updated",
+		},
+	},
+	"workflow_job_template_nodes": {
+		typ: "workflow_job_template_node",
+		foreignKeys: map[string][]string{
+			"workflow_job_template": {"workflow_job_templates"},
+			"unified_job_template":  unifiedJobTemplates,
+			"inventory":             {"inventories"},
+		},
+		required: []string{"workflow_job_template"},
+		associations: map[string]string{
+			"success_nodes": "workflow_job_template_nodes",
+			"failure_nodes": "workflow_job_template_nodes",
+			"always_nodes":  "workflow_job_template_nodes",
+			"credentials":   "credentials",
+		},
+		defaults: Object{
+			"extra_data": Object{}, "inventory": nil, "scm_branch": nil, "job_type": nil, "job_tags": nil,
+			"skip_tags": nil, "limit": nil, "diff_mode": nil, "verbosity": nil, "all_parents_must_converge": false,
+			"identifier": "",
+		},
+	},
+	"workflow_jobs": {
+		typ:         "workflow_job",
+		nameField:   "name",
+		foreignKeys: map[string][]string{"workflow_job_template": {"workflow_job_templates"}, "inventory": {"inventories"}},
+		defaults:    Object{"launch_type": "manual", "failed": false, "extra_vars": "", "job_explanation": ""},
+	},
+}
+
+// inlineAssociations are rendered as lists of IDs in the object itself, as AWX does for workflow nodes.
+//
+//nolint:gochecknoglobals
+var inlineAssociations = map[string]bool{"success_nodes": true, "failure_nodes": true, "always_nodes": true}
+
+// displayName turns a collection type into the name used in AWX validation messages, e.g. "Job Template".
+func displayName(typ string) string {
+	words := strings.Split(typ, "_")
+	for i, word := range words {
+		words[i] = strings.ToUpper(word[:1]) + word[1:]
+	}
+	return strings.Join(words, " ")
+}
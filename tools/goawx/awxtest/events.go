@@ -0,0 +1,159 @@
+package awxtest
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// eventsStageField records the playbook events already emitted by a job.
+const eventsStageField = "_events_stage"
+
+// Stages of the playbook output of a job.
+const (
+	eventsStageNone = iota
+	eventsStageStarted
+	eventsStageFinished
+)
+
+// ansiEscape matches the color codes of the ansi output.
+var ansiEscape = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// text is a response body sent as text/plain rather than JSON.
+type text string
+
+// emitJobEvents records the playbook events of a job that reached a new status: the play and task
+// start when it runs, the task result and the recap when it finishes.
+func (s *Server) emitJobEvents(id int) {
+	job := s.objects["jobs"][id]
+	status, _ := job["status"].(string)
+	stage, _ := job[eventsStageField].(int)
+
+	if stage < eventsStageStarted && (status == "running" || finishedStatuses[status]) {
+		s.addJobEvent(id, job, Object{"event": "playbook_on_start"}, "")
+		s.addJobEvent(id, job, Object{"event": "playbook_on_play_start", "play": "all"}, "PLAY [all] *********************************************************************")
+		s.addJobEvent(id, job, Object{"event": "playbook_on_task_start", "play": "all", "task": "deploy"}, "TASK [deploy] ******************************************************************")
+		stage = eventsStageStarted
+	}
+	if stage < eventsStageFinished && finishedStatuses[status] {
+		switch status {
+		case "successful":
+			s.addJobEvent(id, job, Object{"event": "runner_on_ok", "host_name": "localhost", "task": "deploy"},
+				"\x1b[0;32mok: [localhost]\x1b[0m")
+		case "failed", "error":
+			s.addJobEvent(id, job, Object{"event": "runner_on_failed", "host_name": "localhost", "task": "deploy", "failed": true},
+				"\x1b[0;31mfatal: [localhost]: FAILED! => {\"changed\": false, \"msg\": \"deploy failed\"}\x1b[0m")
+		}
+		failed := 0
+		if status != "successful" {
+			failed = 1
+		}
+		s.addJobEvent(id, job, Object{"event": "playbook_on_stats"}, "PLAY RECAP *********************************************************************\n"+
+			"localhost                  : ok="+strconv.Itoa(1-failed)+"    changed=0    unreachable=0    failed="+strconv.Itoa(failed)+"    skipped=0")
+		stage = eventsStageFinished
+	}
+	job[eventsStageField] = stage
+}
+
+// addJobEvent stores the next event of a job, numbering its stdout lines after the previous events.
+func (s *Server) addJobEvent(id int, job Object, event Object, stdout string) {
+	counter, endLine := 0, 0
+	for _, other := range s.objects["job_events"] {
+		if jobID, _ := asID(other["job"]); jobID == id {
+			counter++
+			if end, _ := other["end_line"].(int); end > endLine {
+				endLine = end
+			}
+		}
+	}
+	lines := 0
+	if stdout != "" {
+		lines = strings.Count(stdout, "\n") + 1
+	}
+
+	event["job"] = id
+	event["counter"] = counter + 1
+	event["stdout"] = stdout
+	event["start_line"] = endLine
+	event["end_line"] = endLine + lines
+	event["event_display"] = displayName(event["event"].(string))
+	event["playbook"] = job["playbook"]
+	for _, field := range []string{"host_name", "play", "task"} {
+		if _, ok := event[field]; !ok {
+			event[field] = ""
+		}
+	}
+	if _, ok := event["failed"]; !ok {
+		event["failed"] = false
+	}
+	event["changed"] = false
+	s.insert("job_events", event)
+}
+
+// serveStdout returns the output of a job in the txt, ansi or json format. The json format
+// returns the lines [start_line, end_line) along with their range.
+func (s *Server) serveStdout(method string, id int, query url.Values) (int, interface{}) {
+	if method != http.MethodGet {
+		return methodNotAllowed(method)
+	}
+
+	var events []Object
+	for _, event := range s.objects["job_events"] {
+		if jobID, _ := asID(event["job"]); jobID == id && event["stdout"] != "" {
+			events = append(events, event)
+		}
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i]["counter"].(int) < events[j]["counter"].(int) })
+	var lines []string
+	for _, event := range events {
+		lines = append(lines, strings.Split(event["stdout"].(string), "\n")...)
+	}
+
+	format := query.Get("format")
+	if format == "" {
+		format = "json"
+	}
+	contentFormat := query.Get("content_format")
+	switch format {
+	case "txt", "ansi":
+		contentFormat = format
+	case "json":
+		if contentFormat == "" {
+			contentFormat = "txt"
+		}
+	default:
+		return notFound()
+	}
+
+	start, end := 0, len(lines)
+	if format == "json" {
+		if value, err := strconv.Atoi(query.Get("start_line")); err == nil && value >= 0 {
+			start = value
+		}
+		if value, err := strconv.Atoi(query.Get("end_line")); err == nil && value >= 0 && value < end {
+			end = value
+		}
+		if start > end {
+			start = end
+		}
+	}
+
+	var content strings.Builder
+	for _, line := range lines[start:end] {
+		if contentFormat != "ansi" {
+			line = ansiEscape.ReplaceAllString(line, "")
+		}
+		content.WriteString(line)
+		content.WriteString("\n")
+	}
+	if format != "json" {
+		return http.StatusOK, text(content.String())
+	}
+	return http.StatusOK, Object{
+		"range":   Object{"start": start, "end": end, "absolute_end": len(lines)},
+		"content": content.String(),
+	}
+}
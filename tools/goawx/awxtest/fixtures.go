@@ -0,0 +1,170 @@
+package awxtest
+
+import (
+	"net/http"
+	"sort"
+)
+
+// managedCredentialTypes are the credential types of a fresh installation, in ID order.
+//
+//nolint:gochecknoglobals
+var managedCredentialTypes = []struct {
+	name, kind, namespace string
+	secrets               []string
+}{
+	{"Machine", "ssh", "ssh", []string{"password", "ssh_key_data", "ssh_key_unlock", "become_password"}},
+	{"Source Control", "scm", "scm", []string{"password", "ssh_key_data", "ssh_key_unlock"}},
+	{"Vault", "vault", "vault", []string{"vault_password"}},
+	{"Network", "net", "net", []string{"password", "ssh_key_data", "ssh_key_unlock", "authorize_password"}},
+	{"Amazon Web Services", "cloud", "aws", []string{"password", "security_token"}},
+	{"OpenStack", "cloud", "openstack", []string{"password"}},
+	{"VMware vCenter", "cloud", "vmware", []string{"password"}},
+	{"Red Hat Satellite 6", "cloud", "satellite6", []string{"password"}},
+	{"Google Compute Engine", "cloud", "gce", []string{"ssh_key_data"}},
+	{"Microsoft Azure Resource Manager", "cloud", "azure_rm", []string{"password", "secret"}},
+	{"GitHub Personal Access Token", "token", "github_token", []string{"token"}},
+	{"GitLab Personal Access Token", "token", "gitlab_token", []string{"token"}},
+	{"Ansible Galaxy/Automation Hub API Token", "galaxy", "galaxy_api_token", []string{"token"}},
+	{"Container Registry", "registry", "registry", []string{"password"}},
+	{"OpenShift or Kubernetes API Bearer Token", "kubernetes", "kubernetes_bearer_token", []string{"bearer_token"}},
+	{"HashiCorp Vault Secret Lookup", "external", "hashivault_kv", []string{"token", "secret_id", "client_key"}},
+	{"Microsoft Azure Key Vault", "external", "azure_kv", []string{"secret"}},
+	{"CyberArk Central Credential Provider Lookup", "external", "aim", []string{"client_key"}},
+}
+
+// defaultSettings are the settings categories and values of a fresh installation.
+//
+//nolint:gochecknoglobals
+var defaultSettings = map[string]struct {
+	name   string
+	values Object
+}{
+	"system": {"System", Object{
+		"ACTIVITY_STREAM_ENABLED": true, "ORG_ADMINS_CAN_SEE_ALL_USERS": true, "MANAGE_ORGANIZATION_AUTH": true,
+		"TOWER_URL_BASE": "https://towerhost", "INSIGHTS_TRACKING_STATE": false,
+	}},
+	"jobs": {"Jobs", Object{
+		"AD_HOC_COMMANDS": []interface{}{"command", "shell"}, "DEFAULT_JOB_TIMEOUT": 0, "SCHEDULE_MAX_JOBS": 10,
+	}},
+	"ui":             {"UI", Object{"PENDO_TRACKING_STATE": "off", "CUSTOM_LOGIN_INFO": "", "CUSTOM_LOGO": ""}},
+	"authentication": {"Authentication", Object{"SESSION_COOKIE_AGE": 1800, "AUTH_BASIC_ENABLED": true}},
+	"ldap": {"LDAP", Object{
+		"AUTH_LDAP_SERVER_URI": "", "AUTH_LDAP_BIND_DN": "", "AUTH_LDAP_USER_SEARCH": []interface{}{},
+		"AUTH_LDAP_ORGANIZATION_MAP": Object{}, "AUTH_LDAP_TEAM_MAP": Object{},
+	}},
+}
+
+// seed creates the objects of a fresh installation.
+func (s *Server) seed() {
+	s.insert("organizations", Object{
+		"name": "Default", "description": "", "max_hosts": 0, "custom_virtualenv": nil, "default_environment": nil,
+	})
+	s.insert("users", Object{
+		"username": s.Username, "password": s.Password, "first_name": "", "last_name": "", "email": "",
+		"is_superuser": true, "is_system_auditor": false,
+	})
+	for _, credentialType := range managedCredentialTypes {
+		fields := make([]interface{}, 0, len(credentialType.secrets)+1)
+		fields = append(fields, Object{"id": "username", "label": "Username", "type": "string"})
+		for _, secret := range credentialType.secrets {
+			fields = append(fields, Object{"id": secret, "label": displayName(secret), "type": "string", "secret": true})
+		}
+		s.insert("credential_types", Object{
+			"name": credentialType.name, "description": "", "kind": credentialType.kind,
+			"namespace": credentialType.namespace, "managed": true,
+			"inputs": Object{"fields": fields}, "injectors": Object{},
+		})
+	}
+	s.insert("instance_groups", Object{
+		"name": "controlplane", "is_container_group": false, "credential": nil, "pod_spec_override": "",
+		"policy_instance_minimum": 0, "policy_instance_percentage": 100,
+	})
+	s.insert("instance_groups", Object{
+		"name": "default", "is_container_group": false, "credential": nil, "pod_spec_override": "",
+		"policy_instance_minimum": 0, "policy_instance_percentage": 100,
+	})
+	s.insert("execution_environments", Object{
+		"name": "AWX EE (latest)", "description": "", "image": "quay.io/ansible/awx-ee:latest",
+		"pull": "", "managed": false, "organization": nil, "credential": nil,
+	})
+
+	s.settings = make(map[string]Object, len(defaultSettings))
+	for slug, category := range defaultSettings {
+		s.settings[slug] = copyValue(category.values).(Object)
+	}
+}
+
+// serveSettings serves the settings categories. The "all" category reads and writes every setting.
+func (s *Server) serveSettings(method string, parts []string, data Object) (int, interface{}) {
+	if len(parts) == 0 {
+		if method != http.MethodGet {
+			return methodNotAllowed(method)
+		}
+		slugs := make([]string, 0, len(defaultSettings)+1)
+		for slug := range defaultSettings {
+			slugs = append(slugs, slug)
+		}
+		sort.Strings(slugs)
+		results := []interface{}{Object{"name": "All", "slug": "all", "url": apiPrefix + "settings/all/"}}
+		for _, slug := range slugs {
+			results = append(results, Object{
+				"name": defaultSettings[slug].name, "slug": slug, "url": apiPrefix + "settings/" + slug + "/",
+			})
+		}
+		return http.StatusOK, Object{"count": len(results), "next": nil, "previous": nil, "results": results}
+	}
+
+	slug := parts[0]
+	if _, ok := s.settings[slug]; !ok && slug != "all" || len(parts) > 1 {
+		return notFound()
+	}
+
+	switch method {
+	case http.MethodGet:
+		return http.StatusOK, s.settingsOf(slug)
+	case http.MethodPatch, http.MethodPut:
+		for key, value := range data {
+			category := slug
+			if slug == "all" {
+				category = s.settingCategory(key)
+			}
+			if _, known := s.settings[category][key]; !known {
+				continue
+			}
+			s.settings[category][key] = copyValue(value)
+		}
+		return http.StatusOK, s.settingsOf(slug)
+	case http.MethodDelete:
+		for category, defaults := range defaultSettings {
+			if slug == "all" || slug == category {
+				s.settings[category] = copyValue(defaults.values).(Object)
+			}
+		}
+		return http.StatusNoContent, nil
+	}
+	return methodNotAllowed(method)
+}
+
+// settingsOf returns the settings of a category.
+func (s *Server) settingsOf(slug string) Object {
+	out := make(Object)
+	for category, values := range s.settings {
+		if slug != "all" && slug != category {
+			continue
+		}
+		for key, value := range values {
+			out[key] = copyValue(value)
+		}
+	}
+	return out
+}
+
+// settingCategory returns the category of a setting, unknown settings belonging to none.
+func (s *Server) settingCategory(key string) string {
+	for category, values := range s.settings {
+		if _, ok := values[key]; ok {
+			return category
+		}
+	}
+	return ""
+}
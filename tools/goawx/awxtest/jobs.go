@@ -0,0 +1,253 @@
+package awxtest
+
+import (
+	"net/http"
+	"strings"
+)
+
+// finishedStatuses are the statuses a job ends in.
+//
+//nolint:gochecknoglobals
+var finishedStatuses = map[string]bool{"successful": true, "failed": true, "error": true, "canceled": true}
+
+// launchPrompts are the launch fields a job template only accepts when it prompts for them.
+//
+//nolint:gochecknoglobals
+var launchPrompts = map[string]string{
+	"extra_vars":  "ask_variables_on_launch",
+	"inventory":   "ask_inventory_on_launch",
+	"limit":       "ask_limit_on_launch",
+	"scm_branch":  "ask_scm_branch_on_launch",
+	"job_type":    "ask_job_type_on_launch",
+	"job_tags":    "ask_tags_on_launch",
+	"skip_tags":   "ask_skip_tags_on_launch",
+	"verbosity":   "ask_verbosity_on_launch",
+	"diff_mode":   "ask_diff_mode_on_launch",
+	"credentials": "ask_credential_on_launch",
+}
+
+// serveLaunch describes (GET) or launches (POST) a job template or a workflow job template.
+func (s *Server) serveLaunch(method, coll string, id int, data Object, userID int) (int, interface{}) {
+	template := s.objects[coll][id]
+	switch method {
+	case http.MethodGet:
+		return http.StatusOK, Object{
+			"can_start_without_user_input": template["inventory"] != nil || coll == "workflow_job_templates",
+			"ask_inventory_on_launch":      template["ask_inventory_on_launch"] == true,
+			"ask_variables_on_launch":      template["ask_variables_on_launch"] == true,
+			"survey_enabled":               template["survey_enabled"] == true,
+			"variables_needed_to_start":    []interface{}{},
+			"credential_needed_to_start":   false,
+			"inventory_needed_to_start":    template["inventory"] == nil && coll == "job_templates",
+			"job_template_data":            Object{"id": id, "name": template["name"], "description": template["description"]},
+		}
+	case http.MethodPost:
+	default:
+		return methodNotAllowed(method)
+	}
+
+	jobColl, jobKey, templateKey := "jobs", "job", "job_template"
+	if coll == "workflow_job_templates" {
+		jobColl, jobKey, templateKey = "workflow_jobs", "workflow_job", "workflow_job_template"
+	}
+
+	job := Object{
+		"name":                 template["name"],
+		"description":          template["description"],
+		templateKey:            id,
+		"unified_job_template": id,
+		"inventory":            template["inventory"],
+		"extra_vars":           template["extra_vars"],
+		"limit":                template["limit"],
+		"launched_by":          Object{"id": userID, "type": "user"},
+	}
+	if coll == "job_templates" {
+		job["project"] = template["project"]
+		job["playbook"] = template["playbook"]
+		job["job_type"] = template["job_type"]
+	}
+
+	ignored := make(Object)
+	for field, prompt := range launchPrompts {
+		value, ok := data[field]
+		if !ok {
+			continue
+		}
+		if template[prompt] != true {
+			ignored[field] = value
+			continue
+		}
+		if field != "credentials" {
+			job[field] = value
+		}
+	}
+	if coll == "job_templates" && job["inventory"] == nil {
+		return http.StatusBadRequest, Object{"inventory": []string{"Job Template 'inventory' is missing or undefined."}}
+	}
+
+	status, body := s.startJob(jobColl, job)
+	if status != http.StatusCreated {
+		return status, body
+	}
+	response := body.(Object)
+	response[jobKey] = response["id"]
+	response["ignored_fields"] = ignored
+	return status, response
+}
+
+// startJob stores a new job in the first configured status.
+func (s *Server) startJob(jobColl string, job Object) (int, interface{}) {
+	statuses := s.jobStatuses
+	if len(statuses) == 0 {
+		statuses = []string{"successful"}
+	}
+	for key, value := range collections[jobColl].defaults {
+		if _, ok := job[key]; !ok {
+			job[key] = copyValue(value)
+		}
+	}
+	job["status"] = statuses[0]
+	job[statusesField] = append([]string(nil), statuses[1:]...)
+	setJobTimes(job)
+	id := s.insert(jobColl, job)
+	if jobColl == "jobs" {
+		s.emitJobEvents(id)
+	}
+	s.notifyJobStatus(jobColl, id)
+	return http.StatusCreated, s.render(jobColl, job)
+}
+
+// advanceJob moves a job to its next status, emitting its events and notifying the WebSocket subscribers.
+func (s *Server) advanceJob(coll string, id int, job Object) {
+	remaining, _ := job[statusesField].([]string)
+	if len(remaining) == 0 {
+		return
+	}
+	job["status"] = remaining[0]
+	job[statusesField] = remaining[1:]
+	setJobTimes(job)
+	if coll == "jobs" {
+		s.emitJobEvents(id)
+	}
+	s.notifyJobStatus(coll, id)
+}
+
+// setJobTimes fills in the fields derived from the status of a job.
+func setJobTimes(job Object) {
+	status, _ := job["status"].(string)
+	if status == "running" && job["started"] == nil {
+		job["started"] = now()
+	}
+	if finishedStatuses[status] {
+		if job["started"] == nil {
+			job["started"] = now()
+		}
+		job["finished"] = now()
+		job["failed"] = status != "successful"
+	}
+	job["event_processing_finished"] = finishedStatuses[status]
+}
+
+// serveCancel cancels an unfinished job.
+func (s *Server) serveCancel(method, coll string, id int) (int, interface{}) {
+	job := s.objects[coll][id]
+	status, _ := job["status"].(string)
+	switch method {
+	case http.MethodGet:
+		return http.StatusOK, Object{"can_cancel": !finishedStatuses[status]}
+	case http.MethodPost:
+		if finishedStatuses[status] {
+			return http.StatusMethodNotAllowed, detail("Method \"POST\" not allowed.")
+		}
+		job["status"] = "canceled"
+		job[statusesField] = []string(nil)
+		setJobTimes(job)
+		if coll == "jobs" {
+			s.emitJobEvents(id)
+		}
+		s.notifyJobStatus(coll, id)
+		return http.StatusAccepted, nil
+	}
+	return methodNotAllowed(method)
+}
+
+// serveRelaunch starts a copy of a job.
+func (s *Server) serveRelaunch(method string, id, userID int) (int, interface{}) {
+	if method != http.MethodPost {
+		return methodNotAllowed(method)
+	}
+	previous := s.objects["jobs"][id]
+	job := make(Object)
+	for key, value := range previous {
+		if strings.HasPrefix(key, hiddenPrefix) || readOnlyFields[key] {
+			continue
+		}
+		switch key {
+		case "status", "started", "finished", "failed":
+			continue
+		}
+		job[key] = copyValue(value)
+	}
+	job["launch_type"] = "relaunch"
+	job["launched_by"] = Object{"id": userID, "type": "user"}
+	status, body := s.startJob("jobs", job)
+	if status == http.StatusCreated {
+		body.(Object)["job"] = body.(Object)["id"]
+	}
+	return status, body
+}
+
+// serveProjectUpdate starts a project update.
+func (s *Server) serveProjectUpdate(method string, id int) (int, interface{}) {
+	project := s.objects["projects"][id]
+	switch method {
+	case http.MethodGet:
+		return http.StatusOK, Object{"can_update": project["scm_type"] != ""}
+	case http.MethodPost:
+		body := s.startProjectUpdate(id)
+		body["project_update"] = body["id"]
+		return http.StatusAccepted, body
+	}
+	return methodNotAllowed(method)
+}
+
+// startProjectUpdate records an update of a project, which completes immediately.
+func (s *Server) startProjectUpdate(id int) Object {
+	project := s.objects["projects"][id]
+	update := Object{"name": project["name"], "project": id, "status": "successful", "failed": false}
+	setJobTimes(update)
+	project[lastUpdateField] = s.insert("project_updates", update)
+	return s.render("project_updates", update)
+}
+
+// serveSurveySpec reads, replaces or deletes the survey of a template.
+func (s *Server) serveSurveySpec(method, coll string, id int, data Object) (int, interface{}) {
+	template := s.objects[coll][id]
+	switch method {
+	case http.MethodGet:
+		spec, ok := template[surveySpecField].(Object)
+		if !ok {
+			return http.StatusOK, Object{}
+		}
+		return http.StatusOK, copyValue(spec)
+	case http.MethodPost:
+		errs := make(fieldErrors)
+		for _, field := range []string{"name", "description", "spec"} {
+			if _, ok := data[field]; !ok {
+				errs.add("error", "'%s' missing from survey spec.", field)
+			}
+		}
+		if _, ok := data["spec"].([]interface{}); !ok && len(errs) == 0 {
+			errs.add("error", "'spec' must be a list of items.")
+		}
+		if len(errs) > 0 {
+			return http.StatusBadRequest, errs
+		}
+		template[surveySpecField] = copyValue(data)
+		return http.StatusOK, copyValue(data)
+	case http.MethodDelete:
+		delete(template, surveySpecField)
+		return http.StatusOK, Object{}
+	}
+	return methodNotAllowed(method)
+}
@@ -0,0 +1,48 @@
+package awxtest
+
+import (
+	"errors"
+	"strings"
+)
+
+// namedURL returns the named URL identifier of obj: its name and the fields it is unique with, where
+// foreign keys are replaced with the named URL of the object they reference, e.g. "Deploy++Default".
+func (s *Server) namedURL(coll string, obj Object) string {
+	def := collections[coll]
+	fields := []string{escapeNamedURL(formatValue(obj[def.nameField]))}
+	var parents []string
+	for _, field := range def.uniqueWith {
+		targets, ok := def.foreignKeys[field]
+		if !ok {
+			fields = append(fields, escapeNamedURL(formatValue(obj[field])))
+			continue
+		}
+		parent := ""
+		if id, ok := asID(obj[field]); ok {
+			if target, ref := s.lookup(targets, id); ref != nil {
+				parent = s.namedURL(target, ref)
+			}
+		}
+		parents = append(parents, parent)
+	}
+	return strings.Join(append([]string{strings.Join(fields, "+")}, parents...), "++")
+}
+
+// escapeNamedURL escapes the '+' of a name, which AWX reserves for separators.
+func escapeNamedURL(name string) string {
+	return strings.ReplaceAll(name, "+", "[+]")
+}
+
+// resolveNamedURL returns the ID of the object of coll identified by the named URL.
+func (s *Server) resolveNamedURL(coll, named string) (int, error) {
+	def := collections[coll]
+	if def.nameField == "" || def.uniqueWith == nil {
+		return 0, errors.New("no named URL")
+	}
+	for id, obj := range s.objects[coll] {
+		if s.namedURL(coll, obj) == named {
+			return id, nil
+		}
+	}
+	return 0, errors.New("not found")
+}
@@ -0,0 +1,676 @@
+// Package awxtest provides an in-memory fake of the AWX API, so that the client and the provider
+// can be tested without a running AWX.
+//
+// The fake server implements the /api/v2/ endpoints used by the client with the semantics tests rely
+// on: creation, update and deletion with field validation, Django style list filters and pagination,
+// association sub-endpoints, object roles, job template and workflow launches whose jobs go through
+// configurable statuses, survey specs and settings. It accepts basic authentication, personal access
+// tokens and session cookies, and pushes the status changes of jobs over the /websocket/ channel.
+//
+//	srv := awxtest.NewServer()
+//	defer srv.Close()
+//	client, err := awx.NewAWX(ctx, srv.URL, srv.Username, srv.Password, srv.Client())
+package awxtest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Object is an AWX API object, as decoded from JSON.
+type Object = map[string]interface{}
+
+// DefaultVersion is the AWX version reported by the fake server.
+const DefaultVersion = "24.6.1"
+
+const (
+	apiPrefix         = "/api/v2/"
+	sessionCookieName = "awx_sessionid"
+	csrfCookieName    = "csrftoken"
+)
+
+// Server is a fake AWX API server listening on a local address.
+type Server struct {
+	*httptest.Server
+
+	// Username and Password are the credentials of the seeded superuser.
+	Username string
+	Password string
+
+	mu          sync.Mutex
+	version     string
+	jobStatuses []string
+	lastIDs     map[string]int
+	objects     map[string]map[int]Object
+	settings    map[string]Object
+	tokens      map[string]int
+	sessions    map[string]int
+	logins      int
+	csrfTokens  map[string]bool
+	subscribers map[*subscriber]bool
+	jobTick     time.Duration
+	tokenTTL    time.Duration
+	tls         bool
+	header      http.Header
+}
+
+// Option customizes a Server.
+type Option func(*Server)
+
+// WithVersion sets the AWX version reported by the ping and config endpoints.
+func WithVersion(version string) Option {
+	return func(s *Server) {
+		s.version = version
+	}
+}
+
+// WithCredentials sets the username and password of the seeded superuser.
+func WithCredentials(username, password string) Option {
+	return func(s *Server) {
+		s.Username = username
+		s.Password = password
+	}
+}
+
+// WithJobStatuses sets the statuses launched jobs go through: a job is created with the first
+// one and moves to the next one every time it is read. Defaults to pending, running, successful.
+func WithJobStatuses(statuses ...string) Option {
+	return func(s *Server) {
+		s.jobStatuses = statuses
+	}
+}
+
+// WithTokenLifetime sets the lifetime of the access tokens issued by the server. Defaults to the
+// 1000 years of AWX.
+func WithTokenLifetime(lifetime time.Duration) Option {
+	return func(s *Server) {
+		s.tokenTTL = lifetime
+	}
+}
+
+// WithTLS serves HTTPS with a certificate of its own, trusted by the Client of the server and by
+// the pool of its Certificate.
+func WithTLS() Option {
+	return func(s *Server) {
+		s.tls = true
+	}
+}
+
+// WithRequiredHeader rejects the requests without the header name set to value, as a gateway in
+// front of AWX checking a header would, including the WebSocket handshakes.
+func WithRequiredHeader(name, value string) Option {
+	return func(s *Server) {
+		if s.header == nil {
+			s.header = make(http.Header)
+		}
+		s.header.Set(name, value)
+	}
+}
+
+// NewServer starts a fake AWX server, seeded with the objects of a fresh AWX installation.
+// The caller must Close it when done.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		Username:    "admin",
+		Password:    "password",
+		version:     DefaultVersion,
+		jobStatuses: []string{"pending", "running", "successful"},
+		lastIDs:     make(map[string]int),
+		objects:     make(map[string]map[int]Object),
+		tokens:      make(map[string]int),
+		sessions:    make(map[string]int),
+		csrfTokens:  make(map[string]bool),
+		subscribers: make(map[*subscriber]bool),
+		jobTick:     DefaultJobTick,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.seed()
+	if s.tls {
+		s.Server = httptest.NewTLSServer(s)
+	} else {
+		s.Server = httptest.NewServer(s)
+	}
+	return s
+}
+
+// SetJobStatuses changes the statuses the jobs launched from now on go through.
+func (s *Server) SetJobStatuses(statuses ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobStatuses = statuses
+}
+
+// Create stores a new object in collection, e.g. "projects", validating it as the API would.
+// It returns the ID of the object.
+func (s *Server) Create(collection string, obj Object) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if collections[collection] == nil {
+		return 0, &unknownCollectionError{collection}
+	}
+	status, body := s.create(collection, copyValue(obj).(Object))
+	if status != http.StatusCreated {
+		encoded, _ := json.Marshal(body)
+		return 0, &validationError{string(encoded)}
+	}
+	id, _ := asID(body.(Object)["id"])
+	return id, nil
+}
+
+// Object returns the API representation of the object collection/id.
+func (s *Server) Object(collection string, id int) (Object, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj, ok := s.get(collection, id)
+	if !ok {
+		return nil, false
+	}
+	return s.render(collection, obj), true
+}
+
+// CredentialInput returns the stored value of an input of the credential id, including the secret
+// inputs the API never returns.
+func (s *Server) CredentialInput(id int, field string) (interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj, ok := s.get("credentials", id)
+	if !ok {
+		return nil, false
+	}
+	inputs, _ := obj["inputs"].(Object)
+	value, ok := inputs[field]
+	return value, ok
+}
+
+// Logins returns the number of successful logins through the /api/login/ form.
+func (s *Server) Logins() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.logins
+}
+
+// Sessions returns the number of open sessions.
+func (s *Server) Sessions() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.sessions)
+}
+
+// Objects returns the API representation of every object of collection, ordered by ID.
+func (s *Server) Objects(collection string) []Object {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ids := make([]int, 0, len(s.objects[collection]))
+	for id := range s.objects[collection] {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	objs := make([]Object, 0, len(ids))
+	for _, id := range ids {
+		objs = append(objs, s.render(collection, s.objects[collection][id]))
+	}
+	return objs
+}
+
+type unknownCollectionError struct {
+	collection string
+}
+
+func (e *unknownCollectionError) Error() string {
+	return "awxtest: unknown collection " + e.collection
+}
+
+type validationError struct {
+	body string
+}
+
+func (e *validationError) Error() string {
+	return "awxtest: invalid object: " + e.body
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	for name := range s.header {
+		if r.Header.Get(name) != s.header.Get(name) {
+			writeJSON(w, http.StatusForbidden, detail("Missing header %s.", name))
+			return
+		}
+	}
+	// The WebSocket connections outlive the request, they lock the server on their own.
+	if cleanPath(r.URL.Path) == webSocketPath {
+		s.serveWebSocket(w, r)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := cleanPath(r.URL.Path)
+	switch path {
+	case "/api/":
+		writeJSON(w, http.StatusOK, Object{
+			"description":        "AWX REST API",
+			"current_version":    apiPrefix,
+			"available_versions": Object{"v2": apiPrefix},
+		})
+		return
+	case "/api/login/":
+		s.serveLogin(w, r)
+		return
+	case "/api/logout/":
+		if cookie, err := r.Cookie(sessionCookieName); err == nil {
+			delete(s.sessions, cookie.Value)
+		}
+		http.SetCookie(w, &http.Cookie{Name: sessionCookieName, Value: "", Path: "/", MaxAge: -1})
+		http.Redirect(w, r, "/api/", http.StatusFound)
+		return
+	}
+	if !strings.HasPrefix(path, apiPrefix) {
+		writeJSON(w, http.StatusNotFound, detail("Not found."))
+		return
+	}
+
+	// Split the escaped path, named URLs may hold escaped slashes.
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(cleanPath(r.URL.EscapedPath()), apiPrefix), "/"), "/")
+	if parts[0] == "" {
+		parts = nil
+	}
+	for i, part := range parts {
+		if unescaped, err := url.PathUnescape(part); err == nil {
+			parts[i] = unescaped
+		}
+	}
+	userID, status, body := s.authenticate(r)
+	if status != 0 && !(len(parts) == 1 && parts[0] == "ping") {
+		writeJSON(w, status, body)
+		return
+	}
+
+	data := make(Object)
+	if r.Body != nil && (r.Method == http.MethodPost || r.Method == http.MethodPut || r.Method == http.MethodPatch) {
+		raw, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, detail("Unable to read the request body."))
+			return
+		}
+		if len(strings.TrimSpace(string(raw))) > 0 {
+			if err := json.Unmarshal(raw, &data); err != nil {
+				writeJSON(w, http.StatusBadRequest, detail("JSON parse error - %s", err))
+				return
+			}
+		}
+	}
+
+	status, body = s.route(r.Method, path, parts, r.URL.Query(), data, userID)
+	writeJSON(w, status, body)
+}
+
+// cleanPath collapses duplicate slashes and adds the trailing slash AWX redirects to.
+func cleanPath(path string) string {
+	for strings.Contains(path, "//") {
+		path = strings.ReplaceAll(path, "//", "/")
+	}
+	if !strings.HasSuffix(path, "/") {
+		path += "/"
+	}
+	return path
+}
+
+// writeJSON writes an API response. A nil body writes no content, and a text body is sent as is.
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	if body == nil {
+		w.WriteHeader(status)
+		return
+	}
+	if content, ok := body.(text); ok {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, string(content))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// randomToken returns a random hexadecimal string.
+func randomToken() string {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
+
+// authenticate returns the ID of the user making the request, or the error response to send.
+func (s *Server) authenticate(r *http.Request) (int, int, interface{}) {
+	authorization := r.Header.Get("Authorization")
+	switch {
+	case strings.HasPrefix(authorization, "Bearer "):
+		if userID, ok := s.tokens[strings.TrimPrefix(authorization, "Bearer ")]; ok {
+			return userID, 0, nil
+		}
+		return 0, http.StatusUnauthorized, detail("Invalid token header.")
+	case authorization != "":
+		username, password, ok := r.BasicAuth()
+		if ok {
+			if userID, valid := s.checkPassword(username, password); valid {
+				return userID, 0, nil
+			}
+		}
+		return 0, http.StatusUnauthorized, detail("Invalid username/password.")
+	}
+
+	if cookie, err := r.Cookie(sessionCookieName); err == nil {
+		userID, ok := s.sessions[cookie.Value]
+		if !ok {
+			return 0, http.StatusUnauthorized, detail("Authentication credentials were not provided.")
+		}
+		if r.Method != http.MethodGet && r.Method != http.MethodHead && r.Method != http.MethodOptions {
+			if status, body := checkCSRF(r); status != 0 {
+				return 0, status, body
+			}
+		}
+		return userID, 0, nil
+	}
+	return 0, http.StatusUnauthorized, detail("Authentication credentials were not provided.")
+}
+
+// checkPassword returns the ID of the user with the given credentials.
+func (s *Server) checkPassword(username, password string) (int, bool) {
+	for id, user := range s.objects["users"] {
+		if user["username"] == username && user["password"] == password && password != "" {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+// checkCSRF returns the error response Django sends to an unsafe request whose X-CSRFToken header
+// does not match the CSRF cookie, or whose Referer is not on the server. Django checks the Referer
+// of HTTPS requests only, the fake server checks it on every request.
+func checkCSRF(r *http.Request) (int, interface{}) {
+	csrf, err := r.Cookie(csrfCookieName)
+	if err != nil || r.Header.Get("X-CSRFToken") != csrf.Value {
+		return http.StatusForbidden, detail("CSRF Failed: CSRF token missing or incorrect.")
+	}
+	referer, err := url.Parse(r.Header.Get("Referer"))
+	if err != nil || referer.Host == "" {
+		return http.StatusForbidden, detail("CSRF Failed: Referer checking failed - no Referer.")
+	}
+	if referer.Host != r.Host {
+		return http.StatusForbidden, detail("CSRF Failed: Referer checking failed - %s does not match any trusted origins.", referer)
+	}
+	return 0, nil
+}
+
+// serveLogin implements the Django login form: a GET sets the CSRF cookie, a POST of the
+// credentials sets the session cookie and redirects to the next page.
+func (s *Server) serveLogin(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		token := randomToken()
+		s.csrfTokens[token] = true
+		http.SetCookie(w, &http.Cookie{Name: csrfCookieName, Value: token, Path: "/"})
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = io.WriteString(w, "<html><body><form method=\"post\"></form></body></html>")
+	case http.MethodPost:
+		if csrf, err := r.Cookie(csrfCookieName); err != nil || !s.csrfTokens[csrf.Value] {
+			writeJSON(w, http.StatusForbidden, detail("CSRF Failed: CSRF cookie not set."))
+			return
+		}
+		if status, body := checkCSRF(r); status != 0 {
+			writeJSON(w, status, body)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			writeJSON(w, http.StatusBadRequest, detail("Invalid form."))
+			return
+		}
+		userID, ok := s.checkPassword(r.PostForm.Get("username"), r.PostForm.Get("password"))
+		if !ok {
+			// Django renders the form again on invalid credentials.
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = io.WriteString(w, "<html><body>Please enter a correct username and password.</body></html>")
+			return
+		}
+		session := randomToken()
+		s.sessions[session] = userID
+		s.logins++
+		http.SetCookie(w, &http.Cookie{Name: sessionCookieName, Value: session, Path: "/", HttpOnly: true})
+		next := r.PostForm.Get("next")
+		if next == "" {
+			next = "/api/"
+		}
+		http.Redirect(w, r, next, http.StatusFound)
+	default:
+		writeJSON(w, http.StatusMethodNotAllowed, detail("Method \"%s\" not allowed.", r.Method))
+	}
+}
+
+// route dispatches an authenticated API request.
+func (s *Server) route(method, path string, parts []string, query url.Values, data Object, userID int) (int, interface{}) {
+	if len(parts) == 0 {
+		return s.serveRoot(method)
+	}
+
+	switch parts[0] {
+	case "ping":
+		return s.servePing(method)
+	case "config":
+		return s.serveConfig(method)
+	case "me":
+		return s.list(path, "users", query, func(obj Object) bool {
+			id, _ := asID(obj["id"])
+			return id == userID
+		})
+	case "settings":
+		return s.serveSettings(method, parts[1:], data)
+	case "bulk":
+		return s.serveBulk(method, parts[1:], data, userID)
+	}
+
+	coll := parts[0]
+	def := collections[coll]
+	if def == nil {
+		return notFound()
+	}
+	if len(parts) == 1 {
+		switch method {
+		case http.MethodGet:
+			return s.list(path, coll, query, nil)
+		case http.MethodPost:
+			return s.createFor(coll, data, userID)
+		}
+		return methodNotAllowed(method)
+	}
+
+	id, err := strconv.Atoi(parts[1])
+	if err != nil {
+		if id, err = s.resolveNamedURL(coll, parts[1]); err != nil {
+			return notFound()
+		}
+	}
+	if _, ok := s.get(coll, id); !ok {
+		return notFound()
+	}
+	if len(parts) == 2 {
+		switch method {
+		case http.MethodGet:
+			return s.serveObject(coll, id)
+		case http.MethodPatch:
+			return s.update(coll, id, data, false)
+		case http.MethodPut:
+			return s.update(coll, id, data, true)
+		case http.MethodDelete:
+			return s.remove(coll, id)
+		}
+		return methodNotAllowed(method)
+	}
+	if len(parts) != 3 {
+		return notFound()
+	}
+	return s.serveSubEndpoint(method, path, coll, id, parts[2], query, data, userID)
+}
+
+// createFor creates an object on behalf of userID, handling the objects the server fills in.
+func (s *Server) createFor(coll string, data Object, userID int) (int, interface{}) {
+	switch coll {
+	case "tokens":
+		return s.createToken(data, userID)
+	case "projects":
+		// Source control projects are updated as soon as they are created.
+		status, body := s.create(coll, data)
+		if status != http.StatusCreated || body.(Object)["scm_type"] == "" {
+			return status, body
+		}
+		id, _ := asID(body.(Object)["id"])
+		s.startProjectUpdate(id)
+		return status, s.render(coll, s.objects[coll][id])
+	}
+	return s.create(coll, data)
+}
+
+// createToken issues a personal access token. The token is only shown in the creation response.
+func (s *Server) createToken(data Object, userID int) (int, interface{}) {
+	data["user"] = userID
+	expires := time.Now().AddDate(1000, 0, 0)
+	if s.tokenTTL > 0 {
+		expires = time.Now().Add(s.tokenTTL)
+	}
+	data["expires"] = expires.UTC().Format("2006-01-02T15:04:05.000000Z")
+	status, body := s.create("tokens", data)
+	if status != http.StatusCreated {
+		return status, body
+	}
+	id, _ := asID(body.(Object)["id"])
+	token := randomToken()
+	s.objects["tokens"][id]["token"] = token
+	s.tokens[token] = userID
+	rendered := body.(Object)
+	rendered["token"] = token
+	return status, rendered
+}
+
+// serveObject returns an object, moving jobs to their next status.
+func (s *Server) serveObject(coll string, id int) (int, interface{}) {
+	obj := s.objects[coll][id]
+	if coll == "jobs" || coll == "workflow_jobs" {
+		s.advanceJob(coll, id, obj)
+	}
+	return http.StatusOK, s.render(coll, obj)
+}
+
+// serveSubEndpoint serves the actions, associations and child lists of an object.
+func (s *Server) serveSubEndpoint(method, path, coll string, id int, sub string, query url.Values, data Object, userID int) (int, interface{}) {
+	def := collections[coll]
+	switch {
+	case sub == "launch" && (coll == "job_templates" || coll == "workflow_job_templates"):
+		return s.serveLaunch(method, coll, id, data, userID)
+	case sub == "survey_spec" && (coll == "job_templates" || coll == "workflow_job_templates"):
+		return s.serveSurveySpec(method, coll, id, data)
+	case sub == "cancel" && (coll == "jobs" || coll == "workflow_jobs" || coll == "project_updates"):
+		return s.serveCancel(method, coll, id)
+	case sub == "stdout" && coll == "jobs":
+		return s.serveStdout(method, id, query)
+	case sub == "relaunch" && coll == "jobs":
+		return s.serveRelaunch(method, id, userID)
+	case sub == "update" && coll == "projects":
+		return s.serveProjectUpdate(method, id)
+	}
+
+	if target, ok := def.associations[sub]; ok {
+		switch method {
+		case http.MethodGet:
+			ids, _ := s.objects[coll][id][assocPrefix+sub].([]int)
+			return s.list(path, target, query, func(obj Object) bool {
+				objID, _ := asID(obj["id"])
+				return containsID(ids, objID)
+			})
+		case http.MethodPost:
+			return s.associate(coll, id, sub, target, data)
+		}
+		return methodNotAllowed(method)
+	}
+
+	if child, ok := def.children[sub]; ok {
+		switch method {
+		case http.MethodGet:
+			return s.list(path, child.collection, query, func(obj Object) bool {
+				refID, _ := asID(obj[child.foreignKey])
+				return refID == id
+			})
+		case http.MethodPost:
+			data[child.foreignKey] = id
+			return s.createFor(child.collection, data, userID)
+		}
+		return methodNotAllowed(method)
+	}
+	return notFound()
+}
+
+// serveRoot lists the top level endpoints.
+func (s *Server) serveRoot(method string) (int, interface{}) {
+	if method != http.MethodGet {
+		return methodNotAllowed(method)
+	}
+	endpoints := Object{
+		"ping":     apiPrefix + "ping/",
+		"config":   apiPrefix + "config/",
+		"me":       apiPrefix + "me/",
+		"settings": apiPrefix + "settings/",
+		"bulk":     apiPrefix + "bulk/",
+	}
+	for coll := range collections {
+		endpoints[coll] = apiPrefix + coll + "/"
+	}
+	return http.StatusOK, endpoints
+}
+
+// servePing describes the instance, without authentication.
+func (s *Server) servePing(method string) (int, interface{}) {
+	if method != http.MethodGet {
+		return methodNotAllowed(method)
+	}
+	return http.StatusOK, Object{
+		"ha":           false,
+		"version":      s.version,
+		"active_node":  "awx-1",
+		"install_uuid": "00000000-0000-0000-0000-000000000000",
+		"instances": []interface{}{Object{
+			"node": "awx-1", "node_type": "control", "uuid": "00000000-0000-0000-0000-000000000001",
+			"heartbeat": now(), "capacity": 100, "version": s.version,
+		}},
+		"instance_groups": []interface{}{
+			Object{"name": "controlplane", "capacity": 100, "instances": []interface{}{"awx-1"}},
+			Object{"name": "default", "capacity": 0, "instances": []interface{}{}},
+		},
+	}
+}
+
+// serveConfig describes the installation.
+func (s *Server) serveConfig(method string) (int, interface{}) {
+	if method != http.MethodGet {
+		return methodNotAllowed(method)
+	}
+	return http.StatusOK, Object{
+		"version":             s.version,
+		"time_zone":           "UTC",
+		"license_info":        Object{"license_type": "open", "valid_key": true, "product_name": "AWX"},
+		"analytics_status":    "off",
+		"become_methods":      []interface{}{[]interface{}{"sudo", "Sudo"}, []interface{}{"su", "Su"}},
+		"project_base_dir":    "/var/lib/awx/projects",
+		"project_local_paths": []interface{}{},
+		"custom_virtualenvs":  []interface{}{},
+	}
+}
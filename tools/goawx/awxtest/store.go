@@ -0,0 +1,745 @@
+package awxtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Hidden fields are kept in the stored objects but never rendered.
+const (
+	hiddenPrefix      = "_"
+	assocPrefix       = "_assoc_"
+	rolesField        = "_roles"
+	surveySpecField   = "_survey_spec"
+	statusesField     = "_statuses"
+	roleResourceField = "_resource"
+	lastUpdateField   = "_last_update"
+)
+
+const (
+	defaultPageSize = 25
+	maxPageSize     = 200
+)
+
+// readOnlyFields are computed by the server and ignored in payloads.
+//
+//nolint:gochecknoglobals
+var readOnlyFields = map[string]bool{
+	"id": true, "type": true, "url": true, "related": true, "summary_fields": true, "created": true, "modified": true,
+}
+
+// reservedQueryParams are list parameters that are not filters.
+//
+//nolint:gochecknoglobals
+var reservedQueryParams = map[string]bool{"page": true, "page_size": true, "order_by": true, "search": true, "format": true}
+
+// fieldErrors is the body AWX returns for invalid payloads.
+type fieldErrors map[string][]string
+
+func (e fieldErrors) add(field, format string, args ...interface{}) {
+	e[field] = append(e[field], fmt.Sprintf(format, args...))
+}
+
+// detail is the body AWX returns for errors that are not about a field.
+func detail(format string, args ...interface{}) Object {
+	return Object{"detail": fmt.Sprintf(format, args...)}
+}
+
+// notFound is the response of missing objects and endpoints.
+func notFound() (int, interface{}) {
+	return http.StatusNotFound, detail("Not found.")
+}
+
+// methodNotAllowed is the response of unsupported methods.
+func methodNotAllowed(method string) (int, interface{}) {
+	return http.StatusMethodNotAllowed, detail("Method \"%s\" not allowed.", method)
+}
+
+// copyValue deep copies a decoded JSON value, so that stored objects never share maps.
+func copyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case Object:
+		out := make(Object, len(v))
+		for key, item := range v {
+			out[key] = copyValue(item)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[i] = copyValue(item)
+		}
+		return out
+	case []int:
+		return append([]int(nil), v...)
+	case map[string]int:
+		out := make(map[string]int, len(v))
+		for key, item := range v {
+			out[key] = item
+		}
+		return out
+	}
+	return value
+}
+
+// asID converts a decoded JSON value into an object ID. Numeric strings are accepted, as AWX does.
+func asID(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case float64:
+		if v == math.Trunc(v) {
+			return int(v), true
+		}
+	case json.Number:
+		if id, err := strconv.Atoi(v.String()); err == nil {
+			return id, true
+		}
+	case string:
+		if id, err := strconv.Atoi(v); err == nil {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+// formatValue renders a field value as compared by list filters.
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		if v == math.Trunc(v) {
+			return strconv.FormatInt(int64(v), 10)
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return fmt.Sprint(value)
+}
+
+// now returns the timestamp format used by AWX.
+func now() string {
+	return time.Now().UTC().Format("2006-01-02T15:04:05.000000Z")
+}
+
+// insert stores obj in coll under a new ID.
+func (s *Server) insert(coll string, obj Object) int {
+	s.lastIDs[coll]++
+	id := s.lastIDs[coll]
+	obj["id"] = id
+	obj["created"] = now()
+	obj["modified"] = obj["created"]
+	if s.objects[coll] == nil {
+		s.objects[coll] = make(map[int]Object)
+	}
+	s.objects[coll][id] = obj
+
+	if def := collections[coll]; len(def.roles) > 0 {
+		roles := make(map[string]int, len(def.roles))
+		for _, role := range def.roles {
+			roles[role] = s.insert("roles", Object{
+				"name":            roleDisplayName(role),
+				"description":     fmt.Sprintf("May %s the %s", strings.TrimSuffix(role, "_role"), def.typ),
+				roleResourceField: Object{"collection": coll, "id": id},
+			})
+		}
+		obj[rolesField] = roles
+	}
+	return id
+}
+
+// roleDisplayName turns a role field into the role name shown by AWX, e.g. "Project Admin".
+func roleDisplayName(role string) string {
+	return displayName(strings.TrimSuffix(role, "_role"))
+}
+
+// get returns the stored object coll/id.
+func (s *Server) get(coll string, id int) (Object, bool) {
+	obj, ok := s.objects[coll][id]
+	return obj, ok
+}
+
+// lookup returns the object id in the first of colls holding one.
+func (s *Server) lookup(colls []string, id int) (string, Object) {
+	for _, coll := range colls {
+		if obj, ok := s.get(coll, id); ok {
+			return coll, obj
+		}
+	}
+	return "", nil
+}
+
+// create validates data and stores it as a new object of coll.
+func (s *Server) create(coll string, data Object) (int, interface{}) {
+	def := collections[coll]
+	obj := make(Object)
+	for key, value := range def.defaults {
+		obj[key] = copyValue(value)
+	}
+	if errs := s.apply(coll, 0, obj, data, true); len(errs) > 0 {
+		return http.StatusBadRequest, errs
+	}
+	s.insert(coll, obj)
+	return http.StatusCreated, s.render(coll, obj)
+}
+
+// update validates data and applies it to the object coll/id. A PUT requires the same fields as a creation.
+func (s *Server) update(coll string, id int, data Object, replace bool) (int, interface{}) {
+	obj, ok := s.get(coll, id)
+	if !ok {
+		return notFound()
+	}
+	updated := copyValue(obj).(Object)
+	if coll == "credentials" {
+		s.keepEncryptedInputs(obj, data)
+	}
+	if errs := s.apply(coll, id, updated, data, replace); len(errs) > 0 {
+		return http.StatusBadRequest, errs
+	}
+	updated["modified"] = now()
+	s.objects[coll][id] = updated
+	return http.StatusOK, s.render(coll, updated)
+}
+
+// remove deletes the object coll/id and its roles.
+func (s *Server) remove(coll string, id int) (int, interface{}) {
+	obj, ok := s.get(coll, id)
+	if !ok {
+		return notFound()
+	}
+	if roles, ok := obj[rolesField].(map[string]int); ok {
+		for _, roleID := range roles {
+			delete(s.objects["roles"], roleID)
+		}
+	}
+	if token, ok := obj["token"].(string); ok && coll == "tokens" {
+		delete(s.tokens, token)
+	}
+	delete(s.objects[coll], id)
+	return http.StatusNoContent, nil
+}
+
+// apply validates data against the collection definition and merges it into obj.
+func (s *Server) apply(coll string, id int, obj, data Object, full bool) fieldErrors {
+	def := collections[coll]
+	errs := make(fieldErrors)
+
+	for key, value := range data {
+		if readOnlyFields[key] || strings.HasPrefix(key, hiddenPrefix) {
+			continue
+		}
+		if targets, ok := def.foreignKeys[key]; ok {
+			ref, valid := s.validateReference(targets, value)
+			if !valid {
+				errs.add(key, "Invalid pk \"%s\" - object does not exist.", formatValue(value))
+				continue
+			}
+			obj[key] = ref
+			continue
+		}
+		obj[key] = copyValue(value)
+	}
+
+	if full {
+		required := def.required
+		if def.nameField != "" {
+			required = append([]string{def.nameField}, required...)
+		}
+		for _, field := range required {
+			if _, invalid := errs[field]; invalid {
+				continue
+			}
+			if value, ok := obj[field]; !ok || value == nil || value == "" {
+				errs.add(field, "This field is required.")
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	if def.nameField != "" && def.uniqueWith != nil {
+		if s.duplicate(coll, id, obj) {
+			fields := []string{displayName(def.nameField)}
+			for _, field := range def.uniqueWith {
+				fields = append(fields, displayName(field))
+			}
+			errs.add("__all__", "%s with this %s already exists.", displayName(def.typ), strings.Join(fields, " and "))
+		}
+	}
+	return errs
+}
+
+// validateReference resolves a foreign key value. Null and empty values clear the reference.
+func (s *Server) validateReference(targets []string, value interface{}) (interface{}, bool) {
+	if value == nil || value == "" {
+		return nil, true
+	}
+	id, ok := asID(value)
+	if !ok {
+		return nil, false
+	}
+	if _, obj := s.lookup(targets, id); obj == nil {
+		return nil, false
+	}
+	return id, true
+}
+
+// duplicate reports whether another object of coll has the same name and unique together fields as obj.
+func (s *Server) duplicate(coll string, id int, obj Object) bool {
+	def := collections[coll]
+	for otherID, other := range s.objects[coll] {
+		if otherID == id || formatValue(other[def.nameField]) != formatValue(obj[def.nameField]) {
+			continue
+		}
+		same := true
+		for _, field := range def.uniqueWith {
+			if formatValue(other[field]) != formatValue(obj[field]) {
+				same = false
+				break
+			}
+		}
+		if same {
+			return true
+		}
+	}
+	return false
+}
+
+// encryptedValue replaces the secret credential inputs in the API responses. Sending it back keeps the
+// stored value.
+const encryptedValue = "$encrypted$"
+
+// secretInputs returns the secret inputs of the type of the credential obj.
+func (s *Server) secretInputs(obj Object) map[string]bool {
+	secrets := make(map[string]bool)
+	typeID, _ := asID(obj["credential_type"])
+	credentialType, ok := s.get("credential_types", typeID)
+	if !ok {
+		return secrets
+	}
+	inputs, _ := credentialType["inputs"].(Object)
+	fields, _ := inputs["fields"].([]interface{})
+	for _, field := range fields {
+		if field, ok := field.(Object); ok && field["secret"] == true {
+			id, _ := field["id"].(string)
+			secrets[id] = true
+		}
+	}
+	return secrets
+}
+
+// maskSecretInputs replaces the secret inputs of the rendered credential out, as AWX does.
+func (s *Server) maskSecretInputs(out Object) {
+	inputs, ok := out["inputs"].(Object)
+	if !ok {
+		return
+	}
+	for field := range s.secretInputs(out) {
+		if value, ok := inputs[field]; ok && value != "" {
+			inputs[field] = encryptedValue
+		}
+	}
+}
+
+// keepEncryptedInputs replaces the masked secret inputs sent back in data by the stored ones of obj.
+func (s *Server) keepEncryptedInputs(obj, data Object) {
+	inputs, ok := data["inputs"].(Object)
+	if !ok {
+		return
+	}
+	stored, _ := obj["inputs"].(Object)
+	for field := range s.secretInputs(obj) {
+		if inputs[field] == encryptedValue {
+			inputs[field] = stored[field]
+		}
+	}
+}
+
+// objectURL returns the API path of coll/id.
+func objectURL(coll string, id int) string {
+	return fmt.Sprintf("%s%s/%d/", apiPrefix, coll, id)
+}
+
+// render returns the API representation of obj, with the related links and summary fields AWX adds.
+func (s *Server) render(coll string, obj Object) Object {
+	def := collections[coll]
+	id, _ := asID(obj["id"])
+	out := make(Object, len(obj)+4)
+	for key, value := range obj {
+		if strings.HasPrefix(key, hiddenPrefix) {
+			continue
+		}
+		out[key] = copyValue(value)
+	}
+	for _, field := range def.secretFields {
+		delete(out, field)
+	}
+	if coll == "credentials" {
+		s.maskSecretInputs(out)
+	}
+	out["type"] = def.typ
+	out["url"] = objectURL(coll, id)
+
+	related := make(Object)
+	summary := make(Object)
+	for field, targets := range def.foreignKeys {
+		refID, ok := asID(obj[field])
+		if !ok {
+			continue
+		}
+		refColl, ref := s.lookup(targets, refID)
+		if ref == nil {
+			continue
+		}
+		related[field] = objectURL(refColl, refID)
+		summary[field] = s.summary(refColl, ref)
+	}
+	for sub := range def.associations {
+		related[sub] = objectURL(coll, id) + sub + "/"
+		if inlineAssociations[sub] {
+			ids, _ := obj[assocPrefix+sub].([]int)
+			list := make([]interface{}, 0, len(ids))
+			for _, assocID := range ids {
+				list = append(list, assocID)
+			}
+			out[sub] = list
+		}
+	}
+	for sub := range def.children {
+		related[sub] = objectURL(coll, id) + sub + "/"
+	}
+
+	if roles, ok := obj[rolesField].(map[string]int); ok {
+		objectRoles := make(Object, len(roles))
+		for field, roleID := range roles {
+			if role, ok := s.get("roles", roleID); ok {
+				objectRoles[field] = Object{"id": roleID, "name": role["name"], "description": role["description"]}
+			}
+		}
+		summary["object_roles"] = objectRoles
+	}
+	if coll == "roles" {
+		if resource, ok := obj[roleResourceField].(Object); ok {
+			resourceColl, _ := resource["collection"].(string)
+			resourceID, _ := asID(resource["id"])
+			if target, ok := s.get(resourceColl, resourceID); ok {
+				summary["resource_name"] = target[collections[resourceColl].nameField]
+				summary["resource_type"] = collections[resourceColl].typ
+				summary["resource_type_display_name"] = displayName(collections[resourceColl].typ)
+				summary["resource_id"] = resourceID
+			}
+		}
+	}
+	if updateID, ok := obj[lastUpdateField].(int); ok {
+		if update, ok := s.get("project_updates", updateID); ok {
+			summary["last_job"] = Object{
+				"id": updateID, "name": update["name"], "status": update["status"],
+				"failed": update["failed"], "finished": update["finished"],
+			}
+			summary["last_update"] = Object{"id": updateID, "status": update["status"], "failed": update["failed"]}
+		}
+	}
+	summary["user_capabilities"] = Object{"edit": true, "delete": true, "start": true, "schedule": true, "copy": true}
+
+	out["related"] = related
+	out["summary_fields"] = summary
+	return out
+}
+
+// summary returns the abstract of a referenced object, as nested in summary_fields.
+func (s *Server) summary(coll string, obj Object) Object {
+	out := Object{"id": obj["id"]}
+	if nameField := collections[coll].nameField; nameField != "" {
+		out[nameField] = obj[nameField]
+		out["name"] = obj[nameField]
+	}
+	if description, ok := obj["description"]; ok {
+		out["description"] = description
+	}
+	return out
+}
+
+// list returns a page of the objects of coll matching the query filters and accepted by keep.
+func (s *Server) list(path, coll string, query url.Values, keep func(Object) bool) (int, interface{}) {
+	var matches []Object
+	for _, obj := range s.objects[coll] {
+		if keep != nil && !keep(obj) {
+			continue
+		}
+		match, err := s.matches(coll, obj, query)
+		if err != nil {
+			return http.StatusBadRequest, detail("%s", err)
+		}
+		if match {
+			matches = append(matches, obj)
+		}
+	}
+	s.sortObjects(matches, query.Get("order_by"))
+
+	page, pageSize, err := pageParams(query)
+	if err != nil {
+		return http.StatusNotFound, detail("Invalid page.")
+	}
+	start := (page - 1) * pageSize
+	if start > len(matches) || (start == len(matches) && page > 1) {
+		return http.StatusNotFound, detail("Invalid page.")
+	}
+	end := start + pageSize
+	if end > len(matches) {
+		end = len(matches)
+	}
+
+	results := make([]interface{}, 0, end-start)
+	for _, obj := range matches[start:end] {
+		results = append(results, s.render(coll, obj))
+	}
+	body := Object{"count": len(matches), "next": nil, "previous": nil, "results": results}
+	if end < len(matches) {
+		body["next"] = pageURL(path, query, page+1)
+	}
+	if page > 1 {
+		body["previous"] = pageURL(path, query, page-1)
+	}
+	return http.StatusOK, body
+}
+
+// pageParams reads the page and page_size list parameters.
+func pageParams(query url.Values) (page, pageSize int, err error) {
+	page, pageSize = 1, defaultPageSize
+	if value := query.Get("page"); value != "" {
+		if page, err = strconv.Atoi(value); err != nil || page < 1 {
+			return 0, 0, fmt.Errorf("invalid page %q", value)
+		}
+	}
+	if value := query.Get("page_size"); value != "" {
+		if pageSize, err = strconv.Atoi(value); err != nil || pageSize < 1 {
+			pageSize = defaultPageSize
+		}
+		if pageSize > maxPageSize {
+			pageSize = maxPageSize
+		}
+	}
+	return page, pageSize, nil
+}
+
+// pageURL returns the link to another page of a list.
+func pageURL(path string, query url.Values, page int) string {
+	params := url.Values{}
+	for key, values := range query {
+		params[key] = values
+	}
+	params.Set("page", strconv.Itoa(page))
+	return path + "?" + params.Encode()
+}
+
+// sortObjects orders a list by ID, or by the order_by field, descending when prefixed with "-".
+func (s *Server) sortObjects(objs []Object, orderBy string) {
+	field, descending := "id", false
+	if orderBy != "" {
+		field = strings.TrimPrefix(orderBy, "-")
+		descending = strings.HasPrefix(orderBy, "-")
+	}
+	sort.SliceStable(objs, func(i, j int) bool {
+		a, b := objs[i][field], objs[j][field]
+		var less bool
+		if x, ok := asID(a); ok {
+			y, _ := asID(b)
+			less = x < y
+		} else {
+			less = formatValue(a) < formatValue(b)
+		}
+		if descending {
+			return !less && formatValue(a) != formatValue(b)
+		}
+		return less
+	})
+}
+
+// matches reports whether obj satisfies the Django style filters of a list query, e.g.
+// name=x, name__icontains=x, organization__name=x or id__in=1,2.
+func (s *Server) matches(coll string, obj Object, query url.Values) (bool, error) {
+	for key, values := range query {
+		if reservedQueryParams[key] {
+			continue
+		}
+		negate := false
+		if strings.HasPrefix(key, "not__") {
+			negate, key = true, strings.TrimPrefix(key, "not__")
+		}
+		for _, expected := range values {
+			ok, err := s.matchFilter(coll, obj, strings.Split(key, "__"), expected)
+			if err != nil {
+				return false, err
+			}
+			if ok == negate {
+				return false, nil
+			}
+		}
+	}
+	if search := query.Get("search"); search != "" {
+		text := strings.ToLower(formatValue(obj[collections[coll].nameField]) + " " + formatValue(obj["description"]))
+		if !strings.Contains(text, strings.ToLower(search)) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// matchFilter evaluates a single filter, following foreign keys for lookups such as organization__name.
+func (s *Server) matchFilter(coll string, obj Object, parts []string, expected string) (bool, error) {
+	lookup := "exact"
+	switch parts[len(parts)-1] {
+	case "exact", "iexact", "contains", "icontains", "startswith", "istartswith", "in", "isnull", "gt", "gte", "lt", "lte":
+		lookup = parts[len(parts)-1]
+		parts = parts[:len(parts)-1]
+	}
+	if len(parts) == 0 {
+		return false, fmt.Errorf("invalid filter")
+	}
+
+	value, found := obj[parts[0]]
+	for _, part := range parts[1:] {
+		targets, ok := collections[coll].foreignKeys[parts[0]]
+		if !ok {
+			return false, fmt.Errorf("invalid field %q", parts[0])
+		}
+		refID, _ := asID(value)
+		refColl, ref := s.lookup(targets, refID)
+		if ref == nil {
+			value, found = nil, true
+			break
+		}
+		coll, value, found = refColl, ref[part], true
+		parts = parts[1:]
+	}
+	if !found && parts[0] != "id" {
+		value = nil
+	}
+
+	actual := formatValue(value)
+	switch lookup {
+	case "exact":
+		if b, ok := value.(bool); ok {
+			return strings.EqualFold(expected, strconv.FormatBool(b)), nil
+		}
+		return actual == expected, nil
+	case "iexact":
+		return strings.EqualFold(actual, expected), nil
+	case "contains":
+		return strings.Contains(actual, expected), nil
+	case "icontains":
+		return strings.Contains(strings.ToLower(actual), strings.ToLower(expected)), nil
+	case "startswith":
+		return strings.HasPrefix(actual, expected), nil
+	case "istartswith":
+		return strings.HasPrefix(strings.ToLower(actual), strings.ToLower(expected)), nil
+	case "in":
+		for _, item := range strings.Split(expected, ",") {
+			if actual == item {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "isnull":
+		return (value == nil) == strings.EqualFold(expected, "true"), nil
+	default:
+		x, errX := strconv.ParseFloat(actual, 64)
+		y, errY := strconv.ParseFloat(expected, 64)
+		if errX != nil || errY != nil {
+			return false, nil
+		}
+		switch lookup {
+		case "gt":
+			return x > y, nil
+		case "gte":
+			return x >= y, nil
+		case "lt":
+			return x < y, nil
+		}
+		return x <= y, nil
+	}
+}
+
+// associate adds or, with disassociate, removes the object data["id"] of target to the sub
+// association of the owner object. Payloads without ID create the object before associating it.
+func (s *Server) associate(ownerColl string, ownerID int, sub, target string, data Object) (int, interface{}) {
+	owner, ok := s.get(ownerColl, ownerID)
+	if !ok {
+		return notFound()
+	}
+	key := assocPrefix + sub
+	ids, _ := owner[key].([]int)
+
+	rawID, hasID := data["id"]
+	if !hasID {
+		// Objects created through an association reference the owner, or inherit the
+		// required references of the owner, e.g. the workflow of a workflow node.
+		for field, targets := range collections[target].foreignKeys {
+			if _, set := data[field]; set {
+				continue
+			}
+			if contains(targets, ownerColl) {
+				data[field] = ownerID
+			} else if contains(collections[target].required, field) && owner[field] != nil {
+				data[field] = owner[field]
+			}
+		}
+		status, body := s.create(target, data)
+		if status != http.StatusCreated {
+			return status, body
+		}
+		createdID, _ := asID(body.(Object)["id"])
+		owner[key] = append(ids, createdID)
+		return status, s.render(target, s.objects[target][createdID])
+	}
+
+	id, ok := asID(rawID)
+	if !ok {
+		return http.StatusBadRequest, detail("\"id\" is required to disassociate")
+	}
+	if _, exists := s.get(target, id); !exists {
+		return http.StatusBadRequest, detail("Object with id=%d does not exist.", id)
+	}
+	if disassociate, _ := data["disassociate"].(bool); disassociate {
+		kept := ids[:0]
+		for _, existing := range ids {
+			if existing != id {
+				kept = append(kept, existing)
+			}
+		}
+		owner[key] = kept
+		return http.StatusNoContent, nil
+	}
+	if !containsID(ids, id) {
+		owner[key] = append(ids, id)
+	}
+	return http.StatusNoContent, nil
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsID(ids []int, id int) bool {
+	for _, item := range ids {
+		if item == id {
+			return true
+		}
+	}
+	return false
+}
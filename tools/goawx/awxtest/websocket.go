@@ -0,0 +1,230 @@
+package awxtest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+const (
+	webSocketPath = "/websocket/"
+	// webSocketSendBuffer is the number of notifications queued for a slow subscriber before
+	// the following ones are dropped.
+	webSocketSendBuffer = 256
+)
+
+// DefaultJobTick is the interval at which jobs move to their next status while a WebSocket
+// client watches their status changes, see WithJobTick.
+const DefaultJobTick = 10 * time.Millisecond
+
+// WithJobTick sets the interval at which jobs move to their next status while a WebSocket client
+// is subscribed to the jobs group: such a client does not read the jobs, which otherwise only
+// move on when read.
+func WithJobTick(interval time.Duration) Option {
+	return func(s *Server) {
+		s.jobTick = interval
+	}
+}
+
+// subscriber is a client of the WebSocket channel.
+type subscriber struct {
+	conn   *websocket.Conn
+	userID int
+	groups map[string]bool
+	send   chan Object
+	done   chan struct{}
+}
+
+// serveWebSocket implements the AWX channels consumer: the connection of a logged in session is
+// accepted, then the client subscribes to groups with the CSRF token of its session as xrftoken.
+func (s *Server) serveWebSocket(w http.ResponseWriter, r *http.Request) {
+	server := websocket.Server{
+		Handshake: func(_ *websocket.Config, r *http.Request) error {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			cookie, err := r.Cookie(sessionCookieName)
+			if err != nil {
+				return errors.New("no session")
+			}
+			if _, ok := s.sessions[cookie.Value]; !ok {
+				return errors.New("invalid session")
+			}
+			return nil
+		},
+		Handler: s.handleWebSocket,
+	}
+	server.ServeHTTP(w, r)
+}
+
+// handleWebSocket serves an accepted connection until the client or the server closes it.
+func (s *Server) handleWebSocket(conn *websocket.Conn) {
+	cookie, _ := conn.Request().Cookie(sessionCookieName)
+	s.mu.Lock()
+	sub := &subscriber{
+		conn:   conn,
+		userID: s.sessions[cookie.Value],
+		groups: make(map[string]bool),
+		send:   make(chan Object, webSocketSendBuffer),
+		done:   make(chan struct{}),
+	}
+	s.subscribers[sub] = true
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.subscribers, sub)
+		s.mu.Unlock()
+		close(sub.done)
+		_ = conn.Close()
+	}()
+
+	go sub.write()
+	sub.send <- Object{"accept": true, "user": sub.userID}
+	for {
+		var msg Object
+		if err := websocket.JSON.Receive(conn, &msg); err != nil {
+			return
+		}
+		groups, ok := msg["groups"].(Object)
+		if !ok {
+			continue
+		}
+		csrf, err := conn.Request().Cookie(csrfCookieName)
+		if err != nil || msg["xrftoken"] != csrf.Value {
+			sub.send <- Object{"error": "access denied to channel"}
+			continue
+		}
+		s.subscribe(sub, groups)
+	}
+}
+
+// subscribe replaces the groups of a subscriber, and starts moving the jobs on for it when it
+// joins the jobs group.
+func (s *Server) subscribe(sub *subscriber, groups Object) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	watching := sub.groups["jobs-status_changed"]
+	current := make(map[string]bool)
+	for group, raw := range groups {
+		events, _ := raw.([]interface{})
+		for _, event := range events {
+			current[fmt.Sprintf("%s-%v", group, event)] = true
+		}
+	}
+	var joined, left, names []string
+	for name := range current {
+		names = append(names, name)
+		if !sub.groups[name] {
+			joined = append(joined, name)
+		}
+	}
+	for name := range sub.groups {
+		if !current[name] {
+			left = append(left, name)
+		}
+	}
+	sort.Strings(names)
+	sort.Strings(joined)
+	sort.Strings(left)
+	sub.groups = current
+
+	sub.send <- Object{"groups_current": names, "groups_joined": joined, "groups_left": left}
+	if !watching && current["jobs-status_changed"] {
+		go s.tickJobs(sub)
+	}
+}
+
+// write sends the queued messages of a subscriber.
+func (sub *subscriber) write() {
+	for {
+		select {
+		case msg := <-sub.send:
+			if err := websocket.JSON.Send(sub.conn, msg); err != nil {
+				return
+			}
+		case <-sub.done:
+			return
+		}
+	}
+}
+
+// notify queues a message for the subscribers of group, without blocking on slow subscribers.
+func (s *Server) notify(group string, msg Object) {
+	for sub := range s.subscribers {
+		if !sub.groups[group] {
+			continue
+		}
+		select {
+		case sub.send <- msg:
+		default:
+		}
+	}
+}
+
+// notifyJobStatus sends the status of a job to the subscribers of its status changes.
+func (s *Server) notifyJobStatus(coll string, id int) {
+	job := s.objects[coll][id]
+	s.notify("jobs-status_changed", Object{
+		"group_name":              "jobs",
+		"type":                    strings.TrimSuffix(coll, "s"),
+		"unified_job_id":          id,
+		"unified_job_template_id": job["unified_job_template"],
+		"status":                  job["status"],
+	})
+}
+
+// tickJobs moves every unfinished job to its next status at the job tick, as long as the
+// subscriber watches their status changes.
+func (s *Server) tickJobs(sub *subscriber) {
+	ticker := time.NewTicker(s.jobTick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-sub.done:
+			return
+		case <-ticker.C:
+		}
+
+		s.mu.Lock()
+		if !sub.groups["jobs-status_changed"] {
+			s.mu.Unlock()
+			return
+		}
+		for _, coll := range []string{"jobs", "workflow_jobs"} {
+			for id, job := range s.objects[coll] {
+				s.advanceJob(coll, id, job)
+			}
+		}
+		s.mu.Unlock()
+	}
+}
+
+// ExpireSessions ends every session, as AWX does when a user opens more sessions than
+// SESSIONS_PER_USER allows, and tells the WebSocket subscribers of the control group.
+func (s *Server) ExpireSessions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	users := make(map[int]bool)
+	for session, userID := range s.sessions {
+		users[userID] = true
+		delete(s.sessions, session)
+	}
+	for userID := range users {
+		s.notify(fmt.Sprintf("control-limit_reached_%d", userID), Object{"group_name": "control", "reason": "limit_reached"})
+	}
+}
+
+// Close closes the WebSocket connections, then shuts down the server.
+func (s *Server) Close() {
+	s.mu.Lock()
+	for sub := range s.subscribers {
+		_ = sub.conn.Close()
+	}
+	s.mu.Unlock()
+	s.Server.Close()
+}
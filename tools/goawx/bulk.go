@@ -0,0 +1,173 @@
+package awx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// BulkService implements the awx bulk apis, which create or delete many hosts, or launch many
+// jobs, in a single request.
+type BulkService struct {
+	client *Client
+}
+
+const (
+	bulkHostCreateAPIEndpoint = "/api/v2/bulk/host_create/"
+	bulkHostDeleteAPIEndpoint = "/api/v2/bulk/host_delete/"
+	bulkJobLaunchAPIEndpoint  = "/api/v2/bulk/job_launch/"
+)
+
+// Default number of hosts AWX accepts in a single bulk request, see the BULK_HOST_MAX_CREATE
+// and BULK_HOST_MAX_DELETE settings.
+const (
+	BulkHostCreateLimit = 100
+	BulkHostDeleteLimit = 250
+)
+
+// BulkHost is a host to create with BulkService.CreateHosts.
+type BulkHost struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+	InstanceID  *string `json:"instance_id,omitempty"`
+	Variables   *string `json:"variables,omitempty"`
+}
+
+// BulkHostCreateResponse represents the `bulk/host_create` endpoint response.
+type BulkHostCreateResponse struct {
+	URL   string  `json:"url"`
+	Hosts []*Host `json:"hosts"`
+}
+
+// BulkHostDeleteResponse represents the `bulk/host_delete` endpoint response, a message per deleted host ID.
+type BulkHostDeleteResponse struct {
+	Hosts map[string]string `json:"hosts"`
+}
+
+// BulkJob is a job of a BulkJobLaunchRequest. Prompts left nil use the defaults of the template.
+type BulkJob struct {
+	UnifiedJobTemplate   int         `json:"unified_job_template"`
+	Identifier           *string     `json:"identifier,omitempty"`
+	Inventory            *int        `json:"inventory,omitempty"`
+	Credentials          []int       `json:"credentials,omitempty"`
+	Labels               []int       `json:"labels,omitempty"`
+	InstanceGroups       []int       `json:"instance_groups,omitempty"`
+	ExecutionEnvironment *int        `json:"execution_environment,omitempty"`
+	ExtraData            interface{} `json:"extra_data,omitempty"`
+	Limit                *string     `json:"limit,omitempty"`
+	ScmBranch            *string     `json:"scm_branch,omitempty"`
+	JobType              *string     `json:"job_type,omitempty"`
+	JobTags              *string     `json:"job_tags,omitempty"`
+	SkipTags             *string     `json:"skip_tags,omitempty"`
+	Verbosity            *int        `json:"verbosity,omitempty"`
+	DiffMode             *bool       `json:"diff_mode,omitempty"`
+	Forks                *int        `json:"forks,omitempty"`
+	JobSliceCount        *int        `json:"job_slice_count,omitempty"`
+	Timeout              *int        `json:"timeout,omitempty"`
+}
+
+// BulkJobLaunchRequest is the payload of the `bulk/job_launch` endpoint. The jobs run as the nodes
+// of a single workflow job, whose prompts apply to every job.
+type BulkJobLaunchRequest struct {
+	Name         string      `json:"name,omitempty"`
+	Description  *string     `json:"description,omitempty"`
+	Jobs         []*BulkJob  `json:"jobs"`
+	Organization *int        `json:"organization,omitempty"`
+	Inventory    *int        `json:"inventory,omitempty"`
+	Limit        *string     `json:"limit,omitempty"`
+	ScmBranch    *string     `json:"scm_branch,omitempty"`
+	ExtraVars    interface{} `json:"extra_vars,omitempty"`
+	JobTags      *string     `json:"job_tags,omitempty"`
+	SkipTags     *string     `json:"skip_tags,omitempty"`
+}
+
+// BulkJobLaunch represents the workflow job started by the `bulk/job_launch` endpoint.
+type BulkJobLaunch struct {
+	ID      int      `json:"id"`
+	Type    string   `json:"type"`
+	URL     string   `json:"url"`
+	Related *Related `json:"related"`
+	Name    string   `json:"name"`
+	Status  string   `json:"status"`
+	Failed  bool     `json:"failed"`
+}
+
+// CreateHosts creates hosts in the inventory, in requests of at most BulkHostCreateLimit hosts.
+// A request creates all of its hosts or none, but hosts created by the requests preceding a
+// failure are kept: they are returned along with the error.
+func (b *BulkService) CreateHosts(ctx context.Context, inventoryID int, hosts []*BulkHost) ([]*Host, error) {
+	var created []*Host
+	for start := 0; start < len(hosts); start += BulkHostCreateLimit {
+		end := start + BulkHostCreateLimit
+		if end > len(hosts) {
+			end = len(hosts)
+		}
+
+		result := new(BulkHostCreateResponse)
+		if err := b.post(ctx, bulkHostCreateAPIEndpoint, map[string]interface{}{
+			"inventory": inventoryID,
+			"hosts":     hosts[start:end],
+		}, result); err != nil {
+			return created, err
+		}
+		created = append(created, result.Hosts...)
+	}
+
+	return created, nil
+}
+
+// DeleteHosts deletes the hosts, in requests of at most BulkHostDeleteLimit hosts.
+func (b *BulkService) DeleteHosts(ctx context.Context, ids []int) error {
+	for start := 0; start < len(ids); start += BulkHostDeleteLimit {
+		end := start + BulkHostDeleteLimit
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		result := new(BulkHostDeleteResponse)
+		if err := b.post(ctx, bulkHostDeleteAPIEndpoint, map[string]interface{}{
+			"hosts": ids[start:end],
+		}, result); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LaunchJobs launches the jobs of req as a single workflow job.
+func (b *BulkService) LaunchJobs(ctx context.Context, req *BulkJobLaunchRequest) (*BulkJobLaunch, error) {
+	if len(req.Jobs) == 0 {
+		return nil, fmt.Errorf("mandatory input arguments are absent: [jobs]")
+	}
+
+	result := new(BulkJobLaunch)
+	if err := b.post(ctx, bulkJobLaunchAPIEndpoint, req, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (b *BulkService) post(ctx context.Context, endpoint string, data interface{}, result interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	resp, err := b.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, map[string]string{})
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				logCloseError(ctx, err)
+			}
+		}()
+	}
+	if err != nil {
+		return err
+	}
+
+	return CheckResponse(resp)
+}
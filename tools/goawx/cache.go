@@ -0,0 +1,224 @@
+package awx
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// volatileCollections are the endpoints whose content changes without a write from the client,
+// such as the status of running jobs or the tokens expiring and revoked, and that are never cached.
+//
+//nolint:gochecknoglobals
+var volatileCollections = map[string]bool{
+	"activity_stream":    true,
+	"ad_hoc_commands":    true,
+	"authorized_tokens":  true,
+	"inventory_updates":  true,
+	"job_events":         true,
+	"job_host_summaries": true,
+	"jobs":               true,
+	"personal_tokens":    true,
+	"ping":               true,
+	"project_updates":    true,
+	"stdout":             true,
+	"system_jobs":        true,
+	"tokens":             true,
+	"unified_jobs":       true,
+	"workflow_approvals": true,
+	"workflow_jobs":      true,
+	"workflow_nodes":     true,
+}
+
+// writeSideEffects are the collections changed by a write to an endpoint, besides the collections
+// named by its path: the bulk endpoints create, delete and launch objects of other collections.
+//
+//nolint:gochecknoglobals
+var writeSideEffects = map[string][]string{
+	"host_create": {"hosts", "inventories", "groups"},
+	"host_delete": {"hosts", "inventories", "groups"},
+	"job_launch":  {"job_templates", "workflow_job_templates", "jobs", "workflow_jobs"},
+}
+
+// deleteCascades are the collections whose objects AWX deletes along with an object of a collection.
+// Deleting an organization cascades to most collections, and drops the whole cache.
+//
+//nolint:gochecknoglobals
+var deleteCascades = map[string][]string{
+	"inventories":   {"hosts", "groups", "inventory_sources", "schedules"},
+	"projects":      {"job_templates", "schedules"},
+	"job_templates": {"schedules", "workflow_job_template_nodes"},
+}
+
+// apiPathSegments are the segments of the API base paths, which name no collection.
+//
+//nolint:gochecknoglobals
+var apiPathSegments = map[string]bool{"api": true, "controller": true, "v2": true}
+
+// WithLookupCache keeps the successful GET responses of the Requester, keyed by endpoint and query,
+// so that looking up the same objects again does not reach AWX. Any other request drops the cached
+// responses of the collections it targets, e.g. a POST to /api/v2/job_templates/5/credentials/
+// drops the cached job templates and credentials, along with the collections it changes as a side
+// effect, such as the hosts of a deleted inventory. Job and token endpoints are never cached.
+//
+// The cache is meant for a client whose lifetime is a single run: the changes made to AWX by
+// other clients are not seen until the cache is dropped.
+func WithLookupCache() RequesterOption {
+	return func(r *Requester) {
+		r.cache = &lookupCache{entries: make(map[string]*cachedResponse)}
+	}
+}
+
+// lookupCache is a read-through cache of GET responses.
+type lookupCache struct {
+	mu      sync.Mutex
+	entries map[string]*cachedResponse
+	// generation changes with every invalidation, so that a response read while a write was in
+	// flight is not cached.
+	generation uint64
+}
+
+// cachedResponse is a successful response, with its body.
+type cachedResponse struct {
+	collections   []string
+	status        int
+	header        http.Header
+	contentLength int64
+	body          []byte
+}
+
+// pathCollections returns the collections named by the segments of an endpoint path, leaving the
+// object IDs and the API prefix out. It reports whether the endpoint may be cached.
+func pathCollections(path string) ([]string, bool) {
+	var collections []string
+	cacheable := true
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" || apiPathSegments[segment] || isNumber(segment) {
+			continue
+		}
+		if volatileCollections[segment] {
+			cacheable = false
+		}
+		collections = append(collections, segment)
+	}
+	return collections, cacheable
+}
+
+func isNumber(s string) bool {
+	_, err := strconv.Atoi(s)
+	return err == nil
+}
+
+// get returns a copy of the cached response to a GET of u, and the generation to store the
+// response under when it is not cached.
+func (c *lookupCache) get(ctx context.Context, u *url.URL) (*http.Response, uint64) {
+	if c == nil {
+		return nil, 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[u.String()]
+	if !ok {
+		return nil, c.generation
+	}
+	tflog.Debug(ctx, "Using the cached AWX API response", map[string]interface{}{"url": u.String()})
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	return &http.Response{
+		Status:        http.StatusText(entry.status),
+		StatusCode:    entry.status,
+		Header:        entry.header.Clone(),
+		ContentLength: entry.contentLength,
+		Body:          io.NopCloser(bytes.NewReader(entry.body)),
+		Request:       req,
+	}, c.generation
+}
+
+// put caches the buffered response to a GET of u, unless the cache was invalidated since the
+// request was sent, at generation.
+func (c *lookupCache) put(u *url.URL, generation uint64, resp *http.Response) {
+	if c == nil || resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return
+	}
+	collections, cacheable := pathCollections(u.Path)
+	if !cacheable {
+		return
+	}
+	// The body was buffered by bufferResponse, reading it again cannot fail.
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generation != generation {
+		return
+	}
+	c.entries[u.String()] = &cachedResponse{
+		collections:   collections,
+		status:        resp.StatusCode,
+		header:        resp.Header.Clone(),
+		contentLength: resp.ContentLength,
+		body:          body,
+	}
+}
+
+// writtenCollections returns the collections changed by a method request to path, or whether it
+// may change any collection.
+func writtenCollections(method, path string) ([]string, bool) {
+	named, _ := pathCollections(path)
+	written := append([]string(nil), named...)
+	for _, collection := range named {
+		written = append(written, writeSideEffects[collection]...)
+	}
+	if method == http.MethodDelete && len(named) > 0 {
+		target := named[len(named)-1]
+		if target == "organizations" {
+			return nil, true
+		}
+		written = append(written, deleteCascades[target]...)
+	}
+	return written, false
+}
+
+// invalidate drops the cached responses of the collections written to by a method request to u.
+func (c *lookupCache) invalidate(ctx context.Context, method string, u *url.URL) {
+	if c == nil {
+		return
+	}
+	written, all := writtenCollections(method, u.Path)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	dropped := 0
+	for key, entry := range c.entries {
+		if all || sharesCollection(entry.collections, written) {
+			delete(c.entries, key)
+			dropped++
+		}
+	}
+	if dropped > 0 {
+		tflog.Debug(ctx, "Dropped the cached AWX API responses", map[string]interface{}{
+			"collections": written,
+			"dropped":     dropped,
+		})
+	}
+}
+
+func sharesCollection(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
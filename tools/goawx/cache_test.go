@@ -0,0 +1,211 @@
+package awx_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/goawx/awxtest"
+)
+
+func TestWithLookupCache(t *testing.T) {
+	var mu sync.Mutex
+	hits := make(map[string]int)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		hits[r.Method+" "+r.URL.RequestURI()]++
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"count": 0, "results": []}`))
+	}))
+	defer srv.Close()
+	r := &awx.Requester{Base: srv.URL, Authenticator: &awx.BasicAuth{}, Client: srv.Client()}
+	awx.WithLookupCache()(r)
+	ctx := context.Background()
+
+	get := func(endpoint string, query map[string]string) {
+		t.Helper()
+		var result map[string]interface{}
+		if _, err := r.GetJSON(ctx, endpoint, &result, query); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := result["count"]; !ok {
+			t.Fatalf("Expecting the response to %s to be decoded, got %v", endpoint, result)
+		}
+	}
+	expectHits := func(request string, want int) {
+		t.Helper()
+		mu.Lock()
+		defer mu.Unlock()
+		if hits[request] != want {
+			t.Errorf("Expecting %d requests %s but got %d", want, request, hits[request])
+		}
+	}
+
+	get("/api/v2/inventories/", map[string]string{"name": "web"})
+	get("/api/v2/inventories/", map[string]string{"name": "web"})
+	get("/api/v2/inventories/", map[string]string{"name": "db"})
+	get("/api/v2/organizations/1/object_roles/", nil)
+	get("/api/v2/organizations/1/object_roles/", nil)
+	expectHits("GET /api/v2/inventories/?name=web", 1)
+	expectHits("GET /api/v2/inventories/?name=db", 1)
+	expectHits("GET /api/v2/organizations/1/object_roles/", 1)
+
+	if _, err := r.PostJSON(ctx, "/api/v2/inventories/", strings.NewReader(`{"name": "app"}`), nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	get("/api/v2/inventories/", map[string]string{"name": "web"})
+	get("/api/v2/organizations/1/object_roles/", nil)
+	expectHits("GET /api/v2/inventories/?name=web", 2)
+	expectHits("GET /api/v2/organizations/1/object_roles/", 1)
+
+	get("/api/v2/jobs/3/", nil)
+	get("/api/v2/jobs/3/", nil)
+	expectHits("GET /api/v2/jobs/3/", 2)
+
+	// Tokens expire and are revoked without a write from the client.
+	for _, endpoint := range []string{"/api/v2/tokens/5/", "/api/v2/users/1/personal_tokens/", "/api/v2/applications/2/tokens/"} {
+		get(endpoint, nil)
+		get(endpoint, nil)
+		expectHits("GET "+endpoint, 2)
+	}
+}
+
+func TestWithLookupCache_sideEffects(t *testing.T) {
+	cases := []struct {
+		name    string
+		method  string
+		write   string
+		dropped []string
+		kept    []string
+	}{
+		{
+			name:    "bulk host create",
+			method:  http.MethodPost,
+			write:   "/api/v2/bulk/host_create/",
+			dropped: []string{"/api/v2/hosts/?inventory=4", "/api/v2/inventories/", "/api/v2/groups/7/hosts/"},
+			kept:    []string{"/api/v2/organizations/"},
+		},
+		{
+			name:    "bulk host delete",
+			method:  http.MethodPost,
+			write:   "/api/v2/bulk/host_delete/",
+			dropped: []string{"/api/v2/hosts/?inventory=4", "/api/v2/inventories/"},
+			kept:    []string{"/api/v2/organizations/"},
+		},
+		{
+			name:    "bulk job launch",
+			method:  http.MethodPost,
+			write:   "/api/v2/bulk/job_launch/",
+			dropped: []string{"/api/v2/job_templates/"},
+			kept:    []string{"/api/v2/hosts/?inventory=4"},
+		},
+		{
+			name:    "inventory delete",
+			method:  http.MethodDelete,
+			write:   "/api/v2/inventories/4/",
+			dropped: []string{"/api/v2/hosts/?inventory=4", "/api/v2/inventories/", "/api/v2/groups/7/hosts/"},
+			kept:    []string{"/api/v2/organizations/"},
+		},
+		{
+			name:    "organization delete",
+			method:  http.MethodDelete,
+			write:   "/api/v2/organizations/1/",
+			dropped: []string{"/api/v2/hosts/?inventory=4", "/api/v2/inventories/", "/api/v2/organizations/"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var mu sync.Mutex
+			hits := make(map[string]int)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				hits[r.URL.RequestURI()]++
+				mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"count": 0, "results": []}`))
+			}))
+			defer srv.Close()
+			r := &awx.Requester{Base: srv.URL, Authenticator: &awx.BasicAuth{}, Client: srv.Client()}
+			awx.WithLookupCache()(r)
+			ctx := context.Background()
+
+			get := func(uri string) {
+				t.Helper()
+				path, query, _ := strings.Cut(uri, "?")
+				params := map[string]string{}
+				if key, value, ok := strings.Cut(query, "="); ok {
+					params[key] = value
+				}
+				if _, err := r.GetJSON(ctx, path, nil, params); err != nil {
+					t.Fatal(err)
+				}
+			}
+			reads := append(append([]string(nil), tc.dropped...), tc.kept...)
+			for _, uri := range reads {
+				get(uri)
+			}
+
+			var err error
+			if tc.method == http.MethodDelete {
+				_, err = r.Delete(ctx, tc.write, nil, nil)
+			} else {
+				_, err = r.PostJSON(ctx, tc.write, strings.NewReader(`{}`), nil, nil)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, uri := range reads {
+				get(uri)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for _, uri := range tc.dropped {
+				if hits[uri] != 2 {
+					t.Errorf("Expecting %s to be dropped from the cache, got %d requests", uri, hits[uri])
+				}
+			}
+			for _, uri := range tc.kept {
+				if hits[uri] != 1 {
+					t.Errorf("Expecting %s to stay cached, got %d requests", uri, hits[uri])
+				}
+			}
+		})
+	}
+}
+
+func TestWithLookupCache_bulkHostCreate(t *testing.T) {
+	srv := awxtest.NewServer()
+	defer srv.Close()
+	ctx := context.Background()
+
+	client, err := awx.NewAWX(ctx, srv.URL, srv.Username, srv.Password, nil, awx.WithLookupCache())
+	if err != nil {
+		t.Fatal(err)
+	}
+	inventory, err := client.InventoriesService.CreateInventory(ctx, map[string]interface{}{"name": "bulk", "organization": 1}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := map[string]string{"inventory": strconv.Itoa(inventory.ID)}
+	if hosts, _, err := client.HostService.ListHosts(ctx, params); err != nil || len(hosts) != 0 {
+		t.Fatalf("Expecting no hosts, got %d (%v)", len(hosts), err)
+	}
+
+	if _, err := client.BulkService.CreateHosts(ctx, inventory.ID, []*awx.BulkHost{{Name: "web-1"}, {Name: "web-2"}}); err != nil {
+		t.Fatal(err)
+	}
+	hosts, _, err := client.HostService.ListHosts(ctx, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hosts) != 2 {
+		t.Errorf("Expecting the 2 hosts created in bulk, got %d", len(hosts))
+	}
+}
@@ -0,0 +1,446 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+const header = "// Code generated by awxgen from the AWX OPTIONS metadata in metadata/. DO NOT EDIT.\n\n"
+
+// initialisms are the words written in upper case in Go names.
+//
+//nolint:gochecknoglobals
+var initialisms = map[string]string{"id": "ID", "url": "URL", "uuid": "UUID"}
+
+// goField is a field of a generated struct.
+type goField struct {
+	Name string
+	Type string
+	Tag  string
+}
+
+// goModel is a model with the fields resolved from its metadata.
+type goModel struct {
+	*model
+	Fields    []goField
+	Request   []goField
+	Mandatory []string
+}
+
+// Method returns the name of a service method, e.g. Method "create" is "CreateExecutionEnvironment".
+func (m *goModel) Method(kind string) string {
+	if name, ok := m.Methods[kind]; ok {
+		return name
+	}
+	switch kind {
+	case "list":
+		return "List" + m.Plural
+	case "get":
+		return "Get" + m.Name + "ByID"
+	}
+	return upperFirst(kind) + m.Name
+}
+
+// generate returns the content of the generated files, by file name.
+func generate(cfg *config, metadataDir string) (map[string][]byte, error) {
+	models := make([]*goModel, 0, len(cfg.Models))
+	for _, m := range cfg.Models {
+		md, err := loadMetadata(filepath.Join(metadataDir, m.Endpoint+".json"))
+		if err != nil {
+			return nil, err
+		}
+		gm, err := resolve(m, md)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, gm)
+	}
+
+	files := make(map[string][]byte)
+	var err error
+	if files["models_gen.go"], err = render(modelsTemplate, models); err != nil {
+		return nil, err
+	}
+	if files["requests_gen.go"], err = render(requestsTemplate, models); err != nil {
+		return nil, err
+	}
+	for _, m := range models {
+		if m.Service == "" {
+			continue
+		}
+		if files[m.Endpoint+"_gen.go"], err = render(serviceTemplate, m); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// resolve maps the metadata fields of a model to Go fields.
+func resolve(m *model, md *metadata) (*goModel, error) {
+	gm := &goModel{model: m}
+	known := make(map[string]bool)
+	for _, f := range md.Get {
+		known[f.Key] = true
+		override := m.Fields[f.Key]
+		if override == nil {
+			override = &fieldOverride{}
+		}
+		if override.Skip {
+			continue
+		}
+		typ := override.Type
+		if typ == "" {
+			typ = modelType(f)
+		}
+		gm.Fields = append(gm.Fields, goField{Name: fieldName(f.Key, override), Type: typ, Tag: fmt.Sprintf("`json:%q`", f.Key)})
+	}
+
+	for _, f := range md.Post {
+		known[f.Key] = true
+		override := m.Fields[f.Key]
+		if override == nil {
+			override = &fieldOverride{}
+		}
+		if f.Required {
+			gm.Mandatory = append(gm.Mandatory, f.Key)
+		}
+		if override.Skip {
+			continue
+		}
+		typ := override.RequestType
+		if typ == "" {
+			typ = requestType(f)
+		}
+		// Request fields keep the plain field name: overridden names only keep existing model fields.
+		gm.Request = append(gm.Request, goField{Name: goName(f.Key), Type: typ, Tag: fmt.Sprintf("`json:\"%s,omitempty\"`", f.Key)})
+	}
+
+	for key := range m.Fields {
+		if !known[key] {
+			return nil, fmt.Errorf("%s: override of unknown field %q", m.Endpoint, key)
+		}
+	}
+	return gm, nil
+}
+
+// modelType returns the Go type of a field of a model.
+func modelType(f *field) string {
+	switch f.Key {
+	case "related":
+		return "*Related"
+	case "summary_fields":
+		return "*Summary"
+	}
+	switch f.Type {
+	case "integer", "id":
+		return "int"
+	case "float", "decimal":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "datetime", "date":
+		return "time.Time"
+	case "string", "url", "email":
+		return "string"
+	case "choice":
+		if numericChoices(f) {
+			return "int"
+		}
+		return "string"
+	case "object":
+		return "map[string]interface{}"
+	case "list":
+		return "[]interface{}"
+	}
+	return "interface{}"
+}
+
+// requestType returns the Go type of a field of a request payload: optional values, and nullable
+// references so that a reference can be cleared.
+func requestType(f *field) string {
+	switch f.Type {
+	case "id":
+		return "*Nullable[int]"
+	case "json", "field", "object", "list":
+		return "interface{}"
+	}
+	return "*" + modelType(f)
+}
+
+func numericChoices(f *field) bool {
+	if len(f.Choices) == 0 {
+		return false
+	}
+	for _, choice := range f.Choices {
+		if len(choice) == 0 {
+			return false
+		}
+		if _, ok := choice[0].(float64); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func fieldName(key string, override *fieldOverride) string {
+	if override.Name != "" {
+		return override.Name
+	}
+	return goName(key)
+}
+
+// goName turns a snake case field into a Go name, e.g. "instance_id" into "InstanceID".
+func goName(key string) string {
+	var b strings.Builder
+	for _, word := range strings.Split(key, "_") {
+		if upper, ok := initialisms[word]; ok {
+			b.WriteString(upper)
+			continue
+		}
+		b.WriteString(upperFirst(word))
+	}
+	return b.String()
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
+
+// render executes a template and formats the result.
+func render(tmpl *template.Template, data interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w\n%s", tmpl.Name(), err, buf.Bytes())
+	}
+	return out, nil
+}
+
+// usesTime reports whether a model field is a time.Time.
+func usesTime(models []*goModel) bool {
+	for _, m := range models {
+		for _, f := range m.Fields {
+			if strings.Contains(f.Type, "time.") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+//nolint:gochecknoglobals
+var funcs = template.FuncMap{"usesTime": usesTime, "quoteAll": quoteAll}
+
+//nolint:gochecknoglobals
+var modelsTemplate = template.Must(template.New("models").Funcs(funcs).Parse(`package awx
+{{if usesTime .}}
+import "time"
+{{end}}
+{{- range .}}
+// {{.Name}} represents the awx api {{.Title}}.
+type {{.Name}} struct {
+{{- range .Fields}}
+	{{.Name}} {{.Type}} {{.Tag}}
+{{- end}}
+}
+{{end}}`))
+
+//nolint:gochecknoglobals
+var requestsTemplate = template.Must(template.New("requests").Funcs(funcs).Parse(`package awx
+{{range .}}{{if .Request}}
+// {{.Name}}Request is the payload to create or update an awx {{.Title}}.
+type {{.Name}}Request struct {
+{{- range .Request}}
+	{{.Name}} {{.Type}} {{.Tag}}
+{{- end}}
+}
+{{end}}{{end}}`))
+
+//nolint:gochecknoglobals
+var serviceTemplate = template.Must(template.New("service").Funcs(funcs).Parse(`package awx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// {{.Service}} implements awx {{.Title}} apis.
+type {{.Service}} struct {
+	client *Client
+}
+
+// List{{.Plural}}Response represents ` + "`{{.Method \"list\"}}`" + ` endpoint response.
+type List{{.Plural}}Response struct {
+	Pagination
+	Results []*{{.Name}} ` + "`json:\"results\"`" + `
+}
+
+const {{.EndpointConst}} = "/api/v2/{{.Endpoint}}/"
+
+// {{.Method "list"}} shows list of awx {{.Title}}s.
+func (s *{{.Service}}) {{.Method "list"}}(ctx context.Context, params map[string]string) ([]*{{.Name}}, *List{{.Plural}}Response, error) {
+	results, pagination, err := listAll[*{{.Name}}](ctx, s.client.Requester, {{.EndpointConst}}, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return results, &List{{.Plural}}Response{Pagination: pagination, Results: results}, nil
+}
+
+// {{.Method "get"}} shows the details of an awx {{.Title}}.
+func (s *{{.Service}}) {{.Method "get"}}(ctx context.Context, id int, params map[string]string) (*{{.Name}}, error) {
+	result := new({{.Name}})
+	endpoint := fmt.Sprintf("%s%d/", {{.EndpointConst}}, id)
+	resp, err := s.client.Requester.GetJSON(ctx, endpoint, result, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				logCloseError(ctx, err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// {{.Method "create"}} creates an awx {{.Title}}.
+func (s *{{.Service}}) {{.Method "create"}}(ctx context.Context, data map[string]interface{}, params map[string]string) (*{{.Name}}, error) {
+	validate, status := ValidateParams(data, []string{ {{- quoteAll .Mandatory -}} })
+	if !status {
+		err := fmt.Errorf("mandatory input arguments are absent: %s", validate)
+		return nil, err
+	}
+
+	result := new({{.Name}})
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Requester.PostJSON(ctx, {{.EndpointConst}}, bytes.NewReader(payload), result, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				logCloseError(ctx, err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+{{if .Request}}
+// {{.Method "create"}}FromRequest is {{.Method "create"}} with a typed {{.Name}}Request payload.
+func (s *{{.Service}}) {{.Method "create"}}FromRequest(ctx context.Context, req *{{.Name}}Request, params map[string]string) (*{{.Name}}, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return s.{{.Method "create"}}(ctx, data, params)
+}
+{{end}}
+// {{.Method "update"}} updates an awx {{.Title}}.
+func (s *{{.Service}}) {{.Method "update"}}(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*{{.Name}}, error) {
+	result := new({{.Name}})
+	endpoint := fmt.Sprintf("%s%d/", {{.EndpointConst}}, id)
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Requester.PatchJSON(ctx, endpoint, bytes.NewReader(payload), result, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				logCloseError(ctx, err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+{{if .Request}}
+// {{.Method "update"}}FromRequest is {{.Method "update"}} with a typed {{.Name}}Request payload.
+func (s *{{.Service}}) {{.Method "update"}}FromRequest(ctx context.Context, id int, req *{{.Name}}Request, params map[string]string) (*{{.Name}}, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return s.{{.Method "update"}}(ctx, id, data, params)
+}
+{{end}}
+// {{.Method "delete"}} deletes an awx {{.Title}}.
+func (s *{{.Service}}) {{.Method "delete"}}(ctx context.Context, id int) (*{{.Name}}, error) {
+	result := new({{.Name}})
+	endpoint := fmt.Sprintf("%s%d/", {{.EndpointConst}}, id)
+
+	resp, err := s.client.Requester.Delete(ctx, endpoint, result, nil)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				logCloseError(ctx, err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+`))
@@ -0,0 +1,99 @@
+// Command awxgen generates the goawx models, request payloads and CRUD services from the AWX
+// OPTIONS metadata saved in the metadata directory.
+//
+// The endpoints to generate and the Go names and types that differ from the defaults are listed
+// in metadata/awxgen.json. The models, payloads and services of the other endpoints are written by
+// hand. To support new AWX fields, refresh the metadata from a running AWX and regenerate:
+//
+//	GOAWX_HOSTNAME=https://awx.example.com GOAWX_USERNAME=admin GOAWX_PASSWORD=secret go run ./cmd/awxgen -fetch
+//	go generate ./...
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const configFile = "awxgen.json"
+
+func main() {
+	metadataDir := flag.String("metadata", "metadata", "directory holding awxgen.json and the OPTIONS metadata")
+	outDir := flag.String("out", ".", "directory the Go files are generated in")
+	fetch := flag.Bool("fetch", false, "refresh the OPTIONS metadata from the AWX given by the GOAWX_* environment variables")
+	flag.Parse()
+
+	cfg, err := loadConfig(filepath.Join(*metadataDir, configFile))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *fetch {
+		if err := fetchMetadata(context.Background(), cfg, *metadataDir); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	files, err := generate(cfg, *metadataDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(*outDir, name), content, 0o600); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// fetchMetadata saves the OPTIONS response of every configured endpoint.
+func fetchMetadata(ctx context.Context, cfg *config, metadataDir string) error {
+	hostname := strings.TrimSuffix(os.Getenv("GOAWX_HOSTNAME"), "/")
+	if hostname == "" {
+		return fmt.Errorf("no AWX hostname provided, set GOAWX_HOSTNAME")
+	}
+	client := &http.Client{Timeout: 30 * time.Second}
+
+	for _, m := range cfg.Models {
+		req, err := http.NewRequestWithContext(ctx, http.MethodOptions, hostname+"/api/v2/"+m.Endpoint+"/", nil)
+		if err != nil {
+			return err
+		}
+		req.SetBasicAuth(os.Getenv("GOAWX_USERNAME"), os.Getenv("GOAWX_PASSWORD"))
+		req.Header.Set("Accept", "application/json")
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		body, err := io.ReadAll(resp.Body)
+		if closeErr := resp.Body.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("OPTIONS %s responded with %d: %s", req.URL, resp.StatusCode, body)
+		}
+
+		// json.Indent keeps the field order, which is the order of the generated struct fields.
+		var out bytes.Buffer
+		if err := json.Indent(&out, body, "", "  "); err != nil {
+			return fmt.Errorf("OPTIONS %s: %w", req.URL, err)
+		}
+		out.WriteByte('\n')
+		if err := os.WriteFile(filepath.Join(metadataDir, m.Endpoint+".json"), out.Bytes(), 0o600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestGeneratedFilesUpToDate fails when the generated files of goawx differ from what the metadata produces.
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	const goawxDir = "../.."
+	cfg, err := loadConfig(filepath.Join(goawxDir, "metadata", configFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := generate(cfg, filepath.Join(goawxDir, "metadata"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range files {
+		got, err := os.ReadFile(filepath.Join(goawxDir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date, run go generate ./tools/goawx/...", name)
+		}
+	}
+}
+
+func Test_goName(t *testing.T) {
+	tests := map[string]string{
+		"name":                    "Name",
+		"instance_id":             "InstanceID",
+		"url":                     "URL",
+		"ask_labels_on_launch":    "AskLabelsOnLaunch",
+		"policy_instance_minimum": "PolicyInstanceMinimum",
+	}
+	for key, want := range tests {
+		if got := goName(key); got != want {
+			t.Errorf("goName(%q) = %q, want %q", key, got, want)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// config is the content of awxgen.json.
+type config struct {
+	Models []*model `json:"models"`
+}
+
+// model describes an AWX endpoint to generate.
+type model struct {
+	// Endpoint is the path of the endpoint under /api/v2/, e.g. "execution_environments".
+	Endpoint string `json:"endpoint"`
+	// Name is the Go name of the model, e.g. "ExecutionEnvironment".
+	Name string `json:"model"`
+	// Title is the name of the model in doc comments, e.g. "execution environment".
+	Title string `json:"title"`
+	// Plural is the plural of Name, defaults to Name + "s".
+	Plural string `json:"plural,omitempty"`
+	// Service is the name of the service to generate, no service is generated when empty.
+	Service string `json:"service,omitempty"`
+	// EndpointConst is the name of the constant holding the endpoint path.
+	EndpointConst string `json:"endpoint_const,omitempty"`
+	// Methods overrides the names of the service methods: list, get, create, update and delete.
+	Methods map[string]string `json:"methods,omitempty"`
+	// Fields overrides the Go name and types of fields.
+	Fields map[string]*fieldOverride `json:"fields,omitempty"`
+}
+
+// fieldOverride changes how a field is generated, e.g. to keep the type of an existing model field.
+type fieldOverride struct {
+	Name        string `json:"name,omitempty"`
+	Type        string `json:"type,omitempty"`
+	RequestType string `json:"request_type,omitempty"`
+	Skip        bool   `json:"skip,omitempty"`
+}
+
+// field is a field of an OPTIONS action.
+type field struct {
+	Key      string
+	Type     string          `json:"type"`
+	Required bool            `json:"required"`
+	Label    string          `json:"label"`
+	Choices  [][]interface{} `json:"choices"`
+}
+
+// metadata is the part of an OPTIONS response used by the generator.
+type metadata struct {
+	// Get lists the fields of the objects, in the order AWX renders them.
+	Get []*field
+	// Post lists the fields accepted on creation.
+	Post []*field
+}
+
+func loadConfig(path string) (*config, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg := new(config)
+	if err := json.Unmarshal(raw, cfg); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	for _, m := range cfg.Models {
+		if m.Plural == "" {
+			m.Plural = m.Name + "s"
+		}
+		if m.EndpointConst == "" {
+			m.EndpointConst = lowerFirst(m.Plural) + "APIEndpoint"
+		}
+		if m.Fields == nil {
+			m.Fields = make(map[string]*fieldOverride)
+		}
+	}
+	return cfg, nil
+}
+
+func loadMetadata(path string) (*metadata, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var doc struct {
+		Actions map[string]json.RawMessage `json:"actions"`
+	}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	get, err := orderedFields(doc.Actions["GET"])
+	if err != nil {
+		return nil, fmt.Errorf("%s: GET: %w", path, err)
+	}
+	post, err := orderedFields(doc.Actions["POST"])
+	if err != nil {
+		return nil, fmt.Errorf("%s: POST: %w", path, err)
+	}
+	if len(get) == 0 {
+		return nil, fmt.Errorf("%s: no GET fields, the metadata must be fetched by a user allowed to read the endpoint", path)
+	}
+	return &metadata{Get: get, Post: post}, nil
+}
+
+// orderedFields decodes an OPTIONS action, keeping the order of its fields.
+func orderedFields(raw json.RawMessage) ([]*field, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, fmt.Errorf("expected an object")
+	}
+	var fields []*field
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		f := &field{Key: tok.(string)}
+		if err := dec.Decode(f); err != nil {
+			return nil, fmt.Errorf("%s: %w", f.Key, err)
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
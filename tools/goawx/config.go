@@ -0,0 +1,59 @@
+package awx
+
+import (
+	"context"
+)
+
+// ConfigService implements awx config apis.
+type ConfigService struct {
+	client *Client
+}
+
+const (
+	configAPIEndpoint = "/api/v2/config/"
+	rootAPIEndpoint   = "/api/v2/"
+)
+
+// GetConfig returns the configuration of the AWX server, including its version and license.
+func (c *ConfigService) GetConfig(ctx context.Context) (*Config, error) {
+	result := new(Config)
+	resp, err := c.client.Requester.GetJSON(ctx, configAPIEndpoint, result, map[string]string{})
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				logCloseError(ctx, err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetAPIEndpoints returns the top level endpoints published by the AWX API, keyed by name.
+func (c *ConfigService) GetAPIEndpoints(ctx context.Context) (map[string]string, error) {
+	result := make(map[string]string)
+	resp, err := c.client.Requester.GetJSON(ctx, rootAPIEndpoint, &result, map[string]string{})
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				logCloseError(ctx, err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
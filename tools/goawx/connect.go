@@ -0,0 +1,35 @@
+package awx
+
+import (
+	"context"
+	"sync"
+)
+
+// connectingKey marks the context of the requests sent while connecting a deferred client,
+// which must not wait for the connection themselves.
+type connectingKey struct{}
+
+// deferredConnection connects a client created by NewAWXDeferred before its first request.
+type deferredConnection struct {
+	mu        sync.Mutex
+	connected bool
+	connect   func(ctx context.Context) error
+}
+
+// ensure connects the client unless it is already connected. A failed connection is attempted
+// again by the next request.
+func (c *deferredConnection) ensure(ctx context.Context) error {
+	if c == nil || ctx.Value(connectingKey{}) != nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.connected {
+		return nil
+	}
+	if err := c.connect(context.WithValue(ctx, connectingKey{}, true)); err != nil {
+		return err
+	}
+	c.connected = true
+	return nil
+}
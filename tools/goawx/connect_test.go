@@ -0,0 +1,42 @@
+package awx_test
+
+import (
+	"context"
+	"testing"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/goawx/awxtest"
+)
+
+func TestNewAWXDeferred(t *testing.T) {
+	srv := awxtest.NewServer()
+	defer srv.Close()
+	ctx := context.Background()
+
+	client := awx.NewAWXDeferred(srv.URL, &awx.PersonalTokenAuth{Username: srv.Username, Password: srv.Password}, nil)
+	if info := client.ServerInfo(); info.Version != "" {
+		t.Errorf("Expecting no server information before the first request, got %s", info)
+	}
+
+	if _, err := client.OrganizationsService.ListOrganizations(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	if info := client.ServerInfo(); info.Version == "" {
+		t.Error("Expecting the server to be detected by the first request")
+	}
+	if err := client.Close(ctx); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNewAWXDeferred_Unreachable(t *testing.T) {
+	srv := awxtest.NewServer()
+	url := srv.URL
+	srv.Close()
+
+	client := awx.NewAWXDeferred(url, &awx.BasicAuth{Username: "admin", Password: "password"}, nil,
+		awx.WithAPIBasePath(awx.DefaultAPIBasePath))
+	if err := client.Connect(context.Background()); err == nil {
+		t.Error("Expecting the connection to an unreachable server to fail")
+	}
+}
@@ -3,6 +3,7 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -21,43 +22,30 @@ type ListCredentialInputSourceResponse struct {
 const credentialInputSourceAPIEndpoint = "/api/v2/credential_input_sources/" //nolint:gosec
 
 // ListCredentialInputSources shows list of awx credential input sources.
-func (cs *CredentialInputSourceService) ListCredentialInputSources(params map[string]string) ([]*CredentialInputSource,
+func (cs *CredentialInputSourceService) ListCredentialInputSources(ctx context.Context, params map[string]string) ([]*CredentialInputSource,
 	*ListCredentialInputSourceResponse,
 	error) {
-	result := new(ListCredentialInputSourceResponse)
-	resp, err := cs.client.Requester.GetJSON(credentialInputSourceAPIEndpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
-	if err != nil {
-		return nil, result, err
-	}
-
-	err = CheckResponse(resp)
+	results, pagination, err := listAll[*CredentialInputSource](ctx, cs.client.Requester, credentialInputSourceAPIEndpoint, params)
 	if err != nil {
-		return nil, result, err
+		return nil, nil, err
 	}
 
-	return result.Results, result, nil
+	return results, &ListCredentialInputSourceResponse{Pagination: pagination, Results: results}, nil
 }
 
 // CreateCredentialInputSource creates an awx credential input source.
-func (cs *CredentialInputSourceService) CreateCredentialInputSource(data map[string]interface{}, params map[string]string) (*CredentialInputSource, error) {
+func (cs *CredentialInputSourceService) CreateCredentialInputSource(ctx context.Context, data map[string]interface{}, params map[string]string) (*CredentialInputSource, error) {
 	result := new(CredentialInputSource)
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := cs.client.Requester.PostJSON(credentialInputSourceAPIEndpoint, bytes.NewReader(payload), result, params)
+	resp, err := cs.client.Requester.PostJSON(ctx, credentialInputSourceAPIEndpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -73,15 +61,24 @@ func (cs *CredentialInputSourceService) CreateCredentialInputSource(data map[str
 	return result, nil
 }
 
+// CreateCredentialInputSourceFromRequest is CreateCredentialInputSource with a typed CredentialInputSourceRequest payload.
+func (cs *CredentialInputSourceService) CreateCredentialInputSourceFromRequest(ctx context.Context, req *CredentialInputSourceRequest, params map[string]string) (*CredentialInputSource, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return cs.CreateCredentialInputSource(ctx, data, params)
+}
+
 // GetCredentialInputSourceByID : Gets a specific input source by ID.
-func (cs *CredentialInputSourceService) GetCredentialInputSourceByID(id int, params map[string]string) (*CredentialInputSource, error) {
+func (cs *CredentialInputSourceService) GetCredentialInputSourceByID(ctx context.Context, id int, params map[string]string) (*CredentialInputSource, error) {
 	result := new(CredentialInputSource)
 	endpoint := fmt.Sprintf("%s%d", credentialInputSourceAPIEndpoint, id)
-	resp, err := cs.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := cs.client.Requester.GetJSON(ctx, endpoint, result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -98,7 +95,7 @@ func (cs *CredentialInputSourceService) GetCredentialInputSourceByID(id int, par
 }
 
 // UpdateCredentialInputSourceByID : Updates an input source by ID.
-func (cs *CredentialInputSourceService) UpdateCredentialInputSourceByID(id int, data map[string]interface{},
+func (cs *CredentialInputSourceService) UpdateCredentialInputSourceByID(ctx context.Context, id int, data map[string]interface{},
 	params map[string]string) (*CredentialInputSource, error) {
 	result := new(CredentialInputSource)
 	endpoint := fmt.Sprintf("%s%d", credentialInputSourceAPIEndpoint, id)
@@ -108,11 +105,11 @@ func (cs *CredentialInputSourceService) UpdateCredentialInputSourceByID(id int,
 		return nil, err
 	}
 
-	resp, err := cs.client.Requester.PatchJSON(endpoint, bytes.NewReader(payload), result, params)
+	resp, err := cs.client.Requester.PatchJSON(ctx, endpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -128,14 +125,23 @@ func (cs *CredentialInputSourceService) UpdateCredentialInputSourceByID(id int,
 	return result, nil
 }
 
+// UpdateCredentialInputSourceByIDFromRequest is UpdateCredentialInputSourceByID with a typed CredentialInputSourceRequest payload.
+func (cs *CredentialInputSourceService) UpdateCredentialInputSourceByIDFromRequest(ctx context.Context, id int, req *CredentialInputSourceRequest, params map[string]string) (*CredentialInputSource, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return cs.UpdateCredentialInputSourceByID(ctx, id, data, params)
+}
+
 // DeleteCredentialInputSourceByID : Deletes an input source by ID.
-func (cs *CredentialInputSourceService) DeleteCredentialInputSourceByID(id int, params map[string]string) error {
+func (cs *CredentialInputSourceService) DeleteCredentialInputSourceByID(ctx context.Context, id int, params map[string]string) error {
 	endpoint := fmt.Sprintf("%s%d", credentialInputSourceAPIEndpoint, id)
-	resp, err := cs.client.Requester.Delete(endpoint, nil, params)
+	resp, err := cs.client.Requester.Delete(ctx, endpoint, nil, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -2,9 +2,9 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
 )
 
 // CredentialTypeService implements awx CredentialType apis.
@@ -21,75 +21,28 @@ type ListCredentialTypeResponse struct {
 const credentialTypesAPIEndpoint = "/api/v2/credential_types/" //nolint:gosec
 
 // ListCredentialTypes shows list of awx CredentialTypes.
-func (cs *CredentialTypeService) ListCredentialTypes(params map[string]string) ([]*CredentialType, error) {
+func (cs *CredentialTypeService) ListCredentialTypes(ctx context.Context, params map[string]string) ([]*CredentialType, error) {
 
-	results, err := cs.getAllPages(credentialTypesAPIEndpoint, params)
+	results, _, err := listAll[*CredentialType](ctx, cs.client.Requester, credentialTypesAPIEndpoint, params)
 	if err != nil {
 		return nil, err
 	}
 	return results, nil
 }
 
-func (cs *CredentialTypeService) getAllPages(firstURL string, params map[string]string) ([]*CredentialType, error) {
-	results := make([]*CredentialType, 0)
-	nextURL := firstURL
-	for {
-		nextURLParsed, err := url.Parse(nextURL)
-		if err != nil {
-			return nil, err
-		}
-
-		nextURLQueryParams := make(map[string]string)
-		for paramName, paramValues := range nextURLParsed.Query() {
-			if len(paramValues) > 0 {
-				nextURLQueryParams[paramName] = paramValues[0]
-			}
-		}
-
-		for paramName, paramValue := range params {
-			nextURLQueryParams[paramName] = paramValue
-		}
-
-		result := new(ListCredentialTypeResponse)
-		resp, err := cs.client.Requester.GetJSON(nextURLParsed.Path, result, nextURLQueryParams)
-		if resp != nil {
-			func() {
-				if err := resp.Body.Close(); err != nil {
-					fmt.Println(err)
-				}
-			}()
-		}
-		if err != nil {
-			return nil, err
-		}
-
-		if err := CheckResponse(resp); err != nil {
-			return nil, err
-		}
-
-		results = append(results, result.Results...)
-
-		if result.Next == nil || result.Next.(string) == "" {
-			break
-		}
-		nextURL = result.Next.(string)
-	}
-	return results, nil
-}
-
 // CreateCredentialType : Creates a new credential type in AWX.
-func (cs *CredentialTypeService) CreateCredentialType(data map[string]interface{}, params map[string]string) (*CredentialType, error) {
+func (cs *CredentialTypeService) CreateCredentialType(ctx context.Context, data map[string]interface{}, params map[string]string) (*CredentialType, error) {
 	result := new(CredentialType)
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := cs.client.Requester.PostJSON(credentialTypesAPIEndpoint, bytes.NewReader(payload), result, params)
+	resp, err := cs.client.Requester.PostJSON(ctx, credentialTypesAPIEndpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -105,15 +58,24 @@ func (cs *CredentialTypeService) CreateCredentialType(data map[string]interface{
 	return result, nil
 }
 
+// CreateCredentialTypeFromRequest is CreateCredentialType with a typed CredentialTypeRequest payload.
+func (cs *CredentialTypeService) CreateCredentialTypeFromRequest(ctx context.Context, req *CredentialTypeRequest, params map[string]string) (*CredentialType, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return cs.CreateCredentialType(ctx, data, params)
+}
+
 // GetCredentialTypeByID : Fetches a credential type by ID.
-func (cs *CredentialTypeService) GetCredentialTypeByID(id int, params map[string]string) (*CredentialType, error) {
+func (cs *CredentialTypeService) GetCredentialTypeByID(ctx context.Context, id int, params map[string]string) (*CredentialType, error) {
 	result := new(CredentialType)
 	endpoint := fmt.Sprintf("%s%d", credentialTypesAPIEndpoint, id)
-	resp, err := cs.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := cs.client.Requester.GetJSON(ctx, endpoint, result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -130,8 +92,8 @@ func (cs *CredentialTypeService) GetCredentialTypeByID(id int, params map[string
 }
 
 // GetCredentialTypeByName : Fetches a credential type by Name.
-func (cs *CredentialTypeService) GetCredentialTypeByName(name string, params map[string]string) (*CredentialType, error) {
-	credentialTypes, err := cs.ListCredentialTypes(params)
+func (cs *CredentialTypeService) GetCredentialTypeByName(ctx context.Context, name string, params map[string]string) (*CredentialType, error) {
+	credentialTypes, err := cs.ListCredentialTypes(ctx, params)
 	if err != nil {
 		return nil, err
 	}
@@ -145,7 +107,7 @@ func (cs *CredentialTypeService) GetCredentialTypeByName(name string, params map
 }
 
 // UpdateCredentialTypeByID : Updates a credential type by ID.
-func (cs *CredentialTypeService) UpdateCredentialTypeByID(id int, data map[string]interface{}, params map[string]string) (*CredentialType, error) {
+func (cs *CredentialTypeService) UpdateCredentialTypeByID(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*CredentialType, error) {
 	result := new(CredentialType)
 	endpoint := fmt.Sprintf("%s%d", credentialTypesAPIEndpoint, id)
 
@@ -154,11 +116,11 @@ func (cs *CredentialTypeService) UpdateCredentialTypeByID(id int, data map[strin
 		return nil, err
 	}
 
-	resp, err := cs.client.Requester.PutJSON(endpoint, bytes.NewReader(payload), result, params)
+	resp, err := cs.client.Requester.PutJSON(ctx, endpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -174,14 +136,23 @@ func (cs *CredentialTypeService) UpdateCredentialTypeByID(id int, data map[strin
 	return result, nil
 }
 
+// UpdateCredentialTypeByIDFromRequest is UpdateCredentialTypeByID with a typed CredentialTypeRequest payload.
+func (cs *CredentialTypeService) UpdateCredentialTypeByIDFromRequest(ctx context.Context, id int, req *CredentialTypeRequest, params map[string]string) (*CredentialType, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return cs.UpdateCredentialTypeByID(ctx, id, data, params)
+}
+
 // DeleteCredentialTypeByID : Deletes a credential type by ID.
-func (cs *CredentialTypeService) DeleteCredentialTypeByID(id int, params map[string]string) error {
+func (cs *CredentialTypeService) DeleteCredentialTypeByID(ctx context.Context, id int, params map[string]string) error {
 	endpoint := fmt.Sprintf("%s%d", credentialTypesAPIEndpoint, id)
-	resp, err := cs.client.Requester.Delete(endpoint, nil, params)
+	resp, err := cs.client.Requester.Delete(ctx, endpoint, nil, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -2,9 +2,9 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
 )
 
 // CredentialsService implements awx credentials apis.
@@ -21,74 +21,27 @@ type ListCredentialsResponse struct {
 const credentialsAPIEndpoint = "/api/v2/credentials/" //nolint:gosec
 
 // ListCredentials : List all credentials.
-func (cs *CredentialsService) ListCredentials(params map[string]string) ([]*Credential, error) {
-	results, err := cs.getAllPages(credentialsAPIEndpoint, params)
+func (cs *CredentialsService) ListCredentials(ctx context.Context, params map[string]string) ([]*Credential, error) {
+	results, _, err := listAll[*Credential](ctx, cs.client.Requester, credentialsAPIEndpoint, params)
 	if err != nil {
 		return nil, err
 	}
 	return results, nil
 }
 
-func (cs *CredentialsService) getAllPages(firstURL string, params map[string]string) ([]*Credential, error) {
-	results := make([]*Credential, 0)
-	nextURL := firstURL
-	for {
-		nextURLParsed, err := url.Parse(nextURL)
-		if err != nil {
-			return nil, err
-		}
-
-		nextURLQueryParams := make(map[string]string)
-		for paramName, paramValues := range nextURLParsed.Query() {
-			if len(paramValues) > 0 {
-				nextURLQueryParams[paramName] = paramValues[0]
-			}
-		}
-
-		for paramName, paramValue := range params {
-			nextURLQueryParams[paramName] = paramValue
-		}
-
-		result := new(ListCredentialsResponse)
-		resp, err := cs.client.Requester.GetJSON(nextURLParsed.Path, result, nextURLQueryParams)
-		if resp != nil {
-			func() {
-				if err := resp.Body.Close(); err != nil {
-					fmt.Println(err)
-				}
-			}()
-		}
-		if err != nil {
-			return nil, err
-		}
-
-		if err := CheckResponse(resp); err != nil {
-			return nil, err
-		}
-
-		results = append(results, result.Results...)
-
-		if result.Next == nil || result.Next.(string) == "" {
-			break
-		}
-		nextURL = result.Next.(string)
-	}
-	return results, nil
-}
-
 // CreateCredentials : Creates a new credential in AWX.
-func (cs *CredentialsService) CreateCredentials(data map[string]interface{}, params map[string]string) (*Credential, error) {
+func (cs *CredentialsService) CreateCredentials(ctx context.Context, data map[string]interface{}, params map[string]string) (*Credential, error) {
 	result := new(Credential)
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := cs.client.Requester.PostJSON(credentialsAPIEndpoint, bytes.NewReader(payload), result, params)
+	resp, err := cs.client.Requester.PostJSON(ctx, credentialsAPIEndpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -104,15 +57,24 @@ func (cs *CredentialsService) CreateCredentials(data map[string]interface{}, par
 	return result, nil
 }
 
+// CreateCredentialsFromRequest is CreateCredentials with a typed CredentialRequest payload.
+func (cs *CredentialsService) CreateCredentialsFromRequest(ctx context.Context, req *CredentialRequest, params map[string]string) (*Credential, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return cs.CreateCredentials(ctx, data, params)
+}
+
 // GetCredentialsByID : Fetches a credential by ID.
-func (cs *CredentialsService) GetCredentialsByID(id int, params map[string]string) (*Credential, error) {
+func (cs *CredentialsService) GetCredentialsByID(ctx context.Context, id int, params map[string]string) (*Credential, error) {
 	result := new(Credential)
 	endpoint := fmt.Sprintf("%s%d", credentialsAPIEndpoint, id)
-	resp, err := cs.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := cs.client.Requester.GetJSON(ctx, endpoint, result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -129,7 +91,7 @@ func (cs *CredentialsService) GetCredentialsByID(id int, params map[string]strin
 }
 
 // UpdateCredentialsByID : Updates a credential by ID.
-func (cs *CredentialsService) UpdateCredentialsByID(id int, data map[string]interface{},
+func (cs *CredentialsService) UpdateCredentialsByID(ctx context.Context, id int, data map[string]interface{},
 	params map[string]string) (*Credential, error) {
 	result := new(Credential)
 	endpoint := fmt.Sprintf("%s%d", credentialsAPIEndpoint, id)
@@ -139,11 +101,11 @@ func (cs *CredentialsService) UpdateCredentialsByID(id int, data map[string]inte
 		return nil, err
 	}
 
-	resp, err := cs.client.Requester.PatchJSON(endpoint, bytes.NewReader(payload), result, params)
+	resp, err := cs.client.Requester.PatchJSON(ctx, endpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -159,14 +121,23 @@ func (cs *CredentialsService) UpdateCredentialsByID(id int, data map[string]inte
 	return result, nil
 }
 
+// UpdateCredentialsByIDFromRequest is UpdateCredentialsByID with a typed CredentialRequest payload.
+func (cs *CredentialsService) UpdateCredentialsByIDFromRequest(ctx context.Context, id int, req *CredentialRequest, params map[string]string) (*Credential, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return cs.UpdateCredentialsByID(ctx, id, data, params)
+}
+
 // DeleteCredentialsByID : Deletes a credential by ID.
-func (cs *CredentialsService) DeleteCredentialsByID(id int, params map[string]string) error {
+func (cs *CredentialsService) DeleteCredentialsByID(ctx context.Context, id int, params map[string]string) error {
 	endpoint := fmt.Sprintf("%s%d", credentialsAPIEndpoint, id)
-	resp, err := cs.client.Requester.Delete(endpoint, nil, params)
+	resp, err := cs.client.Requester.Delete(ctx, endpoint, nil, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -0,0 +1,152 @@
+package awx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// NonFieldErrors is the FieldErrors key of the validation errors that are not about a single field,
+// e.g. a uniqueness constraint on several fields, or a body that is a list of messages.
+const NonFieldErrors = "non_field_errors"
+
+// APIError is returned when the AWX API answers with a status code outside of [200, 300).
+type APIError struct {
+	StatusCode int
+	Method     string
+	URL        string
+	// Detail holds the `detail` message AWX sends along with most non-validation errors.
+	Detail string
+	// FieldErrors maps payload fields to the validation messages AWX returned for them. Nested
+	// fields are joined with dots, e.g. `inputs.password` or `spec.0.variable`, and the errors
+	// that are not about a field are under NonFieldErrors.
+	FieldErrors map[string][]string
+	// Body is the raw response body.
+	Body []byte
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	if len(e.FieldErrors) > 0 {
+		fields := make([]string, 0, len(e.FieldErrors))
+		for field := range e.FieldErrors {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+
+		errorString := "Errors:"
+		for _, field := range fields {
+			errorString = fmt.Sprintf("%s\n- %s: %+v", errorString, field, e.FieldErrors[field])
+		}
+		return errorString
+	}
+
+	msg := e.Detail
+	if msg == "" {
+		msg = strings.TrimSpace(string(e.Body))
+	}
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+	return fmt.Sprintf("%s %s responded with %d: %s", e.Method, e.URL, e.StatusCode, msg)
+}
+
+// newAPIError builds an APIError from a failed response and its already read body.
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Body:       body,
+	}
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.URL = resp.Request.URL.String()
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return apiErr
+	}
+
+	switch v := decoded.(type) {
+	case map[string]interface{}:
+		if detail, ok := v["detail"].(string); ok && len(v) == 1 {
+			apiErr.Detail = detail
+			return apiErr
+		}
+	case []interface{}:
+		// Validators raising outside of a field serializer answer with a bare list of messages.
+		decoded = map[string]interface{}{NonFieldErrors: v}
+	default:
+		return apiErr
+	}
+
+	apiErr.FieldErrors = make(map[string][]string)
+	collectFieldErrors(apiErr.FieldErrors, "", decoded)
+	return apiErr
+}
+
+// collectFieldErrors adds the messages of a validation error body to errs, naming the nested
+// fields after their parents, e.g. {"inputs": {"password": ["..."]}} is `inputs.password`.
+func collectFieldErrors(errs map[string][]string, field string, value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			name := key
+			switch {
+			case key == NonFieldErrors || key == "__all__":
+				// The errors of a nested object as a whole belong to the object field.
+				name = field
+				if field == "" {
+					name = NonFieldErrors
+				}
+			case field != "":
+				name = field + "." + key
+			}
+			collectFieldErrors(errs, name, nested)
+		}
+	case []interface{}:
+		for i, item := range v {
+			switch item.(type) {
+			case map[string]interface{}, []interface{}:
+				// Errors of the items of a list field, indexed as the items. Valid items have no errors.
+				collectFieldErrors(errs, fmt.Sprintf("%s.%d", field, i), item)
+			default:
+				errs[field] = append(errs[field], fmt.Sprint(item))
+			}
+		}
+	case nil:
+	default:
+		errs[field] = append(errs[field], fmt.Sprint(v))
+	}
+}
+
+func hasStatus(err error, codes ...int) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	for _, code := range codes {
+		if apiErr.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNotFound reports whether err is an AWX API error caused by a missing object.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is an AWX API error caused by a conflicting object state.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsPermissionDenied reports whether err is an AWX API error caused by missing permissions.
+func IsPermissionDenied(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
@@ -0,0 +1,227 @@
+package awx_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+func TestAPIError_FieldErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+		want map[string][]string
+	}{
+		{
+			name: "fields",
+			body: `{"name": ["This field may not be blank."], "inventory": ["Invalid pk \"42\" - object does not exist."]}`,
+			want: map[string][]string{
+				"name":      {"This field may not be blank."},
+				"inventory": {"Invalid pk \"42\" - object does not exist."},
+			},
+		},
+		{
+			name: "nested fields",
+			body: `{"inputs": {"password": ["Required."], "non_field_errors": ["Invalid inputs."]}}`,
+			want: map[string][]string{
+				"inputs.password": {"Required."},
+				"inputs":          {"Invalid inputs."},
+			},
+		},
+		{
+			name: "list items",
+			body: `{"spec": [{}, {"variable": ["Duplicate variable."]}]}`,
+			want: map[string][]string{
+				"spec.1.variable": {"Duplicate variable."},
+			},
+		},
+		{
+			name: "non field errors",
+			body: `{"__all__": ["Host with this Name and Inventory already exists."]}`,
+			want: map[string][]string{
+				awx.NonFieldErrors: {"Host with this Name and Inventory already exists."},
+			},
+		},
+		{
+			name: "top level non field errors",
+			body: `{"non_field_errors": ["The fields name, organization must make a unique set."], "name": ["Ensure this field has no more than 512 characters."]}`,
+			want: map[string][]string{
+				awx.NonFieldErrors: {"The fields name, organization must make a unique set."},
+				"name":             {"Ensure this field has no more than 512 characters."},
+			},
+		},
+		{
+			name: "list body",
+			body: `["Cannot assign a Credential of kind ssh."]`,
+			want: map[string][]string{
+				awx.NonFieldErrors: {"Cannot assign a Credential of kind ssh."},
+			},
+		},
+		{
+			name: "list body of several messages",
+			body: `["Cannot assign a Credential of kind ssh.", "Credential kind is required."]`,
+			want: map[string][]string{
+				awx.NonFieldErrors: {"Cannot assign a Credential of kind ssh.", "Credential kind is required."},
+			},
+		},
+		{
+			name: "detail along with fields",
+			body: `{"detail": "Invalid payload.", "name": ["This field is required."]}`,
+			want: map[string][]string{
+				"detail": {"Invalid payload."},
+				"name":   {"This field is required."},
+			},
+		},
+		{
+			name: "not JSON",
+			body: `<html><body>Bad Request</body></html>`,
+		},
+		{
+			name: "scalar body",
+			body: `"Bad Request"`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer srv.Close()
+			r := &awx.Requester{Base: srv.URL, Authenticator: &awx.BasicAuth{}, Client: srv.Client()}
+
+			_, err := r.PostJSON(context.Background(), "/api/v2/hosts/", strings.NewReader("{}"), nil, nil)
+			var apiErr *awx.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("Expecting an *APIError but got %v", err)
+			}
+			if !reflect.DeepEqual(apiErr.FieldErrors, tc.want) {
+				t.Errorf("Expecting the field errors %v but got %v", tc.want, apiErr.FieldErrors)
+			}
+			if apiErr.StatusCode != http.StatusBadRequest || apiErr.Method != http.MethodPost || !strings.HasSuffix(apiErr.URL, "/api/v2/hosts/") {
+				t.Errorf("Unexpected request %s %s and status %d", apiErr.Method, apiErr.URL, apiErr.StatusCode)
+			}
+			if string(apiErr.Body) != tc.body {
+				t.Errorf("Expecting the raw body %q but got %q", tc.body, apiErr.Body)
+			}
+		})
+	}
+}
+
+func TestAPIError_Detail(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"detail": "You do not have permission to perform this action."}`))
+	}))
+	defer srv.Close()
+	r := &awx.Requester{Base: srv.URL, Authenticator: &awx.BasicAuth{}, Client: srv.Client()}
+
+	_, err := r.GetJSON(context.Background(), "/api/v2/hosts/1/", nil, nil)
+	var apiErr *awx.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expecting an *APIError but got %v", err)
+	}
+	if apiErr.Detail != "You do not have permission to perform this action." || apiErr.FieldErrors != nil {
+		t.Errorf("Expecting only a detail but got %q and %v", apiErr.Detail, apiErr.FieldErrors)
+	}
+}
+
+func TestAPIError_Error(t *testing.T) {
+	cases := []struct {
+		name string
+		err  *awx.APIError
+		want string
+	}{
+		{
+			name: "field errors",
+			err:  &awx.APIError{StatusCode: 400, FieldErrors: map[string][]string{"name": {"Required."}, "inventory": {"Invalid pk."}}},
+			want: "Errors:\n- inventory: [Invalid pk.]\n- name: [Required.]",
+		},
+		{
+			name: "detail",
+			err:  &awx.APIError{StatusCode: 404, Method: "GET", URL: "/api/v2/hosts/1/", Detail: "Not found."},
+			want: "GET /api/v2/hosts/1/ responded with 404: Not found.",
+		},
+		{
+			name: "body",
+			err:  &awx.APIError{StatusCode: 502, Method: "GET", URL: "/api/v2/ping/", Body: []byte("upstream unavailable\n")},
+			want: "GET /api/v2/ping/ responded with 502: upstream unavailable",
+		},
+		{
+			name: "status",
+			err:  &awx.APIError{StatusCode: 503, Method: "GET", URL: "/api/v2/ping/"},
+			want: "GET /api/v2/ping/ responded with 503: Service Unavailable",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.err.Error(); got != tc.want {
+				t.Errorf("Expecting %q but got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestIsStatus(t *testing.T) {
+	cases := []struct {
+		name             string
+		err              error
+		notFound         bool
+		conflict         bool
+		permissionDenied bool
+	}{
+		{name: "nil", err: nil},
+		{name: "not an API error", err: errors.New("connection refused")},
+		{name: "not found", err: &awx.APIError{StatusCode: http.StatusNotFound}, notFound: true},
+		{name: "wrapped not found", err: fmt.Errorf("reading host: %w", &awx.APIError{StatusCode: http.StatusNotFound}), notFound: true},
+		{name: "conflict", err: &awx.APIError{StatusCode: http.StatusConflict}, conflict: true},
+		{name: "permission denied", err: &awx.APIError{StatusCode: http.StatusForbidden}, permissionDenied: true},
+		{name: "unauthorized", err: &awx.APIError{StatusCode: http.StatusUnauthorized}},
+		{name: "bad request", err: &awx.APIError{StatusCode: http.StatusBadRequest}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := awx.IsNotFound(tc.err); got != tc.notFound {
+				t.Errorf("IsNotFound: expecting %t but got %t", tc.notFound, got)
+			}
+			if got := awx.IsConflict(tc.err); got != tc.conflict {
+				t.Errorf("IsConflict: expecting %t but got %t", tc.conflict, got)
+			}
+			if got := awx.IsPermissionDenied(tc.err); got != tc.permissionDenied {
+				t.Errorf("IsPermissionDenied: expecting %t but got %t", tc.permissionDenied, got)
+			}
+		})
+	}
+}
+
+func TestIsNotFound_Service(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/api/v2/ping/" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"detail": "Not found."}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"version": "24.6.1"}`))
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+	client, err := awx.NewAWX(ctx, srv.URL, "admin", "password", srv.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.OrganizationsService.GetOrganizationsByID(ctx, 404, nil)
+	if !awx.IsNotFound(err) || awx.IsConflict(err) || awx.IsPermissionDenied(err) {
+		t.Errorf("Expecting a not found error but got %v", err)
+	}
+}
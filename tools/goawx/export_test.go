@@ -0,0 +1,56 @@
+package awx
+
+import (
+	"net/http"
+	"time"
+)
+
+// This file exposes unexported helpers to the tests of package awx_test.
+
+// ShouldRetry exposes RetryPolicy.shouldRetry.
+func (p RetryPolicy) ShouldRetry(method string, attempt int, resp *http.Response, err error) (time.Duration, bool) {
+	return p.shouldRetry(method, attempt, resp, err)
+}
+
+// Backoff exposes RetryPolicy.backoff.
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	return p.backoff(attempt)
+}
+
+// ParseRetryAfter exposes parseRetryAfter.
+func ParseRetryAfter(value string) (time.Duration, bool) {
+	return parseRetryAfter(value)
+}
+
+// MaxLoggedBodySize exposes maxLoggedBodySize.
+const MaxLoggedBodySize = maxLoggedBodySize
+
+// RedactHeaders exposes redactHeaders.
+func RedactHeaders(headers http.Header) map[string]string {
+	return redactHeaders(headers)
+}
+
+// RedactBody exposes redactBody.
+func RedactBody(contentType string, body []byte) string {
+	return redactBody(contentType, body)
+}
+
+// APIEndpoint exposes Requester.apiEndpoint.
+func (r *Requester) APIEndpoint(endpoint string) string {
+	return r.apiEndpoint(endpoint)
+}
+
+// TokensEndpoint exposes Requester.tokensEndpoint.
+func (r *Requester) TokensEndpoint() string {
+	return r.tokensEndpoint()
+}
+
+// SessionEndpoints exposes Requester.sessionEndpoints.
+func (r *Requester) SessionEndpoints() (login, logout string) {
+	return r.sessionEndpoints()
+}
+
+// RequestPayload exposes requestPayload.
+func RequestPayload(req interface{}) (map[string]interface{}, error) {
+	return requestPayload(req)
+}
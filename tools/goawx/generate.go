@@ -0,0 +1,6 @@
+package awx
+
+// The models, request payloads and CRUD services of the endpoints listed in metadata/awxgen.json are
+// generated from the AWX OPTIONS metadata saved in metadata/, see cmd/awxgen. The other ones, in
+// types.go and request_types.go, are written by hand.
+//go:generate go run ./cmd/awxgen
@@ -2,6 +2,7 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -20,14 +21,14 @@ type ListGroupsResponse struct {
 const groupsAPIEndpoint = "/api/v2/groups/"
 
 // GetGroupByID shows the details of a awx group.
-func (g *GroupService) GetGroupByID(id int, params map[string]string) (*Group, error) {
+func (g *GroupService) GetGroupByID(ctx context.Context, id int, params map[string]string) (*Group, error) {
 	result := new(Group)
 	endpoint := fmt.Sprintf("%s%d/", groupsAPIEndpoint, id)
-	resp, err := g.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := g.client.Requester.GetJSON(ctx, endpoint, result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -43,29 +44,17 @@ func (g *GroupService) GetGroupByID(id int, params map[string]string) (*Group, e
 }
 
 // ListGroups shows list of awx Groups.
-func (g *GroupService) ListGroups(params map[string]string) ([]*Group, *ListGroupsResponse, error) {
-	result := new(ListGroupsResponse)
-	resp, err := g.client.Requester.GetJSON(groupsAPIEndpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
+func (g *GroupService) ListGroups(ctx context.Context, params map[string]string) ([]*Group, *ListGroupsResponse, error) {
+	results, pagination, err := listAll[*Group](ctx, g.client.Requester, groupsAPIEndpoint, params)
 	if err != nil {
-		return nil, result, err
+		return nil, nil, err
 	}
 
-	if err := CheckResponse(resp); err != nil {
-		return nil, result, err
-	}
-
-	return result.Results, result, nil
+	return results, &ListGroupsResponse{Pagination: pagination, Results: results}, nil
 }
 
 // CreateGroup creates an awx Group.
-func (g *GroupService) CreateGroup(data map[string]interface{}, params map[string]string) (*Group, error) {
+func (g *GroupService) CreateGroup(ctx context.Context, data map[string]interface{}, params map[string]string) (*Group, error) {
 	mandatoryFields = []string{"name", "inventory"}
 	validate, status := ValidateParams(data, mandatoryFields)
 
@@ -82,11 +71,11 @@ func (g *GroupService) CreateGroup(data map[string]interface{}, params map[strin
 
 	// Add check if Group exists and return proper error
 
-	resp, err := g.client.Requester.PostJSON(groupsAPIEndpoint, bytes.NewReader(payload), result, params)
+	resp, err := g.client.Requester.PostJSON(ctx, groupsAPIEndpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -101,19 +90,28 @@ func (g *GroupService) CreateGroup(data map[string]interface{}, params map[strin
 	return result, nil
 }
 
+// CreateGroupFromRequest is CreateGroup with a typed GroupRequest payload.
+func (g *GroupService) CreateGroupFromRequest(ctx context.Context, req *GroupRequest, params map[string]string) (*Group, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return g.CreateGroup(ctx, data, params)
+}
+
 // UpdateGroup update an awx group.
-func (g *GroupService) UpdateGroup(id int, data map[string]interface{}, _ map[string]string) (*Group, error) {
+func (g *GroupService) UpdateGroup(ctx context.Context, id int, data map[string]interface{}, _ map[string]string) (*Group, error) {
 	result := new(Group)
 	endpoint := fmt.Sprintf("%s%d", groupsAPIEndpoint, id)
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := g.client.Requester.PatchJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := g.client.Requester.PatchJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -128,16 +126,25 @@ func (g *GroupService) UpdateGroup(id int, data map[string]interface{}, _ map[st
 	return result, nil
 }
 
+// UpdateGroupFromRequest is UpdateGroup with a typed GroupRequest payload.
+func (g *GroupService) UpdateGroupFromRequest(ctx context.Context, id int, req *GroupRequest, params map[string]string) (*Group, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return g.UpdateGroup(ctx, id, data, params)
+}
+
 // DeleteGroup delete an awx Group.
-func (g *GroupService) DeleteGroup(id int) (*Group, error) {
+func (g *GroupService) DeleteGroup(ctx context.Context, id int) (*Group, error) {
 	result := new(Group)
 	endpoint := fmt.Sprintf("%s%d", groupsAPIEndpoint, id)
 
-	resp, err := g.client.Requester.Delete(endpoint, result, nil)
+	resp, err := g.client.Requester.Delete(ctx, endpoint, result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
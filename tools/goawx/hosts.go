@@ -2,6 +2,7 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -26,14 +27,14 @@ type ListHostsResponse struct {
 const hostsAPIEndpoint = "/api/v2/hosts/"
 
 // GetHostByID shows the details of a awx inventroy sources.
-func (h *HostService) GetHostByID(id int, params map[string]string) (*Host, error) {
+func (h *HostService) GetHostByID(ctx context.Context, id int, params map[string]string) (*Host, error) {
 	result := new(Host)
 	endpoint := fmt.Sprintf("%s%d/", hostsAPIEndpoint, id)
-	resp, err := h.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := h.client.Requester.GetJSON(ctx, endpoint, result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -49,29 +50,17 @@ func (h *HostService) GetHostByID(id int, params map[string]string) (*Host, erro
 }
 
 // ListHosts shows list of awx Hosts.
-func (h *HostService) ListHosts(params map[string]string) ([]*Host, *ListHostsResponse, error) {
-	result := new(ListHostsResponse)
-	resp, err := h.client.Requester.GetJSON(hostsAPIEndpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
+func (h *HostService) ListHosts(ctx context.Context, params map[string]string) ([]*Host, *ListHostsResponse, error) {
+	results, pagination, err := listAll[*Host](ctx, h.client.Requester, hostsAPIEndpoint, params)
 	if err != nil {
-		return nil, result, err
+		return nil, nil, err
 	}
 
-	if err := CheckResponse(resp); err != nil {
-		return nil, result, err
-	}
-
-	return result.Results, result, nil
+	return results, &ListHostsResponse{Pagination: pagination, Results: results}, nil
 }
 
 // CreateHost creates an awx Host.
-func (h *HostService) CreateHost(data map[string]interface{}, params map[string]string) (*Host, error) {
+func (h *HostService) CreateHost(ctx context.Context, data map[string]interface{}, params map[string]string) (*Host, error) {
 	mandatoryFields = []string{"name", "inventory"}
 	validate, status := ValidateParams(data, mandatoryFields)
 
@@ -88,11 +77,11 @@ func (h *HostService) CreateHost(data map[string]interface{}, params map[string]
 
 	// Add check if Host exists and return proper error
 
-	resp, err := h.client.Requester.PostJSON(hostsAPIEndpoint, bytes.NewReader(payload), result, params)
+	resp, err := h.client.Requester.PostJSON(ctx, hostsAPIEndpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -107,19 +96,28 @@ func (h *HostService) CreateHost(data map[string]interface{}, params map[string]
 	return result, nil
 }
 
+// CreateHostFromRequest is CreateHost with a typed HostRequest payload.
+func (h *HostService) CreateHostFromRequest(ctx context.Context, req *HostRequest, params map[string]string) (*Host, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return h.CreateHost(ctx, data, params)
+}
+
 // UpdateHost update an awx Host.
-func (h *HostService) UpdateHost(id int, data map[string]interface{}, _ map[string]string) (*Host, error) {
+func (h *HostService) UpdateHost(ctx context.Context, id int, data map[string]interface{}, _ map[string]string) (*Host, error) {
 	result := new(Host)
 	endpoint := fmt.Sprintf("%s%d", hostsAPIEndpoint, id)
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := h.client.Requester.PatchJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := h.client.Requester.PatchJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -134,8 +132,17 @@ func (h *HostService) UpdateHost(id int, data map[string]interface{}, _ map[stri
 	return result, nil
 }
 
+// UpdateHostFromRequest is UpdateHost with a typed HostRequest payload.
+func (h *HostService) UpdateHostFromRequest(ctx context.Context, id int, req *HostRequest, params map[string]string) (*Host, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return h.UpdateHost(ctx, id, data, params)
+}
+
 // AssociateGroup update an awx Host.
-func (h *HostService) AssociateGroup(id int, data map[string]interface{}, _ map[string]string) (*Host, error) {
+func (h *HostService) AssociateGroup(ctx context.Context, id int, data map[string]interface{}, _ map[string]string) (*Host, error) {
 	result := new(Host)
 	endpoint := fmt.Sprintf("%s%d/groups/", hostsAPIEndpoint, id)
 	data["associate"] = true
@@ -149,11 +156,11 @@ func (h *HostService) AssociateGroup(id int, data map[string]interface{}, _ map[
 	if err != nil {
 		return nil, err
 	}
-	resp, err := h.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := h.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -169,7 +176,7 @@ func (h *HostService) AssociateGroup(id int, data map[string]interface{}, _ map[
 }
 
 // DisAssociateGroup update an awx Host.
-func (h *HostService) DisAssociateGroup(id int, data map[string]interface{}, _ map[string]string) (*Host, error) {
+func (h *HostService) DisAssociateGroup(ctx context.Context, id int, data map[string]interface{}, _ map[string]string) (*Host, error) {
 	result := new(Host)
 	endpoint := fmt.Sprintf("%s%d/groups/", hostsAPIEndpoint, id)
 	data["disassociate"] = true
@@ -183,11 +190,11 @@ func (h *HostService) DisAssociateGroup(id int, data map[string]interface{}, _ m
 	if err != nil {
 		return nil, err
 	}
-	resp, err := h.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := h.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -203,17 +210,17 @@ func (h *HostService) DisAssociateGroup(id int, data map[string]interface{}, _ m
 }
 
 // ListHostGroups returns the groups associated with an awx Host.
-func (h *HostService) ListHostGroups(id int, params map[string]string) ([]*Group, error) {
+func (h *HostService) ListHostGroups(ctx context.Context, id int, params map[string]string) ([]*Group, error) {
 	var allGroups []*Group
 	endpoint := fmt.Sprintf("%s%d/groups/", hostsAPIEndpoint, id)
 
 	for {
 		result := new(ListGroupsResponse)
-		resp, err := h.client.Requester.GetJSON(endpoint, result, params)
+		resp, err := h.client.Requester.GetJSON(ctx, endpoint, result, params)
 		if resp != nil {
 			func() {
 				if err := resp.Body.Close(); err != nil {
-					fmt.Println(err)
+					logCloseError(ctx, err)
 				}
 			}()
 		}
@@ -238,15 +245,15 @@ func (h *HostService) ListHostGroups(id int, params map[string]string) ([]*Group
 }
 
 // DeleteHost delete an awx Host.
-func (h *HostService) DeleteHost(id int) (*Host, error) {
+func (h *HostService) DeleteHost(ctx context.Context, id int) (*Host, error) {
 	result := new(Host)
 	endpoint := fmt.Sprintf("%s%d", hostsAPIEndpoint, id)
 
-	resp, err := h.client.Requester.Delete(endpoint, result, nil)
+	resp, err := h.client.Requester.Delete(ctx, endpoint, result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
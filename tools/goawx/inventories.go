@@ -2,6 +2,7 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -20,14 +21,14 @@ type ListInventoriesResponse struct {
 const inventoriesAPIEndpoint = "/api/v2/inventories/"
 
 // GetInventoryByID shows the details of a awx inventroy sources.
-func (i *InventoriesService) GetInventoryByID(id int, params map[string]string) (*Inventory, error) {
+func (i *InventoriesService) GetInventoryByID(ctx context.Context, id int, params map[string]string) (*Inventory, error) {
 	result := new(Inventory)
 	endpoint := fmt.Sprintf("%s%d/", inventoriesAPIEndpoint, id)
-	resp, err := i.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := i.client.Requester.GetJSON(ctx, endpoint, result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -43,29 +44,17 @@ func (i *InventoriesService) GetInventoryByID(id int, params map[string]string)
 }
 
 // ListInventories shows list of awx inventories.
-func (i *InventoriesService) ListInventories(params map[string]string) ([]*Inventory, *ListInventoriesResponse, error) {
-	result := new(ListInventoriesResponse)
-	resp, err := i.client.Requester.GetJSON(inventoriesAPIEndpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
+func (i *InventoriesService) ListInventories(ctx context.Context, params map[string]string) ([]*Inventory, *ListInventoriesResponse, error) {
+	results, pagination, err := listAll[*Inventory](ctx, i.client.Requester, inventoriesAPIEndpoint, params)
 	if err != nil {
-		return nil, result, err
+		return nil, nil, err
 	}
 
-	if err := CheckResponse(resp); err != nil {
-		return nil, result, err
-	}
-
-	return result.Results, result, nil
+	return results, &ListInventoriesResponse{Pagination: pagination, Results: results}, nil
 }
 
 // CreateInventory creates an awx inventory.
-func (i *InventoriesService) CreateInventory(data map[string]interface{}, params map[string]string) (*Inventory, error) {
+func (i *InventoriesService) CreateInventory(ctx context.Context, data map[string]interface{}, params map[string]string) (*Inventory, error) {
 	mandatoryFields = []string{"name", "organization"}
 	validate, status := ValidateParams(data, mandatoryFields)
 
@@ -82,11 +71,11 @@ func (i *InventoriesService) CreateInventory(data map[string]interface{}, params
 
 	// Add check if inventory exists and return proper error
 
-	resp, err := i.client.Requester.PostJSON(inventoriesAPIEndpoint, bytes.NewReader(payload), result, params)
+	resp, err := i.client.Requester.PostJSON(ctx, inventoriesAPIEndpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -101,19 +90,28 @@ func (i *InventoriesService) CreateInventory(data map[string]interface{}, params
 	return result, nil
 }
 
+// CreateInventoryFromRequest is CreateInventory with a typed InventoryRequest payload.
+func (i *InventoriesService) CreateInventoryFromRequest(ctx context.Context, req *InventoryRequest, params map[string]string) (*Inventory, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return i.CreateInventory(ctx, data, params)
+}
+
 // UpdateInventory update an awx inventory.
-func (i *InventoriesService) UpdateInventory(id int, data map[string]interface{}, _ map[string]string) (*Inventory, error) {
+func (i *InventoriesService) UpdateInventory(ctx context.Context, id int, data map[string]interface{}, _ map[string]string) (*Inventory, error) {
 	result := new(Inventory)
 	endpoint := fmt.Sprintf("%s%d", inventoriesAPIEndpoint, id)
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := i.client.Requester.PatchJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := i.client.Requester.PatchJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -128,15 +126,24 @@ func (i *InventoriesService) UpdateInventory(id int, data map[string]interface{}
 	return result, nil
 }
 
+// UpdateInventoryFromRequest is UpdateInventory with a typed InventoryRequest payload.
+func (i *InventoriesService) UpdateInventoryFromRequest(ctx context.Context, id int, req *InventoryRequest, params map[string]string) (*Inventory, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return i.UpdateInventory(ctx, id, data, params)
+}
+
 // GetInventory retrieves the inventory information from its ID or Name.
-func (i *InventoriesService) GetInventory(id int, _ map[string]string) (*Inventory, error) {
+func (i *InventoriesService) GetInventory(ctx context.Context, id int, _ map[string]string) (*Inventory, error) {
 	endpoint := fmt.Sprintf("%s%d", inventoriesAPIEndpoint, id)
 	result := new(Inventory)
-	resp, err := i.client.Requester.GetJSON(endpoint, result, map[string]string{})
+	resp, err := i.client.Requester.GetJSON(ctx, endpoint, result, map[string]string{})
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -152,15 +159,15 @@ func (i *InventoriesService) GetInventory(id int, _ map[string]string) (*Invento
 }
 
 // DeleteInventory delete an inventory from AWX.
-func (i *InventoriesService) DeleteInventory(id int) (*Inventory, error) {
+func (i *InventoriesService) DeleteInventory(ctx context.Context, id int) (*Inventory, error) {
 	result := new(Inventory)
 	endpoint := fmt.Sprintf("%s%d", inventoriesAPIEndpoint, id)
 
-	resp, err := i.client.Requester.Delete(endpoint, result, nil)
+	resp, err := i.client.Requester.Delete(ctx, endpoint, result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -176,7 +183,7 @@ func (i *InventoriesService) DeleteInventory(id int) (*Inventory, error) {
 }
 
 // DisAssociateInstanceGroups remove InstanceGroup from an awx Inventory.
-func (i *InventoriesService) DisAssociateInstanceGroups(id int, data map[string]interface{}, _ map[string]string) (*Inventory, error) {
+func (i *InventoriesService) DisAssociateInstanceGroups(ctx context.Context, id int, data map[string]interface{}, _ map[string]string) (*Inventory, error) {
 	result := new(Inventory)
 	endpoint := fmt.Sprintf("%s%d/instance_groups/", inventoriesAPIEndpoint, id)
 	data["disassociate"] = true
@@ -190,11 +197,11 @@ func (i *InventoriesService) DisAssociateInstanceGroups(id int, data map[string]
 	if err != nil {
 		return nil, err
 	}
-	resp, err := i.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := i.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -210,7 +217,7 @@ func (i *InventoriesService) DisAssociateInstanceGroups(id int, data map[string]
 }
 
 // AssociateInstanceGroups  adding InstanceGroup to Inventory.
-func (i *InventoriesService) AssociateInstanceGroups(id int, data map[string]interface{}, _ map[string]string) (*Inventory, error) {
+func (i *InventoriesService) AssociateInstanceGroups(ctx context.Context, id int, data map[string]interface{}, _ map[string]string) (*Inventory, error) {
 	result := new(Inventory)
 
 	endpoint := fmt.Sprintf("%s%d/instance_groups/", inventoriesAPIEndpoint, id)
@@ -225,11 +232,11 @@ func (i *InventoriesService) AssociateInstanceGroups(id int, data map[string]int
 	if err != nil {
 		return nil, err
 	}
-	resp, err := i.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := i.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
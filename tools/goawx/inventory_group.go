@@ -1,6 +1,7 @@
 package awx
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -10,24 +11,12 @@ type InventoryGroupService struct {
 }
 
 // ListInventoryGroups shows list of awx groups in some inventory.
-func (i *InventoryGroupService) ListInventoryGroups(id int, params map[string]string) ([]*Group, *ListGroupsResponse, error) {
-	result := new(ListGroupsResponse)
+func (i *InventoryGroupService) ListInventoryGroups(ctx context.Context, id int, params map[string]string) ([]*Group, *ListGroupsResponse, error) {
 	endpoint := fmt.Sprintf("%s%d/groups/", inventoriesAPIEndpoint, id)
-	resp, err := i.client.Requester.GetJSON(endpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
+	results, pagination, err := listAll[*Group](ctx, i.client.Requester, endpoint, params)
 	if err != nil {
-		return nil, result, err
-	}
-
-	if err := CheckResponse(resp); err != nil {
-		return nil, result, err
+		return nil, nil, err
 	}
 
-	return result.Results, result, nil
+	return results, &ListGroupsResponse{Pagination: pagination, Results: results}, nil
 }
@@ -2,6 +2,7 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -20,14 +21,14 @@ type ListInventorySourcesResponse struct {
 const inventorySourcesAPIEndpoint = "/api/v2/inventory_sources/"
 
 // GetInventorySourceByID shows the details of a awx inventory sources.
-func (i *InventorySourcesService) GetInventorySourceByID(id int, params map[string]string) (*InventorySource, error) {
+func (i *InventorySourcesService) GetInventorySourceByID(ctx context.Context, id int, params map[string]string) (*InventorySource, error) {
 	result := new(InventorySource)
 	endpoint := fmt.Sprintf("%s%d/", inventorySourcesAPIEndpoint, id)
-	resp, err := i.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := i.client.Requester.GetJSON(ctx, endpoint, result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -43,29 +44,17 @@ func (i *InventorySourcesService) GetInventorySourceByID(id int, params map[stri
 }
 
 // ListInventorySources shows list of awx inventories.
-func (i *InventorySourcesService) ListInventorySources(params map[string]string) ([]*InventorySource, *ListInventorySourcesResponse, error) {
-	result := new(ListInventorySourcesResponse)
-	resp, err := i.client.Requester.GetJSON(inventorySourcesAPIEndpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
+func (i *InventorySourcesService) ListInventorySources(ctx context.Context, params map[string]string) ([]*InventorySource, *ListInventorySourcesResponse, error) {
+	results, pagination, err := listAll[*InventorySource](ctx, i.client.Requester, inventorySourcesAPIEndpoint, params)
 	if err != nil {
-		return nil, result, err
+		return nil, nil, err
 	}
 
-	if err := CheckResponse(resp); err != nil {
-		return nil, result, err
-	}
-
-	return result.Results, result, nil
+	return results, &ListInventorySourcesResponse{Pagination: pagination, Results: results}, nil
 }
 
 // CreateInventorySource creates an awx InventorySource.
-func (i *InventorySourcesService) CreateInventorySource(data map[string]interface{}, params map[string]string) (*InventorySource, error) {
+func (i *InventorySourcesService) CreateInventorySource(ctx context.Context, data map[string]interface{}, params map[string]string) (*InventorySource, error) {
 	mandatoryFields = []string{"name", "inventory"}
 	validate, status := ValidateParams(data, mandatoryFields)
 
@@ -82,11 +71,11 @@ func (i *InventorySourcesService) CreateInventorySource(data map[string]interfac
 
 	// Add check if InventorySource exists and return proper error
 
-	resp, err := i.client.Requester.PostJSON(inventorySourcesAPIEndpoint, bytes.NewReader(payload), result, params)
+	resp, err := i.client.Requester.PostJSON(ctx, inventorySourcesAPIEndpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -101,19 +90,28 @@ func (i *InventorySourcesService) CreateInventorySource(data map[string]interfac
 	return result, nil
 }
 
+// CreateInventorySourceFromRequest is CreateInventorySource with a typed InventorySourceRequest payload.
+func (i *InventorySourcesService) CreateInventorySourceFromRequest(ctx context.Context, req *InventorySourceRequest, params map[string]string) (*InventorySource, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return i.CreateInventorySource(ctx, data, params)
+}
+
 // UpdateInventorySource update an awx InventorySource.
-func (i *InventorySourcesService) UpdateInventorySource(id int, data map[string]interface{}, _ map[string]string) (*InventorySource, error) {
+func (i *InventorySourcesService) UpdateInventorySource(ctx context.Context, id int, data map[string]interface{}, _ map[string]string) (*InventorySource, error) {
 	result := new(InventorySource)
 	endpoint := fmt.Sprintf("%s%d", inventorySourcesAPIEndpoint, id)
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := i.client.Requester.PatchJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := i.client.Requester.PatchJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -128,22 +126,31 @@ func (i *InventorySourcesService) UpdateInventorySource(id int, data map[string]
 	return result, nil
 }
 
+// UpdateInventorySourceFromRequest is UpdateInventorySource with a typed InventorySourceRequest payload.
+func (i *InventorySourcesService) UpdateInventorySourceFromRequest(ctx context.Context, id int, req *InventorySourceRequest, params map[string]string) (*InventorySource, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return i.UpdateInventorySource(ctx, id, data, params)
+}
+
 // GetInventorySource retrieves the InventorySource information from its ID or Name.
-func (i *InventorySourcesService) GetInventorySource(id int, _ map[string]string) (*InventorySource, error) {
+func (i *InventorySourcesService) GetInventorySource(ctx context.Context, id int, _ map[string]string) (*InventorySource, error) {
 	endpoint := fmt.Sprintf("%s%d", inventorySourcesAPIEndpoint, id)
 	result := new(InventorySource)
-	resp, err := i.client.Requester.GetJSON(endpoint, result, map[string]string{})
+	resp, err := i.client.Requester.GetJSON(ctx, endpoint, result, map[string]string{})
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -159,15 +166,15 @@ func (i *InventorySourcesService) GetInventorySource(id int, _ map[string]string
 }
 
 // DeleteInventorySource delete an InventorySource from AWX.
-func (i *InventorySourcesService) DeleteInventorySource(id int) (*InventorySource, error) {
+func (i *InventorySourcesService) DeleteInventorySource(ctx context.Context, id int) (*InventorySource, error) {
 	result := new(InventorySource)
 	endpoint := fmt.Sprintf("%s%d", inventorySourcesAPIEndpoint, id)
 
-	resp, err := i.client.Requester.Delete(endpoint, result, nil)
+	resp, err := i.client.Requester.Delete(ctx, endpoint, result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
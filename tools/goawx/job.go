@@ -2,6 +2,7 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -43,14 +44,14 @@ type CancelJobResponse struct {
 const jobAPIEndpoint = "/api/v2/jobs/"
 
 // GetJob shows the details of a job.
-func (j *JobService) GetJob(id int, params map[string]string) (*Job, error) {
+func (j *JobService) GetJob(ctx context.Context, id int, params map[string]string) (*Job, error) {
 	result := new(Job)
 	endpoint := fmt.Sprintf("%s%d/", jobAPIEndpoint, id)
-	resp, err := j.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := j.client.Requester.GetJSON(ctx, endpoint, result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -66,7 +67,7 @@ func (j *JobService) GetJob(id int, params map[string]string) (*Job, error) {
 }
 
 // CancelJob cancels a job.
-func (j *JobService) CancelJob(id int, data map[string]interface{}, params map[string]string) (*CancelJobResponse, error) {
+func (j *JobService) CancelJob(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*CancelJobResponse, error) {
 	result := new(CancelJobResponse)
 	endpoint := fmt.Sprintf("%s%d/cancel/", jobAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -74,11 +75,11 @@ func (j *JobService) CancelJob(id int, data map[string]interface{}, params map[s
 		return nil, err
 	}
 
-	resp, err := j.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, params)
+	resp, err := j.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -94,7 +95,7 @@ func (j *JobService) CancelJob(id int, data map[string]interface{}, params map[s
 }
 
 // RelaunchJob relaunch a job.
-func (j *JobService) RelaunchJob(id int, data map[string]interface{}, params map[string]string) (*JobLaunch, error) {
+func (j *JobService) RelaunchJob(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*JobLaunch, error) {
 	result := new(JobLaunch)
 	endpoint := fmt.Sprintf("%s%d/relaunch/", jobAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -102,11 +103,11 @@ func (j *JobService) RelaunchJob(id int, data map[string]interface{}, params map
 		return nil, err
 	}
 
-	resp, err := j.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, params)
+	resp, err := j.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -122,47 +123,23 @@ func (j *JobService) RelaunchJob(id int, data map[string]interface{}, params map
 }
 
 // GetHostSummaries get a job hosts summaries.
-func (j *JobService) GetHostSummaries(id int, params map[string]string) ([]HostSummary, *HostSummariesResponse, error) {
-	result := new(HostSummariesResponse)
+func (j *JobService) GetHostSummaries(ctx context.Context, id int, params map[string]string) ([]HostSummary, *HostSummariesResponse, error) {
 	endpoint := fmt.Sprintf("%s%d/job_host_summaries/", jobAPIEndpoint, id)
-	resp, err := j.client.Requester.GetJSON(endpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
+	results, pagination, err := listAll[HostSummary](ctx, j.client.Requester, endpoint, params)
 	if err != nil {
-		return nil, result, err
-	}
-
-	if err := CheckResponse(resp); err != nil {
-		return nil, result, err
+		return nil, nil, err
 	}
 
-	return result.Results, result, nil
+	return results, &HostSummariesResponse{Pagination: pagination, Results: results}, nil
 }
 
 // GetJobEvents get a list of job events.
-func (j *JobService) GetJobEvents(id int, params map[string]string) ([]JobEvent, *JobEventsResponse, error) {
-	result := new(JobEventsResponse)
+func (j *JobService) GetJobEvents(ctx context.Context, id int, params map[string]string) ([]JobEvent, *JobEventsResponse, error) {
 	endpoint := fmt.Sprintf("%s%d/job_events/", jobAPIEndpoint, id)
-	resp, err := j.client.Requester.GetJSON(endpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
+	results, pagination, err := listAll[JobEvent](ctx, j.client.Requester, endpoint, params)
 	if err != nil {
-		return nil, result, err
-	}
-
-	if err := CheckResponse(resp); err != nil {
-		return nil, result, err
+		return nil, nil, err
 	}
 
-	return result.Results, result, nil
+	return results, &JobEventsResponse{Pagination: pagination, Results: results}, nil
 }
@@ -0,0 +1,272 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Formats of the job stdout endpoint.
+const (
+	// StdoutFormatText is the plain text output, without color codes.
+	StdoutFormatText = "txt"
+	// StdoutFormatANSI is the output with its ANSI color codes.
+	StdoutFormatANSI = "ansi"
+	// StdoutFormatJSON returns a range of lines of the output along with the range, see GetJobStdoutRange.
+	StdoutFormatJSON = "json"
+)
+
+// DefaultEventPollInterval is the interval at which a JobEventFollower polls for new events.
+const DefaultEventPollInterval = 2 * time.Second
+
+// DefaultJobPollInterval and DefaultJobPollMaxInterval are the first and the longest intervals at
+// which PollJob reads the status of a job.
+const (
+	DefaultJobPollInterval    = 3 * time.Second
+	DefaultJobPollMaxInterval = 30 * time.Second
+)
+
+// StdoutRange is the range of lines of a JobStdout. AbsoluteEnd is the number of lines of the whole output.
+type StdoutRange struct {
+	Start       int `json:"start"`
+	End         int `json:"end"`
+	AbsoluteEnd int `json:"absolute_end"`
+}
+
+// JobStdout represents a range of the output of a job.
+type JobStdout struct {
+	Range   StdoutRange `json:"range"`
+	Content string      `json:"content"`
+}
+
+// GetJobStdout returns the whole output of a job, in StdoutFormatText or StdoutFormatANSI.
+func (j *JobService) GetJobStdout(ctx context.Context, id int, format string) (string, error) {
+	if format != StdoutFormatText && format != StdoutFormatANSI {
+		return "", fmt.Errorf("unsupported stdout format %q, expecting %q or %q", format, StdoutFormatText, StdoutFormatANSI)
+	}
+
+	var result string
+	endpoint := fmt.Sprintf("%s%d/stdout/", jobAPIEndpoint, id)
+	resp, err := j.client.Requester.Get(ctx, endpoint, &result, map[string]string{"format": format})
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				logCloseError(ctx, err)
+			}
+		}()
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return "", err
+	}
+
+	return result, nil
+}
+
+// GetJobStdoutRange returns the lines [start, end) of the output of a job, with the content in
+// StdoutFormatText or StdoutFormatANSI. An end of 0 reads up to the last line. The returned range
+// tells where to continue from, and Range.AbsoluteEnd how many lines the output currently has.
+func (j *JobService) GetJobStdoutRange(ctx context.Context, id int, contentFormat string, start, end int) (*JobStdout, error) {
+	if contentFormat != StdoutFormatText && contentFormat != StdoutFormatANSI {
+		return nil, fmt.Errorf("unsupported stdout content format %q, expecting %q or %q", contentFormat, StdoutFormatText, StdoutFormatANSI)
+	}
+
+	params := map[string]string{
+		"format":         StdoutFormatJSON,
+		"content_format": contentFormat,
+		"start_line":     strconv.Itoa(start),
+	}
+	if end > 0 {
+		params["end_line"] = strconv.Itoa(end)
+	}
+
+	result := new(JobStdout)
+	endpoint := fmt.Sprintf("%s%d/stdout/", jobAPIEndpoint, id)
+	resp, err := j.client.Requester.GetJSON(ctx, endpoint, result, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				logCloseError(ctx, err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetJobStdoutTail returns the last lines of the output of a job, in StdoutFormatText.
+func (j *JobService) GetJobStdoutTail(ctx context.Context, id int, lines int) (string, error) {
+	// A first single line range tells how many lines the output has.
+	head, err := j.GetJobStdoutRange(ctx, id, StdoutFormatText, 0, 1)
+	if err != nil {
+		return "", err
+	}
+	start := head.Range.AbsoluteEnd - lines
+	if start < 0 {
+		start = 0
+	}
+	tail, err := j.GetJobStdoutRange(ctx, id, StdoutFormatText, start, head.Range.AbsoluteEnd)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(tail.Content, "\r\n"), nil
+}
+
+// IsFinished reports whether the job ended, and all of its events were processed.
+func (j *Job) IsFinished() bool {
+	return isFinishedStatus(j.Status) && j.EventProcessingFinished
+}
+
+// isFinishedStatus reports whether a job ends in status.
+func isFinishedStatus(status string) bool {
+	switch status {
+	case JobStatusSuccessful, JobStatusFailed, JobStatusError, JobStatusCanceled:
+		return true
+	}
+	return false
+}
+
+// PollJob waits for the job id to finish, reading its status only, first after interval then at
+// intervals doubling up to maxInterval. A job that did not succeed is returned once its events were
+// processed, for them to be read.
+func (j *JobService) PollJob(ctx context.Context, id int, interval, maxInterval time.Duration) (*Job, error) {
+	for {
+		if err := sleepContext(ctx, interval); err != nil {
+			return nil, err
+		}
+		job, err := j.GetJob(ctx, id, map[string]string{})
+		if err != nil {
+			return nil, err
+		}
+		if job.Status == JobStatusSuccessful || job.IsFinished() {
+			return job, nil
+		}
+		if interval *= 2; interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// GetFailedJobEvents returns the failed events of a job, in counter order.
+func (j *JobService) GetFailedJobEvents(ctx context.Context, id int) ([]JobEvent, error) {
+	events, _, err := j.GetJobEvents(ctx, id, map[string]string{"failed": "true", "order_by": "counter"})
+	return events, err
+}
+
+// JobEventFollower yields the events of a job in counter order as the job emits them, until the
+// job finished and all of its events were read:
+//
+//	f := client.JobService.FollowJobEvents(id)
+//	for f.Next(ctx) {
+//		event := f.Value()
+//	}
+//	if err := f.Err(); err != nil {
+//		...
+//	}
+//	job := f.Job()
+type JobEventFollower struct {
+	service      *JobService
+	jobID        int
+	pollInterval time.Duration
+	counter      int
+
+	job    *Job
+	done   bool
+	buffer []JobEvent
+	value  JobEvent
+	err    error
+}
+
+// FollowJobEvents returns a JobEventFollower reading the events of the job id.
+func (j *JobService) FollowJobEvents(id int) *JobEventFollower {
+	return &JobEventFollower{service: j, jobID: id, pollInterval: DefaultEventPollInterval}
+}
+
+// SetPollInterval overrides the interval at which new events are polled for while the job runs.
+func (f *JobEventFollower) SetPollInterval(interval time.Duration) *JobEventFollower {
+	f.pollInterval = interval
+	return f
+}
+
+// Since skips the events up to counter, e.g. to resume following a job.
+func (f *JobEventFollower) Since(counter int) *JobEventFollower {
+	f.counter = counter
+	return f
+}
+
+// Next advances to the next event, waiting for the job to emit it. It returns false once the
+// job finished and all of its events were consumed, or when an error occurred, see Err.
+func (f *JobEventFollower) Next(ctx context.Context) bool {
+	for len(f.buffer) == 0 {
+		if f.done || f.err != nil {
+			return false
+		}
+		if !f.poll(ctx) {
+			return false
+		}
+	}
+	f.value, f.buffer = f.buffer[0], f.buffer[1:]
+	f.counter = f.value.Counter
+	return true
+}
+
+// poll fetches the events emitted since the last one read, waiting for the poll interval when
+// there is none yet. It returns false on error.
+func (f *JobEventFollower) poll(ctx context.Context) bool {
+	// Read the status before the events, so that no event emitted before the job finished is missed.
+	job, err := f.service.GetJob(ctx, f.jobID, map[string]string{})
+	if err != nil {
+		f.err = err
+		return false
+	}
+	f.job = job
+
+	events, _, err := f.service.GetJobEvents(ctx, f.jobID, map[string]string{
+		"counter__gt": strconv.Itoa(f.counter),
+		"order_by":    "counter",
+	})
+	if err != nil {
+		f.err = err
+		return false
+	}
+	if len(events) > 0 {
+		f.buffer = events
+		return true
+	}
+	if job.IsFinished() {
+		f.done = true
+		return true
+	}
+	if err := sleepContext(ctx, f.pollInterval); err != nil {
+		f.err = err
+		return false
+	}
+	return true
+}
+
+// Value returns the current event, as selected by the last call to Next.
+func (f *JobEventFollower) Value() JobEvent {
+	return f.value
+}
+
+// Job returns the job as last polled, finished once Next returned false without error.
+func (f *JobEventFollower) Job() *Job {
+	return f.job
+}
+
+// Err returns the error that stopped following the job, if any.
+func (f *JobEventFollower) Err() error {
+	return f.err
+}
@@ -0,0 +1,137 @@
+package awx_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/goawx/awxtest"
+)
+
+// launchFakeJob launches a job on a fake AWX whose jobs go through statuses.
+func launchFakeJob(t *testing.T, statuses ...string) (*awx.AWX, int) {
+	t.Helper()
+	srv := awxtest.NewServer(awxtest.WithJobStatuses(statuses...))
+	t.Cleanup(srv.Close)
+	client, err := awx.NewAWX(context.Background(), srv.URL, srv.Username, srv.Password, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inventory, err := srv.Create("inventories", awxtest.Object{"name": "targets", "organization": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	template, err := srv.Create("job_templates", awxtest.Object{"name": "deploy", "inventory": inventory, "playbook": "site.yml"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	launch, err := client.JobTemplateService.Launch(context.Background(), template, map[string]interface{}{}, map[string]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, launch.Job
+}
+
+func TestJobEventFollower(t *testing.T) {
+	client, jobID := launchFakeJob(t, "pending", "running", "running", "failed")
+
+	follower := client.JobService.FollowJobEvents(jobID).SetPollInterval(time.Millisecond)
+	var events []string
+	counter := 0
+	for follower.Next(context.Background()) {
+		event := follower.Value()
+		if event.Counter <= counter {
+			t.Errorf("Expecting events in counter order, got %d after %d", event.Counter, counter)
+		}
+		counter = event.Counter
+		events = append(events, event.Event)
+	}
+	if err := follower.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"playbook_on_start", "playbook_on_play_start", "playbook_on_task_start", "runner_on_failed", "playbook_on_stats"}
+	if strings.Join(events, ",") != strings.Join(want, ",") {
+		t.Errorf("Expecting events %v but got %v", want, events)
+	}
+	if status := follower.Job().Status; status != awx.JobStatusFailed {
+		t.Errorf("Expecting the job to be %s but got %s", awx.JobStatusFailed, status)
+	}
+}
+
+func TestJobService_PollJob(t *testing.T) {
+	client, jobID := launchFakeJob(t, "pending", "running", "running", "failed")
+
+	job, err := client.JobService.PollJob(context.Background(), jobID, time.Millisecond, 4*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Status != awx.JobStatusFailed || !job.IsFinished() {
+		t.Errorf("Expecting the job to be %s with its events processed but got %+v", awx.JobStatusFailed, job)
+	}
+
+	events, err := client.JobService.GetFailedJobEvents(context.Background(), jobID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Event != "runner_on_failed" {
+		t.Errorf("Expecting the failed task event only but got %+v", events)
+	}
+}
+
+func TestJobService_PollJobCanceled(t *testing.T) {
+	client, jobID := launchFakeJob(t, "running")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := client.JobService.PollJob(ctx, jobID, time.Millisecond, time.Millisecond); err == nil || ctx.Err() == nil {
+		t.Errorf("Expecting the deadline to stop polling, got %v", err)
+	}
+}
+
+func TestJobService_GetJobStdout(t *testing.T) {
+	ctx := context.Background()
+	client, jobID := launchFakeJob(t, "failed")
+
+	text, err := client.JobService.GetJobStdout(ctx, jobID, awx.StdoutFormatText)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(text, "fatal: [localhost]: FAILED!") || strings.Contains(text, "\x1b[") {
+		t.Errorf("Expecting the plain text output but got %q", text)
+	}
+
+	ansi, err := client.JobService.GetJobStdout(ctx, jobID, awx.StdoutFormatANSI)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(ansi, "\x1b[0;31mfatal") {
+		t.Errorf("Expecting the colored output but got %q", ansi)
+	}
+
+	if _, err := client.JobService.GetJobStdout(ctx, jobID, awx.StdoutFormatJSON); err == nil {
+		t.Error("Expecting an error reading the whole output in the json format")
+	}
+
+	page, err := client.JobService.GetJobStdoutRange(ctx, jobID, awx.StdoutFormatText, 1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Range.Start != 1 || page.Range.End != 3 || page.Range.AbsoluteEnd != strings.Count(text, "\n") {
+		t.Errorf("Unexpected range %+v", page.Range)
+	}
+	if lines := strings.Split(strings.TrimSuffix(page.Content, "\n"), "\n"); len(lines) != 2 || !strings.HasPrefix(lines[0], "TASK [deploy]") {
+		t.Errorf("Unexpected content %q", page.Content)
+	}
+
+	tail, err := client.JobService.GetJobStdoutTail(ctx, jobID, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(tail, "PLAY RECAP") || !strings.HasSuffix(tail, "skipped=0") {
+		t.Errorf("Unexpected tail %q", tail)
+	}
+}
@@ -2,6 +2,7 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -21,14 +22,14 @@ type ListJobTemplatesResponse struct {
 const jobTemplateAPIEndpoint = "/api/v2/job_templates/"
 
 // GetJobTemplateByID shows the details of a job template.
-func (jt *JobTemplateService) GetJobTemplateByID(id int, params map[string]string) (*JobTemplate, error) {
+func (jt *JobTemplateService) GetJobTemplateByID(ctx context.Context, id int, params map[string]string) (*JobTemplate, error) {
 	result := new(JobTemplate)
 	endpoint := fmt.Sprintf("%s%d/", jobTemplateAPIEndpoint, id)
-	resp, err := jt.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := jt.client.Requester.GetJSON(ctx, endpoint, result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -44,29 +45,17 @@ func (jt *JobTemplateService) GetJobTemplateByID(id int, params map[string]strin
 }
 
 // ListJobTemplates shows a list of job templates.
-func (jt *JobTemplateService) ListJobTemplates(params map[string]string) ([]*JobTemplate, *ListJobTemplatesResponse, error) {
-	result := new(ListJobTemplatesResponse)
-	resp, err := jt.client.Requester.GetJSON(jobTemplateAPIEndpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
+func (jt *JobTemplateService) ListJobTemplates(ctx context.Context, params map[string]string) ([]*JobTemplate, *ListJobTemplatesResponse, error) {
+	results, pagination, err := listAll[*JobTemplate](ctx, jt.client.Requester, jobTemplateAPIEndpoint, params)
 	if err != nil {
-		return nil, result, err
+		return nil, nil, err
 	}
 
-	if err := CheckResponse(resp); err != nil {
-		return nil, result, err
-	}
-
-	return result.Results, result, nil
+	return results, &ListJobTemplatesResponse{Pagination: pagination, Results: results}, nil
 }
 
 // Launch launches a job with the job template.
-func (jt *JobTemplateService) Launch(id int, data map[string]interface{}, params map[string]string) (*JobLaunch, error) {
+func (jt *JobTemplateService) Launch(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*JobLaunch, error) {
 	result := new(JobLaunch)
 	endpoint := fmt.Sprintf("%s%d/launch/", jobTemplateAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -74,11 +63,11 @@ func (jt *JobTemplateService) Launch(id int, data map[string]interface{}, params
 		return nil, err
 	}
 
-	resp, err := jt.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, params)
+	resp, err := jt.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -99,7 +88,7 @@ func (jt *JobTemplateService) Launch(id int, data map[string]interface{}, params
 }
 
 // CreateJobTemplate creates a job template.
-func (jt *JobTemplateService) CreateJobTemplate(data map[string]interface{}, params map[string]string) (*JobTemplate, error) {
+func (jt *JobTemplateService) CreateJobTemplate(ctx context.Context, data map[string]interface{}, params map[string]string) (*JobTemplate, error) {
 	result := new(JobTemplate)
 	mandatoryFields = []string{"name", "job_type", "inventory", "project"}
 	validate, status := ValidateParams(data, mandatoryFields)
@@ -112,11 +101,11 @@ func (jt *JobTemplateService) CreateJobTemplate(data map[string]interface{}, par
 		return nil, err
 	}
 
-	resp, err := jt.client.Requester.PostJSON(jobTemplateAPIEndpoint, bytes.NewReader(payload), result, params)
+	resp, err := jt.client.Requester.PostJSON(ctx, jobTemplateAPIEndpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -129,8 +118,17 @@ func (jt *JobTemplateService) CreateJobTemplate(data map[string]interface{}, par
 	return result, nil
 }
 
+// CreateJobTemplateFromRequest is CreateJobTemplate with a typed JobTemplateRequest payload.
+func (jt *JobTemplateService) CreateJobTemplateFromRequest(ctx context.Context, req *JobTemplateRequest, params map[string]string) (*JobTemplate, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return jt.CreateJobTemplate(ctx, data, params)
+}
+
 // UpdateJobTemplate updates a job template.
-func (jt *JobTemplateService) UpdateJobTemplate(id int, data map[string]interface{}, params map[string]string) (*JobTemplate, error) {
+func (jt *JobTemplateService) UpdateJobTemplate(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*JobTemplate, error) {
 	result := new(JobTemplate)
 	endpoint := fmt.Sprintf("%s%d", jobTemplateAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -138,11 +136,11 @@ func (jt *JobTemplateService) UpdateJobTemplate(id int, data map[string]interfac
 		return nil, err
 	}
 
-	resp, err := jt.client.Requester.PatchJSON(endpoint, bytes.NewReader(payload), result, params)
+	resp, err := jt.client.Requester.PatchJSON(ctx, endpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -155,16 +153,25 @@ func (jt *JobTemplateService) UpdateJobTemplate(id int, data map[string]interfac
 	return result, nil
 }
 
+// UpdateJobTemplateFromRequest is UpdateJobTemplate with a typed JobTemplateRequest payload.
+func (jt *JobTemplateService) UpdateJobTemplateFromRequest(ctx context.Context, id int, req *JobTemplateRequest, params map[string]string) (*JobTemplate, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return jt.UpdateJobTemplate(ctx, id, data, params)
+}
+
 // DeleteJobTemplate deletes a job template.
-func (jt *JobTemplateService) DeleteJobTemplate(id int) (*JobTemplate, error) {
+func (jt *JobTemplateService) DeleteJobTemplate(ctx context.Context, id int) (*JobTemplate, error) {
 	result := new(JobTemplate)
 	endpoint := fmt.Sprintf("%s%d", jobTemplateAPIEndpoint, id)
 
-	resp, err := jt.client.Requester.Delete(endpoint, result, nil)
+	resp, err := jt.client.Requester.Delete(ctx, endpoint, result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -180,14 +187,15 @@ func (jt *JobTemplateService) DeleteJobTemplate(id int) (*JobTemplate, error) {
 }
 
 // ListJobTemplateLabels returns all labels associated with a job template.
-func (jt *JobTemplateService) ListJobTemplateLabels(id int) ([]*Label, error) {
+func (jt *JobTemplateService) ListJobTemplateLabels(ctx context.Context, id int) ([]*Label, error) {
 	endpoint := fmt.Sprintf("%s%d/labels/", jobTemplateAPIEndpoint, id)
-	return getAllLabelPages(jt.client.Requester, endpoint, map[string]string{})
+	results, _, err := listAll[*Label](ctx, jt.client.Requester, endpoint, map[string]string{})
+	return results, err
 }
 
 // AssociateLabel creates (or finds) a label by name+organization and associates it
 // with the job template. It returns the Label object produced by AWX.
-func (jt *JobTemplateService) AssociateLabel(id int, data map[string]interface{}) (*Label, error) {
+func (jt *JobTemplateService) AssociateLabel(ctx context.Context, id int, data map[string]interface{}) (*Label, error) {
 	result := new(Label)
 	endpoint := fmt.Sprintf("%s%d/labels/", jobTemplateAPIEndpoint, id)
 	mandatory := []string{"name", "organization"}
@@ -199,11 +207,11 @@ func (jt *JobTemplateService) AssociateLabel(id int, data map[string]interface{}
 	if err != nil {
 		return nil, err
 	}
-	resp, err := jt.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := jt.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -217,7 +225,7 @@ func (jt *JobTemplateService) AssociateLabel(id int, data map[string]interface{}
 }
 
 // DisassociateLabel removes a label association from a job template.
-func (jt *JobTemplateService) DisAssociateLabel(id int, labelID int) error {
+func (jt *JobTemplateService) DisAssociateLabel(ctx context.Context, id int, labelID int) error {
 	result := new(Label)
 	endpoint := fmt.Sprintf("%s%d/labels/", jobTemplateAPIEndpoint, id)
 	data := map[string]interface{}{
@@ -228,11 +236,11 @@ func (jt *JobTemplateService) DisAssociateLabel(id int, labelID int) error {
 	if err != nil {
 		return err
 	}
-	resp, err := jt.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := jt.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -243,7 +251,7 @@ func (jt *JobTemplateService) DisAssociateLabel(id int, labelID int) error {
 }
 
 // DisAssociateCredentials remove Credentials form an awx job template.
-func (jt *JobTemplateService) DisAssociateCredentials(id int, data map[string]interface{}, _ map[string]string) (*JobTemplate, error) {
+func (jt *JobTemplateService) DisAssociateCredentials(ctx context.Context, id int, data map[string]interface{}, _ map[string]string) (*JobTemplate, error) {
 	result := new(JobTemplate)
 	endpoint := fmt.Sprintf("%s%d/credentials/", jobTemplateAPIEndpoint, id)
 	data["disassociate"] = true
@@ -257,11 +265,11 @@ func (jt *JobTemplateService) DisAssociateCredentials(id int, data map[string]in
 	if err != nil {
 		return nil, err
 	}
-	resp, err := jt.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := jt.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -277,7 +285,7 @@ func (jt *JobTemplateService) DisAssociateCredentials(id int, data map[string]in
 }
 
 // AssociateCredentials  adding credentials to JobTemplate.
-func (jt *JobTemplateService) AssociateCredentials(id int, data map[string]interface{}, _ map[string]string) (*JobTemplate, error) {
+func (jt *JobTemplateService) AssociateCredentials(ctx context.Context, id int, data map[string]interface{}, _ map[string]string) (*JobTemplate, error) {
 	result := new(JobTemplate)
 
 	endpoint := fmt.Sprintf("%s%d/credentials/", jobTemplateAPIEndpoint, id)
@@ -292,11 +300,11 @@ func (jt *JobTemplateService) AssociateCredentials(id int, data map[string]inter
 	if err != nil {
 		return nil, err
 	}
-	resp, err := jt.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := jt.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -312,7 +320,7 @@ func (jt *JobTemplateService) AssociateCredentials(id int, data map[string]inter
 }
 
 // DisAssociateInstanceGroups remove instance group from an awx job template.
-func (jt *JobTemplateService) DisAssociateInstanceGroups(id int, data map[string]interface{}, _ map[string]string) (*JobTemplate, error) {
+func (jt *JobTemplateService) DisAssociateInstanceGroups(ctx context.Context, id int, data map[string]interface{}, _ map[string]string) (*JobTemplate, error) {
 	result := new(JobTemplate)
 	endpoint := fmt.Sprintf("%s%d/instance_groups/", jobTemplateAPIEndpoint, id)
 	data["disassociate"] = true
@@ -326,11 +334,11 @@ func (jt *JobTemplateService) DisAssociateInstanceGroups(id int, data map[string
 	if err != nil {
 		return nil, err
 	}
-	resp, err := jt.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := jt.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -346,7 +354,7 @@ func (jt *JobTemplateService) DisAssociateInstanceGroups(id int, data map[string
 }
 
 // AssociateInstanceGroups  adding instance group to JobTemplate.
-func (jt *JobTemplateService) AssociateInstanceGroups(id int, data map[string]interface{}, _ map[string]string) (*JobTemplate, error) {
+func (jt *JobTemplateService) AssociateInstanceGroups(ctx context.Context, id int, data map[string]interface{}, _ map[string]string) (*JobTemplate, error) {
 	result := new(JobTemplate)
 
 	endpoint := fmt.Sprintf("%s%d/instance_groups/", jobTemplateAPIEndpoint, id)
@@ -361,11 +369,11 @@ func (jt *JobTemplateService) AssociateInstanceGroups(id int, data map[string]in
 	if err != nil {
 		return nil, err
 	}
-	resp, err := jt.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := jt.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -2,6 +2,7 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -13,7 +14,7 @@ type JobTemplateNotificationTemplatesService struct {
 	client *Client
 }
 
-func (jt *JobTemplateNotificationTemplatesService) associateJobTemplateNotificationTemplatesForType(jobTemplateID int, notificationTemplateID int, typ string) (*NotificationTemplate, error) {
+func (jt *JobTemplateNotificationTemplatesService) associateJobTemplateNotificationTemplatesForType(ctx context.Context, jobTemplateID int, notificationTemplateID int, typ string) (*NotificationTemplate, error) {
 	result := new(NotificationTemplate)
 
 	data := map[string]interface{}{
@@ -32,11 +33,11 @@ func (jt *JobTemplateNotificationTemplatesService) associateJobTemplateNotificat
 	if err != nil {
 		return nil, err
 	}
-	resp, err := jt.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := jt.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -52,21 +53,21 @@ func (jt *JobTemplateNotificationTemplatesService) associateJobTemplateNotificat
 }
 
 // AssociateJobTemplateNotificationTemplatesError will associate an error notification_template for a job_template.
-func (jt *JobTemplateNotificationTemplatesService) AssociateJobTemplateNotificationTemplatesError(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return jt.associateJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "error")
+func (jt *JobTemplateNotificationTemplatesService) AssociateJobTemplateNotificationTemplatesError(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return jt.associateJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "error")
 }
 
 // AssociateJobTemplateNotificationTemplatesSuccess will associate a success notification_template for a job_template.
-func (jt *JobTemplateNotificationTemplatesService) AssociateJobTemplateNotificationTemplatesSuccess(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return jt.associateJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "success")
+func (jt *JobTemplateNotificationTemplatesService) AssociateJobTemplateNotificationTemplatesSuccess(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return jt.associateJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "success")
 }
 
 // AssociateJobTemplateNotificationTemplatesStarted will associate a started notification_template for a job_template.
-func (jt *JobTemplateNotificationTemplatesService) AssociateJobTemplateNotificationTemplatesStarted(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return jt.associateJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "started")
+func (jt *JobTemplateNotificationTemplatesService) AssociateJobTemplateNotificationTemplatesStarted(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return jt.associateJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "started")
 }
 
-func (jt *JobTemplateNotificationTemplatesService) disassociateJobTemplateNotificationTemplatesForType(jobTemplateID int, notificationTemplateID int, typ string) (*NotificationTemplate, error) {
+func (jt *JobTemplateNotificationTemplatesService) disassociateJobTemplateNotificationTemplatesForType(ctx context.Context, jobTemplateID int, notificationTemplateID int, typ string) (*NotificationTemplate, error) {
 	result := new(NotificationTemplate)
 
 	data := map[string]interface{}{
@@ -86,11 +87,11 @@ func (jt *JobTemplateNotificationTemplatesService) disassociateJobTemplateNotifi
 	if err != nil {
 		return nil, err
 	}
-	resp, err := jt.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := jt.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -106,16 +107,16 @@ func (jt *JobTemplateNotificationTemplatesService) disassociateJobTemplateNotifi
 }
 
 // DisassociateJobTemplateNotificationTemplatesError will disassociate an error notification_template for a job_template.
-func (jt *JobTemplateNotificationTemplatesService) DisassociateJobTemplateNotificationTemplatesError(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return jt.disassociateJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "error")
+func (jt *JobTemplateNotificationTemplatesService) DisassociateJobTemplateNotificationTemplatesError(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return jt.disassociateJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "error")
 }
 
 // DisassociateJobTemplateNotificationTemplatesSuccess will disassociate a success notification_template for a job_template.
-func (jt *JobTemplateNotificationTemplatesService) DisassociateJobTemplateNotificationTemplatesSuccess(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return jt.disassociateJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "success")
+func (jt *JobTemplateNotificationTemplatesService) DisassociateJobTemplateNotificationTemplatesSuccess(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return jt.disassociateJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "success")
 }
 
 // DisassociateJobTemplateNotificationTemplatesStarted will disassociate a started notification_template for a job_template.
-func (jt *JobTemplateNotificationTemplatesService) DisassociateJobTemplateNotificationTemplatesStarted(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return jt.disassociateJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "started")
+func (jt *JobTemplateNotificationTemplatesService) DisassociateJobTemplateNotificationTemplatesStarted(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return jt.disassociateJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "started")
 }
@@ -0,0 +1,139 @@
+package awx
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// WithRateLimit bounds the load the Requester puts on AWX: at most maxConcurrent requests are in
+// flight at once, and at most requestsPerSecond requests are sent every second. Zero disables the
+// corresponding limit. Retries go through the limits again, but the wait between two attempts
+// does not hold a slot.
+func WithRateLimit(maxConcurrent int, requestsPerSecond float64) RequesterOption {
+	return func(r *Requester) {
+		if maxConcurrent <= 0 && requestsPerSecond <= 0 {
+			r.limiter = nil
+			return
+		}
+		r.limiter = newLimiter(maxConcurrent, requestsPerSecond)
+	}
+}
+
+// limiter combines a semaphore, bounding the concurrent requests, and a token bucket, bounding
+// the request rate.
+type limiter struct {
+	slots  chan struct{}
+	bucket *tokenBucket
+
+	// Total time the requests spent waiting, reported in the debug logs.
+	slotWait atomic.Int64
+	rateWait atomic.Int64
+}
+
+// limiterWait is the time a request spent waiting for the limiter before being sent.
+type limiterWait struct {
+	slot, rate time.Duration
+}
+
+func newLimiter(maxConcurrent int, requestsPerSecond float64) *limiter {
+	l := &limiter{}
+	if maxConcurrent > 0 {
+		l.slots = make(chan struct{}, maxConcurrent)
+	}
+	if requestsPerSecond > 0 {
+		l.bucket = newTokenBucket(requestsPerSecond)
+	}
+	return l
+}
+
+// acquire waits for a request slot, then for a token. The slot is taken first, so that the
+// requests waiting for a token do not pile up on AWX once they get it. The returned release
+// frees the slot and must be called once the response was read.
+func (l *limiter) acquire(ctx context.Context) (func(), limiterWait, error) {
+	var wait limiterWait
+	if l == nil {
+		return func() {}, wait, nil
+	}
+
+	release := func() {}
+	if l.slots != nil {
+		start := time.Now()
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, wait, ctx.Err()
+		}
+		wait.slot = time.Since(start)
+		l.slotWait.Add(int64(wait.slot))
+		release = func() { <-l.slots }
+	}
+
+	if l.bucket != nil {
+		start := time.Now()
+		if err := l.bucket.wait(ctx); err != nil {
+			release()
+			return nil, wait, err
+		}
+		wait.rate = time.Since(start)
+		l.rateWait.Add(int64(wait.rate))
+	}
+	return release, wait, nil
+}
+
+// logFields returns the wait metrics of a request, and the totals of the limiter.
+func (l *limiter) logFields(wait limiterWait) map[string]interface{} {
+	if l == nil {
+		return nil
+	}
+	fields := map[string]interface{}{
+		"slot_wait_ms":       wait.slot.Milliseconds(),
+		"rate_wait_ms":       wait.rate.Milliseconds(),
+		"total_slot_wait_ms": time.Duration(l.slotWait.Load()).Milliseconds(),
+		"total_rate_wait_ms": time.Duration(l.rateWait.Load()).Milliseconds(),
+	}
+	if l.slots != nil {
+		fields["in_flight"] = len(l.slots)
+	}
+	return fields
+}
+
+// tokenBucket hands out rate tokens per second, with a burst of a single token so that requests
+// are spread evenly over time.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64) *tokenBucket {
+	return &tokenBucket{rate: rate, tokens: 1, last: time.Now()}
+}
+
+// wait takes a token, waiting for it to be available. The token is reserved before waiting, so
+// that the concurrent callers are served in order, and given back if ctx is done first.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens = min(1, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+	var delay time.Duration
+	if b.tokens < 0 {
+		delay = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	b.mu.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+	if err := sleepContext(ctx, delay); err != nil {
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package awx_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+// newLimitedRequester returns a Requester limited by opt, and the highest number of requests the
+// server saw in flight at once.
+func newLimitedRequester(t *testing.T, opt awx.RequesterOption) (*awx.Requester, *atomic.Int32) {
+	var inFlight, maxInFlight atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	r := &awx.Requester{Base: srv.URL, Authenticator: &awx.BasicAuth{}, Client: srv.Client()}
+	opt(r)
+	return r, &maxInFlight
+}
+
+func sendConcurrently(t *testing.T, r *awx.Requester, n int) {
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := r.GetJSON(context.Background(), "/api/v2/ping/", nil, nil); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestWithRateLimit_MaxConcurrent(t *testing.T) {
+	r, maxInFlight := newLimitedRequester(t, awx.WithRateLimit(2, 0))
+
+	sendConcurrently(t, r, 10)
+	if got := maxInFlight.Load(); got > 2 {
+		t.Errorf("Expecting at most 2 requests in flight but got %d", got)
+	}
+}
+
+func TestWithRateLimit_RequestsPerSecond(t *testing.T) {
+	r, _ := newLimitedRequester(t, awx.WithRateLimit(0, 50))
+
+	start := time.Now()
+	sendConcurrently(t, r, 6)
+	// The first request goes right away, the 5 others are spread 20ms apart.
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("Expecting 6 requests at 50 per second to take at least 100ms but took %s", elapsed)
+	}
+}
+
+func TestWithRateLimit_Canceled(t *testing.T) {
+	r, _ := newLimitedRequester(t, awx.WithRateLimit(0, 0.1))
+	if _, err := r.GetJSON(context.Background(), "/api/v2/ping/", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := r.GetJSON(ctx, "/api/v2/ping/", nil, nil); err == nil {
+		t.Error("Expecting the request waiting for its turn to fail with the context")
+	}
+}
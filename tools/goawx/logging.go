@@ -0,0 +1,177 @@
+package awx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// maxLoggedBodySize caps the number of body bytes written to the debug logs.
+const maxLoggedBodySize = 64 * 1024
+
+const redacted = "***"
+
+// sensitiveHeaders are never written to the logs.
+//
+//nolint:gochecknoglobals
+var sensitiveHeaders = map[string]struct{}{
+	"Authorization": {},
+	"Cookie":        {},
+	"Set-Cookie":    {},
+	"X-Csrftoken":   {},
+}
+
+// sensitiveKeyParts are matched against JSON and form field names; any field containing one
+// of them has its value masked in the logs.
+//
+//nolint:gochecknoglobals
+var sensitiveKeyParts = []string{"password", "passphrase", "secret", "token", "ssh_key_data", "ssh_key_unlock", "webhook_key"}
+
+// isSensitiveKey reports whether the value of the field named key must be masked.
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactHeaders returns the request or response headers with credentials masked.
+func redactHeaders(headers http.Header) map[string]string {
+	redactedHeaders := make(map[string]string, len(headers))
+	for name, values := range headers {
+		if _, ok := sensitiveHeaders[http.CanonicalHeaderKey(name)]; ok {
+			redactedHeaders[name] = redacted
+			continue
+		}
+		redactedHeaders[name] = strings.Join(values, ", ")
+	}
+	return redactedHeaders
+}
+
+// redactValue masks sensitive fields of a decoded JSON document. Every value of a credential
+// `inputs` object is masked, whatever its name, as custom credential types can store secrets
+// under any field.
+func redactValue(key string, value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, item := range v {
+			if key == "inputs" {
+				if item != nil && item != "" {
+					v[k] = redacted
+				}
+				continue
+			}
+			v[k] = redactValue(k, item)
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(key, item)
+		}
+		return v
+	case string:
+		if v != "" && isSensitiveKey(key) {
+			return redacted
+		}
+	}
+	return value
+}
+
+// redactBody renders a request or response body for the logs, masking credentials in JSON
+// and form encoded payloads and truncating large bodies.
+func redactBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			for key := range values {
+				if isSensitiveKey(key) {
+					values.Set(key, redacted)
+				}
+			}
+			return values.Encode()
+		}
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err == nil {
+		if encoded, err := json.Marshal(redactValue("", decoded)); err == nil {
+			body = encoded
+		}
+	}
+
+	if len(body) > maxLoggedBodySize {
+		return string(body[:maxLoggedBodySize]) + "... (truncated)"
+	}
+	return string(body)
+}
+
+// logRequest writes an outgoing request to the debug logs.
+func logRequest(ctx context.Context, req *http.Request, body []byte, attempt int, extra map[string]interface{}) {
+	fields := map[string]interface{}{
+		"method":  req.Method,
+		"url":     req.URL.String(),
+		"attempt": attempt + 1,
+		"headers": redactHeaders(req.Header),
+		"body":    redactBody(req.Header.Get("Content-Type"), body),
+	}
+	for k, v := range extra {
+		fields[k] = v
+	}
+	tflog.Debug(ctx, "Sending AWX API request", fields)
+}
+
+// bufferResponse reads the whole response body, so that it can be logged and decoded, and
+// replaces it with an in-memory copy.
+func bufferResponse(ctx context.Context, req *http.Request, resp *http.Response, latency time.Duration) error {
+	body, err := io.ReadAll(resp.Body)
+	if cerr := resp.Body.Close(); cerr != nil {
+		logCloseError(ctx, cerr)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	fields := map[string]interface{}{
+		"method":     req.Method,
+		"url":        req.URL.String(),
+		"status":     resp.StatusCode,
+		"latency_ms": latency.Milliseconds(),
+		"headers":    redactHeaders(resp.Header),
+		"body":       redactBody(resp.Header.Get("Content-Type"), body),
+	}
+	if err != nil {
+		fields["error"] = err
+		tflog.Debug(ctx, "Failed to read AWX API response", fields)
+		return err
+	}
+	tflog.Debug(ctx, "Received AWX API response", fields)
+	return nil
+}
+
+// logRequestError writes a request that failed before AWX could answer to the debug logs.
+func logRequestError(ctx context.Context, req *http.Request, latency time.Duration, err error) {
+	tflog.Debug(ctx, "AWX API request failed", map[string]interface{}{
+		"method":     req.Method,
+		"url":        req.URL.String(),
+		"latency_ms": latency.Milliseconds(),
+		"error":      err,
+	})
+}
+
+// logCloseError reports a failure to close a response body.
+func logCloseError(ctx context.Context, err error) {
+	tflog.Warn(ctx, "Unable to close AWX API response body", map[string]interface{}{
+		"error": err,
+	})
+}
@@ -0,0 +1,187 @@
+package awx_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/goawx/awxtest"
+)
+
+func TestRedactHeaders(t *testing.T) {
+	headers := http.Header{
+		"Authorization": {"Bearer glpat-secret"},
+		"Cookie":        {"awx_sessionid=session-secret; csrftoken=csrf-secret"},
+		"Set-Cookie":    {"awx_sessionid=session-secret; Path=/"},
+		"X-Csrftoken":   {"csrf-secret"},
+		"Content-Type":  {"application/json"},
+		"Accept":        {"application/json", "text/plain"},
+	}
+	got := awx.RedactHeaders(headers)
+	want := map[string]string{
+		"Authorization": "***",
+		"Cookie":        "***",
+		"Set-Cookie":    "***",
+		"X-Csrftoken":   "***",
+		"Content-Type":  "application/json",
+		"Accept":        "application/json, text/plain",
+	}
+	for name, value := range want {
+		if got[name] != value {
+			t.Errorf("Expecting header %s to be logged as %q but got %q", name, value, got[name])
+		}
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	cases := []struct {
+		name        string
+		contentType string
+		body        string
+		secrets     []string
+		keep        []string
+	}{
+		{
+			name:        "password",
+			contentType: "application/json",
+			body:        `{"username": "admin", "password": "s3cret", "new_password": "n3w"}`,
+			secrets:     []string{"s3cret", "n3w"},
+			keep:        []string{`"username":"admin"`},
+		},
+		{
+			name:        "token",
+			contentType: "application/json",
+			body:        `{"id": 4, "token": "tok3n", "refresh_token": "r3fresh", "scope": "write"}`,
+			secrets:     []string{"tok3n", "r3fresh"},
+			keep:        []string{`"scope":"write"`, `"id":4`},
+		},
+		{
+			name:        "credential inputs",
+			contentType: "application/json",
+			body:        `{"name": "deploy", "inputs": {"username": "deploy-user", "api_url": "https://vault.example.com", "empty": ""}}`,
+			secrets:     []string{"deploy-user", "vault.example.com"},
+			keep:        []string{`"name":"deploy"`, `"empty":""`},
+		},
+		{
+			name:        "nested list",
+			contentType: "application/json",
+			body:        `{"results": [{"name": "ssh", "ssh_key_data": "-----BEGIN KEY-----", "webhook_key": "h00k"}]}`,
+			secrets:     []string{"BEGIN KEY", "h00k"},
+			keep:        []string{`"name":"ssh"`},
+		},
+		{
+			name:        "form",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "username=admin&password=s3cret&next=%2Fapi%2F",
+			secrets:     []string{"s3cret"},
+			keep:        []string{"username=admin"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := awx.RedactBody(tc.contentType, []byte(tc.body))
+			for _, secret := range tc.secrets {
+				if strings.Contains(got, secret) {
+					t.Errorf("Expecting %q to be masked in %s", secret, got)
+				}
+			}
+			for _, keep := range tc.keep {
+				if !strings.Contains(got, keep) {
+					t.Errorf("Expecting %q to be logged in %s", keep, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRedactBody_Truncated(t *testing.T) {
+	cases := []struct {
+		name      string
+		body      string
+		truncated bool
+	}{
+		{name: "small", body: strings.Repeat("a", 100)},
+		{name: "limit", body: strings.Repeat("a", awx.MaxLoggedBodySize)},
+		{name: "large text", body: strings.Repeat("a", awx.MaxLoggedBodySize+1), truncated: true},
+		{name: "large JSON", body: `{"stdout": "` + strings.Repeat("a", 2*awx.MaxLoggedBodySize) + `", "password": "s3cret"}`, truncated: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := awx.RedactBody("application/json", []byte(tc.body))
+			if !tc.truncated {
+				if got != tc.body {
+					t.Errorf("Expecting the body to be logged whole, got %d bytes", len(got))
+				}
+				return
+			}
+			if !strings.HasSuffix(got, "... (truncated)") || len(got) != awx.MaxLoggedBodySize+len("... (truncated)") {
+				t.Errorf("Expecting the body to be truncated to %d bytes, got %d bytes ending with %q",
+					awx.MaxLoggedBodySize, len(got), got[len(got)-20:])
+			}
+			if strings.Contains(got, "s3cret") {
+				t.Error("Expecting the password to be masked before truncation")
+			}
+		})
+	}
+}
+
+// TestRequestLogging checks that the debug logs of a session never carry the credentials sent to or
+// returned by AWX.
+func TestRequestLogging(t *testing.T) {
+	srv := awxtest.NewServer(awxtest.WithCredentials("admin", "adm1n-s3cret"))
+	defer srv.Close()
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	client, err := awx.NewAWX(ctx, srv.URL, srv.Username, srv.Password, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := client.TokenService.CreateToken(ctx, map[string]interface{}{"scope": "write"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CredentialsService.CreateCredentials(ctx, map[string]interface{}{
+		"name":            "deploy",
+		"organization":    1,
+		"credential_type": 1,
+		"inputs":          map[string]interface{}{"username": "deploy-user", "password": "machine-s3cret"},
+	}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) == 0 {
+		t.Fatal("Expecting the requests to be logged")
+	}
+	logged, err := json.Marshal(entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{srv.Password, token.Token, "deploy-user", "machine-s3cret"} {
+		if strings.Contains(string(logged), secret) {
+			t.Errorf("Expecting %q never to be logged", secret)
+		}
+	}
+	authorized := false
+	for _, entry := range entries {
+		headers, _ := entry["headers"].(map[string]interface{})
+		if value, ok := headers["Authorization"]; ok {
+			authorized = true
+			if value != "***" {
+				t.Errorf("Expecting the Authorization header to be masked, got %v", value)
+			}
+		}
+	}
+	if !authorized {
+		t.Error("Expecting the masked Authorization header to be logged")
+	}
+}
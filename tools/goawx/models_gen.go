@@ -0,0 +1,118 @@
+// Code generated by awxgen from the AWX OPTIONS metadata in metadata/. DO NOT EDIT.
+
+package awx
+
+import "time"
+
+// ExecutionEnvironment represents the awx api execution environment.
+type ExecutionEnvironment struct {
+	ID            int       `json:"id"`
+	Type          string    `json:"type"`
+	URL           string    `json:"url"`
+	Related       *Related  `json:"related"`
+	SummaryFields *Summary  `json:"summary_fields"`
+	Created       time.Time `json:"created"`
+	Modified      time.Time `json:"modified"`
+	Name          string    `json:"name"`
+	Description   string    `json:"description"`
+	Organization  int       `json:"organization"`
+	Image         string    `json:"image"`
+	Managed       bool      `json:"managed"`
+	Credential    int       `json:"credential"`
+	Pull          string    `json:"pull"`
+}
+
+// InstanceGroup represents the awx api instance group.
+type InstanceGroup struct {
+	ID                       int         `json:"id"`
+	Type                     string      `json:"type"`
+	URL                      string      `json:"url"`
+	Related                  *Related    `json:"related"`
+	Name                     string      `json:"name"`
+	Created                  time.Time   `json:"created"`
+	Modified                 time.Time   `json:"modified"`
+	Capacity                 int         `json:"capacity"`
+	ConsumedCapacity         float64     `json:"consumed_capacity"`
+	PercentCapacityRemaining float64     `json:"percent_capacity_remaining"`
+	JobsRunning              int         `json:"jobs_running"`
+	MaxConcurrentJobs        int         `json:"max_concurrent_jobs"`
+	MaxForks                 int         `json:"max_forks"`
+	JobsTotal                int         `json:"jobs_total"`
+	Instances                int         `json:"instances"`
+	IsContainerGroup         bool        `json:"is_container_group"`
+	CredentialID             int         `json:"credential"`
+	PolicyInstancePercentage int         `json:"policy_instance_percentage"`
+	PolicyInstanceMinimum    int         `json:"policy_instance_minimum"`
+	PolicyInstanceList       interface{} `json:"policy_instance_list"`
+	PodSpecOverride          string      `json:"pod_spec_override"`
+	SummaryFields            *Summary    `json:"summary_fields"`
+}
+
+// Schedule represents the awx api schedule.
+type Schedule struct {
+	ID                   int                    `json:"id"`
+	Type                 string                 `json:"type"`
+	URL                  string                 `json:"url"`
+	Related              *Related               `json:"related"`
+	SummaryFields        *Summary               `json:"summary_fields"`
+	Created              time.Time              `json:"created"`
+	Modified             time.Time              `json:"modified"`
+	Name                 string                 `json:"name"`
+	Description          string                 `json:"description"`
+	ExtraData            map[string]interface{} `json:"extra_data"`
+	Inventory            int                    `json:"inventory"`
+	ScmBranch            string                 `json:"scm_branch"`
+	JobType              string                 `json:"job_type"`
+	JobTags              string                 `json:"job_tags"`
+	SkipTags             string                 `json:"skip_tags"`
+	Limit                string                 `json:"limit"`
+	DiffMode             bool                   `json:"diff_mode"`
+	Verbosity            int                    `json:"verbosity"`
+	ExecutionEnvironment int                    `json:"execution_environment"`
+	Forks                int                    `json:"forks"`
+	JobSliceCount        int                    `json:"job_slice_count"`
+	Timeout              int                    `json:"timeout"`
+	UnifiedJobTemplate   int                    `json:"unified_job_template"`
+	Enabled              bool                   `json:"enabled"`
+	Dtstart              time.Time              `json:"dtstart"`
+	Dtend                time.Time              `json:"dtend"`
+	Rrule                string                 `json:"rrule"`
+	NextRun              time.Time              `json:"next_run"`
+	Timezone             string                 `json:"timezone"`
+	Until                string                 `json:"until"`
+}
+
+// WorkflowJobTemplate represents the awx api workflow job template.
+type WorkflowJobTemplate struct {
+	ID                   int         `json:"id"`
+	Type                 string      `json:"type"`
+	URL                  string      `json:"url"`
+	Related              *Related    `json:"related"`
+	SummaryFields        *Summary    `json:"summary_fields"`
+	Created              time.Time   `json:"created"`
+	Modified             time.Time   `json:"modified"`
+	Name                 string      `json:"name"`
+	Description          string      `json:"description"`
+	LastJobRun           interface{} `json:"last_job_run"`
+	LastJobFailed        bool        `json:"last_job_failed"`
+	NextJobRun           interface{} `json:"next_job_run"`
+	Status               string      `json:"status"`
+	ExtraVars            string      `json:"extra_vars"`
+	Organization         int         `json:"organization"`
+	SurveyEnabled        bool        `json:"survey_enabled"`
+	AllowSimultaneous    bool        `json:"allow_simultaneous"`
+	AskVariablesOnLaunch bool        `json:"ask_variables_on_launch"`
+	Inventory            *int        `json:"inventory"`
+	Limit                interface{} `json:"limit"`
+	ScmBranch            interface{} `json:"scm_branch"`
+	AskInventoryOnLaunch bool        `json:"ask_inventory_on_launch"`
+	AskScmBranchOnLaunch bool        `json:"ask_scm_branch_on_launch"`
+	AskLimitOnLaunch     bool        `json:"ask_limit_on_launch"`
+	WebhookService       string      `json:"webhook_service"`
+	WebhookCredential    interface{} `json:"webhook_credential"`
+	AskLabelsOnLaunch    bool        `json:"ask_labels_on_launch"`
+	AskSkipTagsOnLaunch  bool        `json:"ask_skip_tags_on_launch"`
+	AskTagsOnLaunch      bool        `json:"ask_tags_on_launch"`
+	SkipTags             string      `json:"skip_tags"`
+	JobTags              string      `json:"job_tags"`
+}
@@ -0,0 +1,90 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Named URLs identify an AWX object by its name and by the names of the objects it belongs to,
+// e.g. the job template "Deploy" of the organization "Default" is /api/v2/job_templates/Deploy++Default/.
+// The identifying fields of a single object, such as the name and kind of a credential type, are joined
+// with namedURLFieldSeparator, and the objects with namedURLSeparator.
+const (
+	namedURLSeparator      = "++"
+	namedURLFieldSeparator = "+"
+	// namedURLEscapedPlus stands for a literal '+' in a name.
+	namedURLEscapedPlus = "[+]"
+)
+
+// NamedURLService fetches awx objects by named URL.
+type NamedURLService struct {
+	client *Client
+}
+
+// NamedURL returns the named URL identifier of an object, from its identifying fields followed by
+// the identifiers of the objects it belongs to, outermost last. Each argument is the list of
+// identifying fields of one object, e.g.
+//
+//	NamedURL([]string{"Deploy"}, []string{"Default"}) == "Deploy++Default"
+//	NamedURL([]string{"vault"}, []string{"Vault", "cloud"}, nil) == "vault++Vault+cloud++"
+//
+// An empty object stands for an unset foreign key, such as a credential without organization.
+func NamedURL(objects ...[]string) string {
+	components := make([]string, len(objects))
+	for i, fields := range objects {
+		escaped := make([]string, len(fields))
+		for j, field := range fields {
+			escaped[j] = strings.ReplaceAll(field, namedURLFieldSeparator, namedURLEscapedPlus)
+		}
+		components[i] = strings.Join(escaped, namedURLFieldSeparator)
+	}
+	return strings.Join(components, namedURLSeparator)
+}
+
+// namedURLEndpoint returns the endpoint of the object of collection, e.g. "job_templates", identified by namedURL.
+func namedURLEndpoint(collection, namedURL string) (string, error) {
+	if collection == "" || strings.Contains(collection, "/") {
+		return "", fmt.Errorf("invalid AWX collection %q", collection)
+	}
+	if namedURL == "" {
+		return "", fmt.Errorf("empty named URL for %s", collection)
+	}
+	return fmt.Sprintf("%s%s/%s/", DefaultAPIBasePath, collection, url.PathEscape(namedURL)), nil
+}
+
+// Get fetches the object of collection, e.g. "job_templates", identified by namedURL into result.
+func (n *NamedURLService) Get(ctx context.Context, collection, namedURL string, result interface{}) error {
+	endpoint, err := namedURLEndpoint(collection, namedURL)
+	if err != nil {
+		return err
+	}
+	resp, err := n.client.Requester.GetJSON(ctx, endpoint, result, map[string]string{})
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				logCloseError(ctx, err)
+			}
+		}()
+	}
+	if err != nil {
+		return err
+	}
+
+	return CheckResponse(resp)
+}
+
+// ResolveID returns the ID of the object of collection, e.g. "job_templates", identified by namedURL.
+func (n *NamedURLService) ResolveID(ctx context.Context, collection, namedURL string) (int, error) {
+	result := struct {
+		ID int `json:"id"`
+	}{}
+	if err := n.Get(ctx, collection, namedURL, &result); err != nil {
+		return 0, err
+	}
+	if result.ID == 0 {
+		return 0, fmt.Errorf("the %s named URL %q did not resolve to an object", collection, namedURL)
+	}
+	return result.ID, nil
+}
@@ -2,6 +2,7 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -20,36 +21,24 @@ type ListNotificationTemplatesResponse struct {
 const notificationTemplatesAPIEndpoint = "/api/v2/notification_templates/"
 
 // List shows list of awx notification_templates.
-func (s *NotificationTemplatesService) List(params map[string]string) ([]*NotificationTemplate, *ListNotificationTemplatesResponse, error) {
-	result := new(ListNotificationTemplatesResponse)
-	resp, err := s.client.Requester.GetJSON(notificationTemplatesAPIEndpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
+func (s *NotificationTemplatesService) List(ctx context.Context, params map[string]string) ([]*NotificationTemplate, *ListNotificationTemplatesResponse, error) {
+	results, pagination, err := listAll[*NotificationTemplate](ctx, s.client.Requester, notificationTemplatesAPIEndpoint, params)
 	if err != nil {
-		return nil, result, err
+		return nil, nil, err
 	}
 
-	if err := CheckResponse(resp); err != nil {
-		return nil, result, err
-	}
-
-	return result.Results, result, nil
+	return results, &ListNotificationTemplatesResponse{Pagination: pagination, Results: results}, nil
 }
 
 // GetByID shows the details of a notification_template.
-func (s *NotificationTemplatesService) GetByID(id int, params map[string]string) (*NotificationTemplate, error) {
+func (s *NotificationTemplatesService) GetByID(ctx context.Context, id int, params map[string]string) (*NotificationTemplate, error) {
 	result := new(NotificationTemplate)
 	endpoint := fmt.Sprintf("%s%d/", notificationTemplatesAPIEndpoint, id)
-	resp, err := s.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := s.client.Requester.GetJSON(ctx, endpoint, result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -65,7 +54,7 @@ func (s *NotificationTemplatesService) GetByID(id int, params map[string]string)
 }
 
 // Create creates an awx notification_template.
-func (s *NotificationTemplatesService) Create(data map[string]interface{}, params map[string]string) (*NotificationTemplate, error) {
+func (s *NotificationTemplatesService) Create(ctx context.Context, data map[string]interface{}, params map[string]string) (*NotificationTemplate, error) {
 	mandatoryFields = []string{"name", "organization", "notification_type"}
 	validate, status := ValidateParams(data, mandatoryFields)
 	if !status {
@@ -79,11 +68,11 @@ func (s *NotificationTemplatesService) Create(data map[string]interface{}, param
 		return nil, err
 	}
 
-	resp, err := s.client.Requester.PostJSON(notificationTemplatesAPIEndpoint, bytes.NewReader(payload), result, params)
+	resp, err := s.client.Requester.PostJSON(ctx, notificationTemplatesAPIEndpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -98,19 +87,28 @@ func (s *NotificationTemplatesService) Create(data map[string]interface{}, param
 	return result, nil
 }
 
+// CreateFromRequest is Create with a typed NotificationTemplateRequest payload.
+func (s *NotificationTemplatesService) CreateFromRequest(ctx context.Context, req *NotificationTemplateRequest, params map[string]string) (*NotificationTemplate, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return s.Create(ctx, data, params)
+}
+
 // Update update an awx notification_template.
-func (s *NotificationTemplatesService) Update(id int, data map[string]interface{}, _ map[string]string) (*NotificationTemplate, error) {
+func (s *NotificationTemplatesService) Update(ctx context.Context, id int, data map[string]interface{}, _ map[string]string) (*NotificationTemplate, error) {
 	result := new(NotificationTemplate)
 	endpoint := fmt.Sprintf("%s%d", notificationTemplatesAPIEndpoint, id)
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := s.client.Requester.PatchJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := s.client.Requester.PatchJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -125,16 +123,25 @@ func (s *NotificationTemplatesService) Update(id int, data map[string]interface{
 	return result, nil
 }
 
+// UpdateFromRequest is Update with a typed NotificationTemplateRequest payload.
+func (s *NotificationTemplatesService) UpdateFromRequest(ctx context.Context, id int, req *NotificationTemplateRequest, params map[string]string) (*NotificationTemplate, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return s.Update(ctx, id, data, params)
+}
+
 // Delete delete an awx notification_template.
-func (s *NotificationTemplatesService) Delete(id int) (*NotificationTemplate, error) {
+func (s *NotificationTemplatesService) Delete(ctx context.Context, id int) (*NotificationTemplate, error) {
 	result := new(NotificationTemplate)
 	endpoint := fmt.Sprintf("%s%d", notificationTemplatesAPIEndpoint, id)
 
-	resp, err := s.client.Requester.Delete(endpoint, result, nil)
+	resp, err := s.client.Requester.Delete(ctx, endpoint, result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -2,9 +2,9 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
 )
 
 // OrganizationsService implements awx organizations apis.
@@ -21,8 +21,8 @@ type ListOrganizationsResponse struct {
 const organizationsAPIEndpoint = "/api/v2/organizations/"
 
 // ListOrganizations shows list of awx organizations.
-func (p *OrganizationsService) ListOrganizations(params map[string]string) ([]*Organization, error) {
-	results, err := p.getAllPages(organizationsAPIEndpoint, params)
+func (p *OrganizationsService) ListOrganizations(ctx context.Context, params map[string]string) ([]*Organization, error) {
+	results, _, err := listAll[*Organization](ctx, p.client.Requester, organizationsAPIEndpoint, params)
 	if err != nil {
 		return nil, err
 	}
@@ -30,14 +30,14 @@ func (p *OrganizationsService) ListOrganizations(params map[string]string) ([]*O
 }
 
 // GetOrganizationsByID shows the details of a Organization.
-func (p *OrganizationsService) GetOrganizationsByID(id int, params map[string]string) (*Organization, error) {
+func (p *OrganizationsService) GetOrganizationsByID(ctx context.Context, id int, params map[string]string) (*Organization, error) {
 	result := new(Organization)
 	endpoint := fmt.Sprintf("%s%d/", organizationsAPIEndpoint, id)
-	resp, err := p.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := p.client.Requester.GetJSON(ctx, endpoint, result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -53,7 +53,7 @@ func (p *OrganizationsService) GetOrganizationsByID(id int, params map[string]st
 }
 
 // CreateOrganization creates an awx Organization.
-func (p *OrganizationsService) CreateOrganization(data map[string]interface{}, params map[string]string) (*Organization, error) {
+func (p *OrganizationsService) CreateOrganization(ctx context.Context, data map[string]interface{}, params map[string]string) (*Organization, error) {
 	mandatoryFields = []string{"name"}
 	validate, status := ValidateParams(data, mandatoryFields)
 
@@ -67,11 +67,11 @@ func (p *OrganizationsService) CreateOrganization(data map[string]interface{}, p
 	if err != nil {
 		return nil, err
 	}
-	resp, err := p.client.Requester.PostJSON(organizationsAPIEndpoint, bytes.NewReader(payload), result, params)
+	resp, err := p.client.Requester.PostJSON(ctx, organizationsAPIEndpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -86,19 +86,28 @@ func (p *OrganizationsService) CreateOrganization(data map[string]interface{}, p
 	return result, nil
 }
 
+// CreateOrganizationFromRequest is CreateOrganization with a typed OrganizationRequest payload.
+func (p *OrganizationsService) CreateOrganizationFromRequest(ctx context.Context, req *OrganizationRequest, params map[string]string) (*Organization, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return p.CreateOrganization(ctx, data, params)
+}
+
 // UpdateOrganization update an awx Organization.
-func (p *OrganizationsService) UpdateOrganization(id int, data map[string]interface{}, _ map[string]string) (*Organization, error) {
+func (p *OrganizationsService) UpdateOrganization(ctx context.Context, id int, data map[string]interface{}, _ map[string]string) (*Organization, error) {
 	result := new(Organization)
 	endpoint := fmt.Sprintf("%s%d", organizationsAPIEndpoint, id)
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := p.client.Requester.PatchJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := p.client.Requester.PatchJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -113,16 +122,25 @@ func (p *OrganizationsService) UpdateOrganization(id int, data map[string]interf
 	return result, nil
 }
 
+// UpdateOrganizationFromRequest is UpdateOrganization with a typed OrganizationRequest payload.
+func (p *OrganizationsService) UpdateOrganizationFromRequest(ctx context.Context, id int, req *OrganizationRequest, params map[string]string) (*Organization, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return p.UpdateOrganization(ctx, id, data, params)
+}
+
 // DeleteOrganization delete an awx Organization.
-func (p *OrganizationsService) DeleteOrganization(id int) (*Organization, error) {
+func (p *OrganizationsService) DeleteOrganization(ctx context.Context, id int) (*Organization, error) {
 	result := new(Organization)
 	endpoint := fmt.Sprintf("%s%d", organizationsAPIEndpoint, id)
 
-	resp, err := p.client.Requester.Delete(endpoint, result, nil)
+	resp, err := p.client.Requester.Delete(ctx, endpoint, result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -138,7 +156,7 @@ func (p *OrganizationsService) DeleteOrganization(id int) (*Organization, error)
 }
 
 // associate associate an element to an organization.
-func (p *OrganizationsService) associate(id int, typ string, data map[string]interface{}, _ map[string]string) (*Organization, error) {
+func (p *OrganizationsService) associate(ctx context.Context, id int, typ string, data map[string]interface{}, _ map[string]string) (*Organization, error) {
 	result := new(Organization)
 
 	endpoint := fmt.Sprintf("%s%d/%s/", organizationsAPIEndpoint, id, typ)
@@ -153,11 +171,11 @@ func (p *OrganizationsService) associate(id int, typ string, data map[string]int
 	if err != nil {
 		return nil, err
 	}
-	resp, err := p.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := p.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -173,7 +191,7 @@ func (p *OrganizationsService) associate(id int, typ string, data map[string]int
 }
 
 // disAssociate remove element from an organization
-func (p *OrganizationsService) disAssociate(id int, typ string, data map[string]interface{}, _ map[string]string) (*Organization, error) {
+func (p *OrganizationsService) disAssociate(ctx context.Context, id int, typ string, data map[string]interface{}, _ map[string]string) (*Organization, error) {
 	result := new(Organization)
 	endpoint := fmt.Sprintf("%s%d/%s/", organizationsAPIEndpoint, id, typ)
 	data["disassociate"] = true
@@ -187,11 +205,11 @@ func (p *OrganizationsService) disAssociate(id int, typ string, data map[string]
 	if err != nil {
 		return nil, err
 	}
-	resp, err := p.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := p.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -207,70 +225,21 @@ func (p *OrganizationsService) disAssociate(id int, typ string, data map[string]
 }
 
 // DisAssociateGalaxyCredentials remove Credentials form an awx job template.
-func (p *OrganizationsService) DisAssociateGalaxyCredentials(id int, data map[string]interface{}, params map[string]string) (*Organization, error) {
-	return p.disAssociate(id, "galaxy_credentials", data, params)
+func (p *OrganizationsService) DisAssociateGalaxyCredentials(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Organization, error) {
+	return p.disAssociate(ctx, id, "galaxy_credentials", data, params)
 }
 
 // AssociateGalaxyCredentials adding credentials to Organization.
-func (p *OrganizationsService) AssociateGalaxyCredentials(id int, data map[string]interface{}, params map[string]string) (*Organization, error) {
-	return p.associate(id, "galaxy_credentials", data, params)
+func (p *OrganizationsService) AssociateGalaxyCredentials(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Organization, error) {
+	return p.associate(ctx, id, "galaxy_credentials", data, params)
 }
 
 // DisAssociateInstanceGroups remove Instance Groups from an organization
-func (p *OrganizationsService) DisAssociateInstanceGroups(id int, data map[string]interface{}, params map[string]string) (*Organization, error) {
-	return p.disAssociate(id, "instance_groups", data, params)
+func (p *OrganizationsService) DisAssociateInstanceGroups(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Organization, error) {
+	return p.disAssociate(ctx, id, "instance_groups", data, params)
 }
 
 // AssociateGalaxyCredentials adding Instance Groups to Organization.
-func (p *OrganizationsService) AssociateInstanceGroups(id int, data map[string]interface{}, params map[string]string) (*Organization, error) {
-	return p.associate(id, "instance_groups", data, params)
-}
-
-// Must be replaced by a generic function.
-// But upgrade to version go 1.18 before.
-func (p *OrganizationsService) getAllPages(firstURL string, params map[string]string) ([]*Organization, error) {
-	results := make([]*Organization, 0)
-	nextURL := firstURL
-	for {
-		nextURLParsed, err := url.Parse(nextURL)
-		if err != nil {
-			return nil, err
-		}
-
-		nextURLQueryParams := make(map[string]string)
-		for paramName, paramValues := range nextURLParsed.Query() {
-			if len(paramValues) > 0 {
-				nextURLQueryParams[paramName] = paramValues[0]
-			}
-		}
-
-		for paramName, paramValue := range params {
-			nextURLQueryParams[paramName] = paramValue
-		}
-
-		result := new(ListOrganizationsResponse)
-		resp, err := p.client.Requester.GetJSON(nextURLParsed.Path, result, nextURLQueryParams)
-		if resp != nil {
-			func() {
-				if err := resp.Body.Close(); err != nil {
-					fmt.Println(err)
-				}
-			}()
-		}
-		if err != nil {
-			return nil, err
-		}
-
-		if err := CheckResponse(resp); err != nil {
-			return nil, err
-		}
-
-		results = append(results, result.Results...)
-
-		if result.Next == nil || result.Next.(string) == "" {
-			break
-		}
-		nextURL = result.Next.(string)
-	}
-	return results, nil
+func (p *OrganizationsService) AssociateInstanceGroups(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Organization, error) {
+	return p.associate(ctx, id, "instance_groups", data, params)
 }
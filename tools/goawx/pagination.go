@@ -0,0 +1,173 @@
+package awx
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// DefaultPageSize is the page_size requested from list endpoints when the caller does not set one.
+// It matches the maximum page size accepted by AWX by default (MAX_PAGE_SIZE).
+const DefaultPageSize = 200
+
+// Page represents a single page returned by an AWX list endpoint.
+type Page[T any] struct {
+	Pagination
+	Results []T `json:"results"`
+}
+
+// Paginator lazily walks the pages of an AWX list endpoint, following the `next` links.
+//
+// Items can be consumed one by one:
+//
+//	p := NewPaginator[*Host](requester, "/api/v2/hosts/", params)
+//	for p.Next(ctx) {
+//		host := p.Value()
+//	}
+//	if err := p.Err(); err != nil {
+//		...
+//	}
+//
+// or page by page with NextPage, or all at once with All.
+type Paginator[T any] struct {
+	requester *Requester
+	nextURL   string
+	params    map[string]string
+	started   bool
+	count     int
+
+	buffer []T
+	value  T
+	err    error
+}
+
+// NewPaginator news a Paginator for endpoint. params are sent with the first request only,
+// AWX carries them over in the `next` link of the following pages.
+func NewPaginator[T any](requester *Requester, endpoint string, params map[string]string) *Paginator[T] {
+	query := make(map[string]string, len(params)+1)
+	for k, v := range params {
+		query[k] = v
+	}
+	if _, ok := query["page_size"]; !ok {
+		query["page_size"] = strconv.Itoa(DefaultPageSize)
+	}
+	return &Paginator[T]{requester: requester, nextURL: endpoint, params: query}
+}
+
+// SetPageSize overrides the number of items requested per page.
+func (p *Paginator[T]) SetPageSize(size int) *Paginator[T] {
+	p.params["page_size"] = strconv.Itoa(size)
+	return p
+}
+
+// HasMorePages reports whether another page can be fetched.
+func (p *Paginator[T]) HasMorePages() bool {
+	return p.err == nil && (!p.started || p.nextURL != "")
+}
+
+// Count returns the total number of items reported by AWX, once the first page is fetched.
+func (p *Paginator[T]) Count() int {
+	return p.count
+}
+
+// NextPage fetches and returns the next page of results.
+func (p *Paginator[T]) NextPage(ctx context.Context) ([]T, error) {
+	if !p.HasMorePages() {
+		return nil, p.err
+	}
+
+	nextURLParsed, err := url.Parse(p.nextURL)
+	if err != nil {
+		p.err = err
+		return nil, err
+	}
+
+	query := make(map[string]string)
+	if !p.started {
+		for k, v := range p.params {
+			query[k] = v
+		}
+	}
+	for paramName, paramValues := range nextURLParsed.Query() {
+		if len(paramValues) > 0 {
+			query[paramName] = paramValues[0]
+		}
+	}
+
+	result := new(Page[T])
+	resp, err := p.requester.GetJSON(ctx, nextURLParsed.Path, result, query)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				logCloseError(ctx, err)
+			}
+		}()
+	}
+	if err == nil {
+		err = CheckResponse(resp)
+	}
+	if err != nil {
+		p.err = err
+		return nil, err
+	}
+
+	p.started = true
+	p.count = result.Count
+	p.nextURL = ""
+	if next, ok := result.Next.(string); ok {
+		p.nextURL = next
+	}
+	return result.Results, nil
+}
+
+// Next advances to the next item, fetching a new page when needed. It returns false
+// when all items were consumed or an error occurred, see Err.
+func (p *Paginator[T]) Next(ctx context.Context) bool {
+	for len(p.buffer) == 0 {
+		if !p.HasMorePages() {
+			return false
+		}
+		results, err := p.NextPage(ctx)
+		if err != nil {
+			return false
+		}
+		p.buffer = results
+	}
+	p.value, p.buffer = p.buffer[0], p.buffer[1:]
+	return true
+}
+
+// Value returns the current item, as selected by the last call to Next.
+func (p *Paginator[T]) Value() T {
+	return p.value
+}
+
+// Err returns the first error met while fetching pages.
+func (p *Paginator[T]) Err() error {
+	return p.err
+}
+
+// All fetches every remaining page and returns the concatenated results.
+func (p *Paginator[T]) All(ctx context.Context) ([]T, error) {
+	results := make([]T, 0)
+	results = append(results, p.buffer...)
+	p.buffer = nil
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, page...)
+	}
+	return results, nil
+}
+
+// listAll is a shortcut for NewPaginator(...).All(ctx), also returning the total count.
+func listAll[T any](ctx context.Context, requester *Requester, endpoint string, params map[string]string) ([]T, Pagination, error) {
+	p := NewPaginator[T](requester, endpoint, params)
+	results, err := p.All(ctx)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+	return results, Pagination{Count: p.Count()}, nil
+}
@@ -0,0 +1,192 @@
+package awx_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+// queryRecorder records the query of the requests sent through it.
+type queryRecorder struct {
+	rt      http.RoundTripper
+	mu      sync.Mutex
+	queries []url.Values
+}
+
+func (q *queryRecorder) RoundTrip(r *http.Request) (*http.Response, error) {
+	q.mu.Lock()
+	q.queries = append(q.queries, r.URL.Query())
+	q.mu.Unlock()
+	return q.rt.RoundTrip(r)
+}
+
+// newPaginatedServer starts a server listing organizations organizations the way AWX pages them,
+// and returns a Requester recording the queries sent to it.
+func newPaginatedServer(t *testing.T, organizations int) (*awx.Requester, *queryRecorder) {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/api/v2/organizations/" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"detail": "Not found."}`))
+			return
+		}
+
+		query := r.URL.Query()
+		results := []map[string]interface{}{}
+		for id := 1; id <= organizations; id++ {
+			name := fmt.Sprintf("org-%03d", id)
+			if filter := query.Get("name"); filter != "" && filter != name {
+				continue
+			}
+			results = append(results, map[string]interface{}{"id": id, "name": name})
+		}
+		page, _ := strconv.Atoi(query.Get("page"))
+		page = max(page, 1)
+		size, _ := strconv.Atoi(query.Get("page_size"))
+		start, end := min((page-1)*size, len(results)), min(page*size, len(results))
+
+		var next interface{}
+		if end < len(results) {
+			query.Set("page", strconv.Itoa(page+1))
+			next = r.URL.Path + "?" + query.Encode()
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"count":    len(results),
+			"next":     next,
+			"previous": nil,
+			"results":  results[start:end],
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	recorder := &queryRecorder{rt: srv.Client().Transport}
+	return &awx.Requester{
+		Base:          srv.URL,
+		Authenticator: &awx.BasicAuth{},
+		Client:        &http.Client{Transport: recorder},
+	}, recorder
+}
+
+func TestPaginator_FollowsNext(t *testing.T) {
+	r, recorder := newPaginatedServer(t, 25)
+
+	p := awx.NewPaginator[*awx.Organization](r, "/api/v2/organizations/", map[string]string{"order_by": "id"}).SetPageSize(10)
+	var ids []int
+	for p.Next(context.Background()) {
+		ids = append(ids, p.Value().ID)
+	}
+	if err := p.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ids) != 25 || p.Count() != 25 {
+		t.Fatalf("Expecting 25 organizations but got %d, counted %d", len(ids), p.Count())
+	}
+	for i, id := range ids {
+		if id != i+1 {
+			t.Fatalf("Expecting the organizations in order, got %v", ids)
+		}
+	}
+	if len(recorder.queries) != 3 {
+		t.Fatalf("Expecting 3 pages to be fetched but got %d requests", len(recorder.queries))
+	}
+	for i, query := range recorder.queries {
+		page := query.Get("page")
+		if i > 0 && page != strconv.Itoa(i+1) {
+			t.Errorf("Expecting request %d to follow the next link to page %d, got %v", i+1, i+1, query)
+		}
+		// The parameters of the first request are carried over by the next links.
+		if query.Get("page_size") != "10" || query.Get("order_by") != "id" {
+			t.Errorf("Expecting request %d to keep the parameters, got %v", i+1, query)
+		}
+	}
+	if p.HasMorePages() || p.Next(context.Background()) {
+		t.Error("Expecting no more pages")
+	}
+}
+
+func TestPaginator_LastPage(t *testing.T) {
+	cases := []struct {
+		name          string
+		organizations int
+		params        map[string]string
+		want          int
+		wantRequests  int
+	}{
+		{name: "empty list", organizations: 1, params: map[string]string{"name": "missing"}, want: 0, wantRequests: 1},
+		{name: "full last page", organizations: 20, want: 20, wantRequests: 2},
+		{name: "partial last page", organizations: 21, want: 21, wantRequests: 3},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, recorder := newPaginatedServer(t, tc.organizations)
+
+			p := awx.NewPaginator[*awx.Organization](r, "/api/v2/organizations/", tc.params).SetPageSize(10)
+			results, err := p.All(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if results == nil || len(results) != tc.want || p.Count() != tc.want {
+				t.Errorf("Expecting %d organizations but got %v, counted %d", tc.want, results, p.Count())
+			}
+			// No request follows the last page, whether it is full or empty.
+			if len(recorder.queries) != tc.wantRequests {
+				t.Errorf("Expecting %d requests but got %d", tc.wantRequests, len(recorder.queries))
+			}
+			if p.HasMorePages() {
+				t.Error("Expecting no more pages")
+			}
+		})
+	}
+}
+
+func TestPaginator_DefaultPageSize(t *testing.T) {
+	r, recorder := newPaginatedServer(t, awx.DefaultPageSize+1)
+
+	results, err := awx.NewPaginator[*awx.Organization](r, "/api/v2/organizations/", nil).All(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != awx.DefaultPageSize+1 {
+		t.Errorf("Expecting %d organizations but got %d", awx.DefaultPageSize+1, len(results))
+	}
+	if len(recorder.queries) != 2 {
+		t.Fatalf("Expecting 2 pages of %d but got %d requests", awx.DefaultPageSize, len(recorder.queries))
+	}
+	if size := recorder.queries[0].Get("page_size"); size != strconv.Itoa(awx.DefaultPageSize) {
+		t.Errorf("Expecting the default page size %d but got %q", awx.DefaultPageSize, size)
+	}
+
+	// A page size set by the caller is kept.
+	recorder.queries = nil
+	if _, err := awx.NewPaginator[*awx.Organization](r, "/api/v2/organizations/", map[string]string{"page_size": "50"}).NextPage(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if size := recorder.queries[0].Get("page_size"); size != "50" {
+		t.Errorf("Expecting the page size 50 but got %q", size)
+	}
+}
+
+func TestPaginator_Error(t *testing.T) {
+	r, _ := newPaginatedServer(t, 1)
+
+	p := awx.NewPaginator[*awx.Organization](r, "/api/v2/unknown/", nil)
+	if p.Next(context.Background()) {
+		t.Fatal("Expecting no item from an unknown endpoint")
+	}
+	if !awx.IsNotFound(p.Err()) {
+		t.Errorf("Expecting a not found error but got %v", p.Err())
+	}
+	if p.HasMorePages() {
+		t.Error("Expecting no more pages after an error")
+	}
+}
@@ -1,6 +1,8 @@
 package awx
 
-import "fmt"
+import (
+	"context"
+)
 
 // PingService implements awx ping apis.
 type PingService struct {
@@ -10,13 +12,13 @@ type PingService struct {
 const pingAPIEndpoint = "/api/v2/ping/"
 
 // Ping do ping with awx servers.
-func (p *PingService) Ping() (*Ping, error) {
+func (p *PingService) Ping(ctx context.Context) (*Ping, error) {
 	result := new(Ping)
-	resp, err := p.client.Requester.GetJSON(pingAPIEndpoint, result, map[string]string{})
+	resp, err := p.client.Requester.GetJSON(ctx, pingAPIEndpoint, result, map[string]string{})
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -1,6 +1,7 @@
 package awx
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -12,14 +13,14 @@ type ProjectUpdatesService struct {
 const projectUpdatesAPIEndpoint = "/api/v2/project_updates/"
 
 // ProjectUpdateCancel cancel of awx projects update.
-func (p *ProjectUpdatesService) ProjectUpdateCancel(id int) (*ProjectUpdateCancel, error) {
+func (p *ProjectUpdatesService) ProjectUpdateCancel(ctx context.Context, id int) (*ProjectUpdateCancel, error) {
 	result := new(ProjectUpdateCancel)
 	endpoint := fmt.Sprintf("%s%d/cancel", projectUpdatesAPIEndpoint, id)
-	resp, err := p.client.Requester.GetJSON(endpoint, result, nil)
+	resp, err := p.client.Requester.GetJSON(ctx, endpoint, result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -34,14 +35,14 @@ func (p *ProjectUpdatesService) ProjectUpdateCancel(id int) (*ProjectUpdateCance
 }
 
 // ProjectUpdateGet get of awx projects update.
-func (p *ProjectUpdatesService) ProjectUpdateGet(id int) (*Job, error) {
+func (p *ProjectUpdatesService) ProjectUpdateGet(ctx context.Context, id int) (*Job, error) {
 	result := new(Job)
 	endpoint := fmt.Sprintf("%s%d", projectUpdatesAPIEndpoint, id)
-	resp, err := p.client.Requester.GetJSON(endpoint, result, nil)
+	resp, err := p.client.Requester.GetJSON(ctx, endpoint, result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
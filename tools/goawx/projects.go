@@ -2,6 +2,7 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -20,36 +21,24 @@ type ListProjectsResponse struct {
 const projectsAPIEndpoint = "/api/v2/projects/"
 
 // ListProjects shows list of awx projects.
-func (p *ProjectService) ListProjects(params map[string]string) ([]*Project, *ListProjectsResponse, error) {
-	result := new(ListProjectsResponse)
-	resp, err := p.client.Requester.GetJSON(projectsAPIEndpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
+func (p *ProjectService) ListProjects(ctx context.Context, params map[string]string) ([]*Project, *ListProjectsResponse, error) {
+	results, pagination, err := listAll[*Project](ctx, p.client.Requester, projectsAPIEndpoint, params)
 	if err != nil {
-		return nil, result, err
+		return nil, nil, err
 	}
 
-	if err := CheckResponse(resp); err != nil {
-		return nil, result, err
-	}
-
-	return result.Results, result, nil
+	return results, &ListProjectsResponse{Pagination: pagination, Results: results}, nil
 }
 
 // GetProjectByID shows the details of a project.
-func (p *ProjectService) GetProjectByID(id int, params map[string]string) (*Project, error) {
+func (p *ProjectService) GetProjectByID(ctx context.Context, id int, params map[string]string) (*Project, error) {
 	result := new(Project)
 	endpoint := fmt.Sprintf("%s%d/", projectsAPIEndpoint, id)
-	resp, err := p.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := p.client.Requester.GetJSON(ctx, endpoint, result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -65,7 +54,7 @@ func (p *ProjectService) GetProjectByID(id int, params map[string]string) (*Proj
 }
 
 // CreateProject creates an awx project.
-func (p *ProjectService) CreateProject(data map[string]interface{}, params map[string]string) (*Project, error) {
+func (p *ProjectService) CreateProject(ctx context.Context, data map[string]interface{}, params map[string]string) (*Project, error) {
 	mandatoryFields = []string{"name", "organization", "scm_type"}
 	validate, status := ValidateParams(data, mandatoryFields)
 
@@ -82,11 +71,11 @@ func (p *ProjectService) CreateProject(data map[string]interface{}, params map[s
 
 	// Add check if project exists and return proper error
 
-	resp, err := p.client.Requester.PostJSON(projectsAPIEndpoint, bytes.NewReader(payload), result, params)
+	resp, err := p.client.Requester.PostJSON(ctx, projectsAPIEndpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -101,19 +90,28 @@ func (p *ProjectService) CreateProject(data map[string]interface{}, params map[s
 	return result, nil
 }
 
+// CreateProjectFromRequest is CreateProject with a typed ProjectRequest payload.
+func (p *ProjectService) CreateProjectFromRequest(ctx context.Context, req *ProjectRequest, params map[string]string) (*Project, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return p.CreateProject(ctx, data, params)
+}
+
 // UpdateProject update an awx Project.
-func (p *ProjectService) UpdateProject(id int, data map[string]interface{}, _ map[string]string) (*Project, error) {
+func (p *ProjectService) UpdateProject(ctx context.Context, id int, data map[string]interface{}, _ map[string]string) (*Project, error) {
 	result := new(Project)
 	endpoint := fmt.Sprintf("%s%d", projectsAPIEndpoint, id)
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := p.client.Requester.PatchJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := p.client.Requester.PatchJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -128,16 +126,25 @@ func (p *ProjectService) UpdateProject(id int, data map[string]interface{}, _ ma
 	return result, nil
 }
 
+// UpdateProjectFromRequest is UpdateProject with a typed ProjectRequest payload.
+func (p *ProjectService) UpdateProjectFromRequest(ctx context.Context, id int, req *ProjectRequest, params map[string]string) (*Project, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return p.UpdateProject(ctx, id, data, params)
+}
+
 // DeleteProject delete an awx Project.
-func (p *ProjectService) DeleteProject(id int) (*Project, error) {
+func (p *ProjectService) DeleteProject(ctx context.Context, id int) (*Project, error) {
 	result := new(Project)
 	endpoint := fmt.Sprintf("%s%d", projectsAPIEndpoint, id)
 
-	resp, err := p.client.Requester.Delete(endpoint, result, nil)
+	resp, err := p.client.Requester.Delete(ctx, endpoint, result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
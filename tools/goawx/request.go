@@ -1,14 +1,18 @@
 package awx
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"io/ioutil" //nolint: staticcheck
 	"net/http"
+	"net/http/cookiejar"
 	"net/url"
 	"strings"
+	"sync"
+	"time"
 )
 
 // APIRequest represents the http api communication way.
@@ -58,6 +62,260 @@ func (ta *TokenAuth) addAuthenticationHeaders(r *http.Request) {
 	r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", ta.Token))
 }
 
+const tokensAPIEndpoint = "/api/v2/tokens/" //nolint:gosec
+
+// PersonalTokenAuth exchanges a username and password for a scoped OAuth2 personal access token,
+// issued by the platform gateway on AAP 2.5, and authenticates every following request with that token instead of basic auth.
+// The token is created by NewAWXWithAuthenticator and revoked by AWX.Close.
+type PersonalTokenAuth struct {
+	Username string
+	Password string
+	// Scope is either `read` or `write`.
+	Scope       string
+	Description string
+
+	mu      sync.RWMutex
+	token   string
+	tokenID int
+}
+
+func (pa *PersonalTokenAuth) addAuthenticationHeaders(r *http.Request) {
+	pa.mu.RLock()
+	defer pa.mu.RUnlock()
+	if pa.token == "" {
+		r.SetBasicAuth(pa.Username, pa.Password)
+		return
+	}
+	r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", pa.token))
+}
+
+func (pa *PersonalTokenAuth) login(ctx context.Context, r *Requester) error {
+	payload, err := json.Marshal(map[string]interface{}{
+		"description": pa.Description,
+		"application": nil,
+		"scope":       pa.Scope,
+	})
+	if err != nil {
+		return err
+	}
+
+	result := new(Token)
+	resp, err := r.PostJSON(ctx, r.tokensEndpoint(), bytes.NewReader(payload), result, nil)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				logCloseError(ctx, err)
+			}
+		}()
+	}
+	if err != nil {
+		return fmt.Errorf("unable to create personal access token: %w", err)
+	}
+	if err := CheckResponse(resp); err != nil {
+		return fmt.Errorf("unable to create personal access token: %w", err)
+	}
+
+	pa.mu.Lock()
+	defer pa.mu.Unlock()
+	pa.token = result.Token
+	pa.tokenID = result.ID
+	return nil
+}
+
+func (pa *PersonalTokenAuth) logout(ctx context.Context, r *Requester) error {
+	pa.mu.RLock()
+	tokenID := pa.tokenID
+	pa.mu.RUnlock()
+	if tokenID == 0 {
+		return nil
+	}
+
+	resp, err := r.Delete(ctx, fmt.Sprintf("%s%d/", r.tokensEndpoint(), tokenID), nil, nil)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				logCloseError(ctx, err)
+			}
+		}()
+	}
+	if err != nil {
+		return fmt.Errorf("unable to revoke personal access token %d: %w", tokenID, err)
+	}
+
+	pa.mu.Lock()
+	defer pa.mu.Unlock()
+	pa.token = ""
+	pa.tokenID = 0
+	return nil
+}
+
+const (
+	loginEndpoint  = "/api/login/"
+	logoutEndpoint = "/api/logout/"
+	csrfCookieName = "csrftoken"
+)
+
+// SessionAuth logs in through the AWX `/api/login/` form (the gateway login form on AAP 2.5), and authenticates every following
+// request with the session cookie kept in a cookie jar on the Requester client. It works on
+// deployments where basic auth is disabled (AUTH_BASIC_ENABLED=false). The session is opened
+// by NewAWXWithAuthenticator, renewed transparently when it expires, and closed by AWX.Close.
+type SessionAuth struct {
+	Username string
+	Password string
+
+	mu   sync.Mutex
+	jar  http.CookieJar
+	base *url.URL
+}
+
+func (sa *SessionAuth) addAuthenticationHeaders(r *http.Request) {
+	sa.mu.Lock()
+	defer sa.mu.Unlock()
+	if sa.jar == nil {
+		return
+	}
+	// Django rejects unsafe methods sent with a session cookie but without the CSRF token.
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+	default:
+		if token := sa.csrfToken(r.URL); token != "" {
+			r.Header.Set("X-CSRFToken", token)
+			r.Header.Set("Referer", sa.base.String())
+		}
+	}
+}
+
+func (sa *SessionAuth) csrfToken(u *url.URL) string {
+	for _, cookie := range sa.jar.Cookies(u) {
+		if cookie.Name == csrfCookieName {
+			return cookie.Value
+		}
+	}
+	return ""
+}
+
+func (sa *SessionAuth) hasSession(u *url.URL) bool {
+	for _, cookie := range sa.jar.Cookies(u) {
+		// AWX names the cookie `awx_sessionid`, older releases use the Django default `sessionid`.
+		if strings.HasSuffix(cookie.Name, "sessionid") && cookie.Value != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (sa *SessionAuth) login(ctx context.Context, r *Requester) error {
+	sa.mu.Lock()
+	defer sa.mu.Unlock()
+
+	login, _ := r.sessionEndpoints()
+	loginURL, err := url.Parse(r.Base + login)
+	if err != nil {
+		return err
+	}
+	sa.base = loginURL
+
+	if sa.jar == nil {
+		jar, err := cookiejar.New(nil)
+		if err != nil {
+			return err
+		}
+		sa.jar = jar
+	}
+	if r.Client.Jar != sa.jar {
+		// Never modify a client that may be shared, such as http.DefaultClient.
+		client := *r.Client
+		client.Jar = sa.jar
+		r.Client = &client
+	}
+
+	// The login form sets the CSRF cookie that must be sent back with the credentials.
+	if err := sa.send(ctx, r.Client, http.MethodGet, loginURL, nil, nil); err != nil {
+		return fmt.Errorf("unable to open AWX login session: %w", err)
+	}
+
+	form := url.Values{}
+	form.Set("username", sa.Username)
+	form.Set("password", sa.Password)
+	form.Set("next", "/api/")
+	headers := http.Header{}
+	headers.Set("Content-Type", "application/x-www-form-urlencoded")
+	headers.Set("X-CSRFToken", sa.csrfToken(loginURL))
+	headers.Set("Referer", loginURL.String())
+	if err := sa.send(ctx, r.Client, http.MethodPost, loginURL, strings.NewReader(form.Encode()), headers); err != nil {
+		return fmt.Errorf("unable to log in to AWX: %w", err)
+	}
+
+	if !sa.hasSession(loginURL) {
+		return fmt.Errorf("unable to log in to AWX as %s: no session cookie returned, check the username and password", sa.Username)
+	}
+	return nil
+}
+
+func (sa *SessionAuth) send(ctx context.Context, client *http.Client, method string, u *url.URL, body io.Reader, headers http.Header) error {
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return err
+	}
+	for k := range headers {
+		req.Header.Set(k, headers.Get(k))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			logCloseError(ctx, err)
+		}
+	}()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return newAPIError(resp, nil)
+	}
+	return nil
+}
+
+func (sa *SessionAuth) logout(ctx context.Context, r *Requester) error {
+	sa.mu.Lock()
+	defer sa.mu.Unlock()
+	if sa.jar == nil || sa.base == nil || !sa.hasSession(sa.base) {
+		return nil
+	}
+
+	_, logout := r.sessionEndpoints()
+	logoutURL, err := url.Parse(r.Base + logout)
+	if err != nil {
+		return err
+	}
+	if err := sa.send(ctx, r.Client, http.MethodGet, logoutURL, nil, nil); err != nil {
+		return fmt.Errorf("unable to close AWX session: %w", err)
+	}
+	return nil
+}
+
+// sessionExpired reports whether AWX refused the session cookie.
+func (sa *SessionAuth) sessionExpired(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusUnauthorized
+}
+
+// renewableAuthenticator is implemented by session authenticators that can log in again
+// when AWX reports that their session expired.
+type renewableAuthenticator interface {
+	sessionAuthenticator
+	sessionExpired(resp *http.Response) bool
+}
+
+// sessionAuthenticator is implemented by authenticators that must open a server-side
+// session (token, cookie) before the first request and release it when done.
+type sessionAuthenticator interface {
+	Authenticator
+	login(ctx context.Context, r *Requester) error
+	logout(ctx context.Context, r *Requester) error
+}
+
 // Requester implemented a base http client.
 // It supports do POST/GET via an human-readable way,
 // in other word, all data is in `application/json` format.
@@ -68,15 +326,28 @@ type Requester struct {
 	Base          string
 	Authenticator Authenticator
 	Client        *http.Client
+	Retry         RetryPolicy
+	// APIBasePath replaces the /api/v2/ prefix of every endpoint when set, see WithAPIBasePath.
+	APIBasePath string
+
+	// limiter bounds the concurrency and the rate of the requests, see WithRateLimit.
+	limiter *limiter
+	// cache keeps the responses to GET requests, see WithLookupCache.
+	cache *lookupCache
+	// deferred connects the client on its first request, see NewAWXDeferred.
+	deferred *deferredConnection
 }
 
 // Do : Performs the actual http request.
-func (r *Requester) Do(ar *APIRequest, responseStruct interface{}, options ...interface{}) (*http.Response, error) { //nolint:funlen
+func (r *Requester) Do(ctx context.Context, ar *APIRequest, responseStruct interface{}, options ...interface{}) (*http.Response, error) { //nolint:funlen
+	if err := r.deferred.ensure(ctx); err != nil {
+		return nil, fmt.Errorf("Do.Request: unable to connect to AWX: %w", err)
+	}
 	if !strings.HasSuffix(ar.Endpoint, "/") && ar.Method != "POST" {
 		ar.Endpoint += "/"
 	}
 
-	URL, err := url.Parse(r.Base + ar.Endpoint + ar.Suffix)
+	URL, err := url.Parse(r.Base + r.apiEndpoint(ar.Endpoint) + ar.Suffix)
 	if err != nil {
 		return nil, err
 	}
@@ -93,38 +364,87 @@ func (r *Requester) Do(ar *APIRequest, responseStruct interface{}, options ...in
 		}
 	}
 
-	var req *http.Request
-	req, err = http.NewRequest(ar.Method, URL.String(), ar.Payload)
-	if err != nil {
-		return nil, err
+	// Buffer the payload so that it can be replayed when the request is retried.
+	var body []byte
+	if ar.Payload != nil {
+		if body, err = io.ReadAll(ar.Payload); err != nil {
+			return nil, err
+		}
 	}
 
-	r.Authenticator.addAuthenticationHeaders(req)
-
-	for k := range ar.Headers {
-		req.Header.Add(k, ar.Headers.Get(k))
+	var response *http.Response
+	var generation uint64
+	if ar.Method == http.MethodGet {
+		response, generation = r.cache.get(ctx, URL)
+	} else {
+		// Invalidate whatever the outcome, a failed write may have been partially applied.
+		defer r.cache.invalidate(ctx, ar.Method, URL)
 	}
+	cached := response != nil
+	renewed := false
+	for attempt := 0; !cached; attempt++ {
+		var payload io.Reader
+		if body != nil {
+			payload = bytes.NewReader(body)
+		}
 
-	response, err := r.Client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("Do.Request: %v", err)
-	}
+		var req *http.Request
+		req, err = http.NewRequestWithContext(ctx, ar.Method, URL.String(), payload)
+		if err != nil {
+			return nil, err
+		}
+
+		r.Authenticator.addAuthenticationHeaders(req)
+
+		for k := range ar.Headers {
+			req.Header.Add(k, ar.Headers.Get(k))
+		}
 
-	//nolint:gomnd
-	if response.StatusCode == 400 { // Bad Request
-		var errorString string
+		release, queued, lerr := r.limiter.acquire(ctx)
+		if lerr != nil {
+			return nil, fmt.Errorf("Do.Request: %v", lerr)
+		}
+		logRequest(ctx, req, body, attempt, r.limiter.logFields(queued))
+		start := time.Now()
+		response, err = r.Client.Do(req)
+		if err != nil {
+			logRequestError(ctx, req, time.Since(start), err)
+		} else if err = bufferResponse(ctx, req, response, time.Since(start)); err != nil {
+			response = nil
+		}
+		release()
+		if ra, ok := r.Authenticator.(renewableAuthenticator); ok && err == nil && !renewed && ra.sessionExpired(response) {
+			// The session expired server-side: log in again and replay the request once.
+			renewed = true
+			attempt--
+			if err := ra.login(ctx, r); err != nil {
+				return nil, fmt.Errorf("Do.Request: %v", err)
+			}
+			continue
+		}
 
-		errorList := map[string]interface{}{}
-		if err := json.NewDecoder(response.Body).Decode(&errorList); err != nil {
-			return response, err
+		wait, retry := r.Retry.shouldRetry(ar.Method, attempt, response, err)
+		if !retry {
+			break
 		}
 
-		errorString = "Errors:"
-		for k, v := range errorList {
-			errorString = fmt.Sprintf("%s\n- %s: %+v", errorString, k, v)
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, fmt.Errorf("Do.Request: %v", err)
 		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Do.Request: %v", err)
+	}
+	if ar.Method == http.MethodGet && !cached {
+		r.cache.put(URL, generation, response)
+	}
+
+	if response.StatusCode >= http.StatusBadRequest {
+		// The body was buffered by bufferResponse, reading it again cannot fail.
+		body, _ := io.ReadAll(response.Body)
+		response.Body = io.NopCloser(bytes.NewReader(body))
 
-		return response, errors.New(errorString)
+		return response, newAPIError(response, body)
 	}
 
 	// If there is no response body, or if the response is of type `No Content` bypass the decode process.
@@ -134,17 +454,17 @@ func (r *Requester) Do(ar *APIRequest, responseStruct interface{}, options ...in
 
 	switch responseStruct.(type) {
 	case *string:
-		return r.ReadRawResponse(response, responseStruct)
+		return r.ReadRawResponse(ctx, response, responseStruct)
 	default:
-		return r.ReadJSONResponse(response, responseStruct)
+		return r.ReadJSONResponse(ctx, response, responseStruct)
 	}
 }
 
 // ReadRawResponse reads the http raw response and store it into `responseStruct`.
-func (r *Requester) ReadRawResponse(response *http.Response, responseStruct interface{}) (*http.Response, error) {
+func (r *Requester) ReadRawResponse(ctx context.Context, response *http.Response, responseStruct interface{}) (*http.Response, error) {
 	defer func() {
 		if err := response.Body.Close(); err != nil {
-			fmt.Println(err)
+			logCloseError(ctx, err)
 		}
 	}()
 
@@ -162,14 +482,14 @@ func (r *Requester) ReadRawResponse(response *http.Response, responseStruct inte
 }
 
 // ReadJSONResponse reads the http raw response and decodes into json.
-func (r *Requester) ReadJSONResponse(response *http.Response, responseStruct interface{}) (*http.Response, error) {
+func (r *Requester) ReadJSONResponse(ctx context.Context, response *http.Response, responseStruct interface{}) (*http.Response, error) {
 	if response.ContentLength == 0 {
 		return response, nil
 	}
 
 	defer func() {
 		if err := response.Body.Close(); err != nil {
-			fmt.Println(err)
+			logCloseError(ctx, err)
 		}
 	}()
 
@@ -180,55 +500,55 @@ func (r *Requester) ReadJSONResponse(response *http.Response, responseStruct int
 }
 
 // Get performs http get request.
-func (r *Requester) Get(endpoint string, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
+func (r *Requester) Get(ctx context.Context, endpoint string, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
 	ar := NewAPIRequest("GET", endpoint, nil)
 	ar.Suffix = ""
-	return r.Do(ar, responseStruct, querystring)
+	return r.Do(ctx, ar, responseStruct, querystring)
 }
 
 // GetJSON performs http get request with json response.
-func (r *Requester) GetJSON(endpoint string, responseStruct interface{}, query map[string]string) (*http.Response, error) {
+func (r *Requester) GetJSON(ctx context.Context, endpoint string, responseStruct interface{}, query map[string]string) (*http.Response, error) {
 	ar := NewAPIRequest("GET", endpoint, nil)
 	ar.SetHeader("Content-Type", "application/json")
 	ar.Suffix = ""
-	return r.Do(ar, &responseStruct, query)
+	return r.Do(ctx, ar, &responseStruct, query)
 }
 
 // Post performs http post request.
-func (r *Requester) Post(endpoint string, payload io.Reader, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
+func (r *Requester) Post(ctx context.Context, endpoint string, payload io.Reader, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
 	ar := NewAPIRequest("POST", endpoint, payload)
 	ar.SetHeader("Content-Type", "application/json")
 	ar.Suffix = ""
-	return r.Do(ar, &responseStruct, querystring)
+	return r.Do(ctx, ar, &responseStruct, querystring)
 }
 
 // PutJSON perform http PUT request with json response.
-func (r *Requester) PutJSON(endpoint string, payload io.Reader, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
+func (r *Requester) PutJSON(ctx context.Context, endpoint string, payload io.Reader, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
 	ar := NewAPIRequest("PUT", endpoint, payload)
 	ar.SetHeader("Content-Type", "application/json")
 	ar.Suffix = ""
-	return r.Do(ar, &responseStruct, querystring)
+	return r.Do(ctx, ar, &responseStruct, querystring)
 }
 
 // PostJSON performs http post request with json response.
-func (r *Requester) PostJSON(endpoint string, payload io.Reader, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
+func (r *Requester) PostJSON(ctx context.Context, endpoint string, payload io.Reader, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
 	ar := NewAPIRequest("POST", endpoint, payload)
 	ar.SetHeader("Content-Type", "application/json")
 	ar.Suffix = ""
-	return r.Do(ar, &responseStruct, querystring)
+	return r.Do(ctx, ar, &responseStruct, querystring)
 }
 
 // PatchJSON perform http patch request with json response.
-func (r *Requester) PatchJSON(endpoint string, payload io.Reader, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
+func (r *Requester) PatchJSON(ctx context.Context, endpoint string, payload io.Reader, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
 	ar := NewAPIRequest("PATCH", endpoint, payload)
 	ar.SetHeader("Content-Type", "application/json")
 	ar.Suffix = ""
-	return r.Do(ar, &responseStruct, querystring)
+	return r.Do(ctx, ar, &responseStruct, querystring)
 }
 
 // Delete performs http Delete request.
-func (r *Requester) Delete(endpoint string, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
+func (r *Requester) Delete(ctx context.Context, endpoint string, responseStruct interface{}, querystring map[string]string) (*http.Response, error) {
 	ar := NewAPIRequest("DELETE", endpoint, nil)
 	ar.Suffix = ""
-	return r.Do(ar, responseStruct, querystring)
+	return r.Do(ctx, ar, responseStruct, querystring)
 }
@@ -0,0 +1,269 @@
+package awx
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Request structs are the typed payloads of the ...FromRequest methods. A nil field is left
+// out of the payload, so that the same struct serves for creations and partial updates.
+// Foreign keys are Nullable, as AWX needs an explicit null to clear them.
+
+// Ptr returns a pointer to v, to fill request fields.
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// Nullable is a request field that is sent either with a value or as null.
+// A nil *Nullable field is left out of the payload.
+type Nullable[T any] struct {
+	value T
+	null  bool
+}
+
+// NewNullable returns a Nullable holding v.
+func NewNullable[T any](v T) *Nullable[T] {
+	return &Nullable[T]{value: v}
+}
+
+// Null returns a Nullable sent as null.
+func Null[T any]() *Nullable[T] {
+	return &Nullable[T]{null: true}
+}
+
+// Get returns the value and whether it is set, i.e. not null.
+func (n Nullable[T]) Get() (T, bool) {
+	return n.value, !n.null
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if n.null {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.value)
+}
+
+// requestPayload converts a request struct into the map accepted by the map based methods.
+func requestPayload(req interface{}) (map[string]interface{}, error) {
+	encoded, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	data := make(map[string]interface{})
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// ApplicationRequest is the payload to create or update an awx application.
+type ApplicationRequest struct {
+	Name                   *string        `json:"name,omitempty"`
+	Description            *string        `json:"description,omitempty"`
+	Organization           *Nullable[int] `json:"organization,omitempty"`
+	AuthorizationGrantType *string        `json:"authorization_grant_type,omitempty"`
+	ClientType             *string        `json:"client_type,omitempty"`
+	RedirectURIs           *string        `json:"redirect_uris,omitempty"`
+	SkipAuthorization      *bool          `json:"skip_authorization,omitempty"`
+}
+
+// CredentialRequest is the payload to create or update an awx credential.
+type CredentialRequest struct {
+	Name           *string        `json:"name,omitempty"`
+	Description    *string        `json:"description,omitempty"`
+	Organization   *Nullable[int] `json:"organization,omitempty"`
+	CredentialType *Nullable[int] `json:"credential_type,omitempty"`
+	// Inputs is always sent, so that an empty map clears every input.
+	Inputs map[string]interface{} `json:"inputs"`
+}
+
+// CredentialInputSourceRequest is the payload to create or update an awx credential input source.
+type CredentialInputSourceRequest struct {
+	Description      *string                `json:"description,omitempty"`
+	InputFieldName   *string                `json:"input_field_name,omitempty"`
+	TargetCredential *Nullable[int]         `json:"target_credential,omitempty"`
+	SourceCredential *Nullable[int]         `json:"source_credential,omitempty"`
+	Metadata         map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// CredentialTypeRequest is the payload to create or update an awx credential type.
+type CredentialTypeRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Kind        *string `json:"kind,omitempty"`
+	// Inputs and Injectors hold JSON objects. An empty object is sent, so that injectors can be cleared.
+	Inputs    interface{} `json:"inputs,omitempty"`
+	Injectors interface{} `json:"injectors,omitempty"`
+}
+
+// GroupRequest is the payload to create or update an awx inventory group.
+type GroupRequest struct {
+	Name        *string        `json:"name,omitempty"`
+	Description *string        `json:"description,omitempty"`
+	Inventory   *Nullable[int] `json:"inventory,omitempty"`
+	Variables   *string        `json:"variables,omitempty"`
+}
+
+// HostRequest is the payload to create or update an awx host.
+type HostRequest struct {
+	Name        *string        `json:"name,omitempty"`
+	Description *string        `json:"description,omitempty"`
+	Inventory   *Nullable[int] `json:"inventory,omitempty"`
+	Enabled     *bool          `json:"enabled,omitempty"`
+	InstanceID  *string        `json:"instance_id,omitempty"`
+	Variables   *string        `json:"variables,omitempty"`
+}
+
+// InventoryRequest is the payload to create or update an awx inventory.
+type InventoryRequest struct {
+	Name         *string        `json:"name,omitempty"`
+	Description  *string        `json:"description,omitempty"`
+	Organization *Nullable[int] `json:"organization,omitempty"`
+	Kind         *string        `json:"kind,omitempty"`
+	HostFilter   *string        `json:"host_filter,omitempty"`
+	Variables    *string        `json:"variables,omitempty"`
+}
+
+// InventorySourceRequest is the payload to create or update an awx inventory source.
+type InventorySourceRequest struct {
+	Name                 *string        `json:"name,omitempty"`
+	Description          *string        `json:"description,omitempty"`
+	Inventory            *Nullable[int] `json:"inventory,omitempty"`
+	Source               *string        `json:"source,omitempty"`
+	SourcePath           *string        `json:"source_path,omitempty"`
+	SourceVars           *string        `json:"source_vars,omitempty"`
+	SourceProject        *Nullable[int] `json:"source_project,omitempty"`
+	Credential           *Nullable[int] `json:"credential,omitempty"`
+	ExecutionEnvironment *Nullable[int] `json:"execution_environment,omitempty"`
+	EnabledVar           *string        `json:"enabled_var,omitempty"`
+	EnabledValue         *string        `json:"enabled_value,omitempty"`
+	HostFilter           *string        `json:"host_filter,omitempty"`
+	Overwrite            *bool          `json:"overwrite,omitempty"`
+	OverwriteVars        *bool          `json:"overwrite_vars,omitempty"`
+	UpdateOnLaunch       *bool          `json:"update_on_launch,omitempty"`
+	UpdateCacheTimeout   *int           `json:"update_cache_timeout,omitempty"`
+	Verbosity            *int           `json:"verbosity,omitempty"`
+	// Obsolete fields, ignored by recent AWX releases.
+	SourceRegions   *string `json:"source_regions,omitempty"`
+	InstanceFilters *string `json:"instance_filters,omitempty"`
+	GroupBy         *string `json:"group_by,omitempty"`
+}
+
+// JobTemplateRequest is the payload to create or update an awx job template.
+type JobTemplateRequest struct {
+	Name                            *string           `json:"name,omitempty"`
+	Description                     *string           `json:"description,omitempty"`
+	JobType                         *string           `json:"job_type,omitempty"`
+	Inventory                       *Nullable[int]    `json:"inventory,omitempty"`
+	Project                         *Nullable[int]    `json:"project,omitempty"`
+	Playbook                        *string           `json:"playbook,omitempty"`
+	ScmBranch                       *string           `json:"scm_branch,omitempty"`
+	Forks                           *int              `json:"forks,omitempty"`
+	Limit                           *string           `json:"limit,omitempty"`
+	Verbosity                       *int              `json:"verbosity,omitempty"`
+	ExtraVars                       *string           `json:"extra_vars,omitempty"`
+	JobTags                         *string           `json:"job_tags,omitempty"`
+	ForceHandlers                   *bool             `json:"force_handlers,omitempty"`
+	SkipTags                        *string           `json:"skip_tags,omitempty"`
+	StartAtTask                     *string           `json:"start_at_task,omitempty"`
+	Timeout                         *int              `json:"timeout,omitempty"`
+	UseFactCache                    *bool             `json:"use_fact_cache,omitempty"`
+	HostConfigKey                   *string           `json:"host_config_key,omitempty"`
+	AskScmBranchOnLaunch            *bool             `json:"ask_scm_branch_on_launch,omitempty"`
+	AskDiffModeOnLaunch             *bool             `json:"ask_diff_mode_on_launch,omitempty"`
+	AskVariablesOnLaunch            *bool             `json:"ask_variables_on_launch,omitempty"`
+	AskLimitOnLaunch                *bool             `json:"ask_limit_on_launch,omitempty"`
+	AskTagsOnLaunch                 *bool             `json:"ask_tags_on_launch,omitempty"`
+	AskSkipTagsOnLaunch             *bool             `json:"ask_skip_tags_on_launch,omitempty"`
+	AskJobTypeOnLaunch              *bool             `json:"ask_job_type_on_launch,omitempty"`
+	AskVerbosityOnLaunch            *bool             `json:"ask_verbosity_on_launch,omitempty"`
+	AskInventoryOnLaunch            *bool             `json:"ask_inventory_on_launch,omitempty"`
+	AskCredentialOnLaunch           *bool             `json:"ask_credential_on_launch,omitempty"`
+	AskExecutionEnvironmentOnLaunch *bool             `json:"ask_execution_environment_on_launch,omitempty"`
+	AskLabelsOnLaunch               *bool             `json:"ask_labels_on_launch,omitempty"`
+	AskForksOnLaunch                *bool             `json:"ask_forks_on_launch,omitempty"`
+	AskJobSliceCountOnLaunch        *bool             `json:"ask_job_slice_count_on_launch,omitempty"`
+	AskTimeoutOnLaunch              *bool             `json:"ask_timeout_on_launch,omitempty"`
+	AskInstanceGroupsOnLaunch       *bool             `json:"ask_instance_groups_on_launch,omitempty"`
+	SurveyEnabled                   *bool             `json:"survey_enabled,omitempty"`
+	BecomeEnabled                   *bool             `json:"become_enabled,omitempty"`
+	DiffMode                        *bool             `json:"diff_mode,omitempty"`
+	AllowSimultaneous               *bool             `json:"allow_simultaneous,omitempty"`
+	CustomVirtualenv                *Nullable[string] `json:"custom_virtualenv,omitempty"`
+	ExecutionEnvironment            *Nullable[int]    `json:"execution_environment,omitempty"`
+	JobSliceCount                   *int              `json:"job_slice_count,omitempty"`
+}
+
+// NotificationTemplateRequest is the payload to create or update an awx notification template.
+type NotificationTemplateRequest struct {
+	Name                      *string                `json:"name,omitempty"`
+	Description               *string                `json:"description,omitempty"`
+	Organization              *Nullable[int]         `json:"organization,omitempty"`
+	NotificationType          *string                `json:"notification_type,omitempty"`
+	NotificationConfiguration map[string]interface{} `json:"notification_configuration,omitempty"`
+	Messages                  map[string]interface{} `json:"messages,omitempty"`
+}
+
+// OrganizationRequest is the payload to create or update an awx organization.
+type OrganizationRequest struct {
+	Name               *string           `json:"name,omitempty"`
+	Description        *string           `json:"description,omitempty"`
+	MaxHosts           *int              `json:"max_hosts,omitempty"`
+	CustomVirtualenv   *Nullable[string] `json:"custom_virtualenv,omitempty"`
+	DefaultEnvironment *Nullable[int]    `json:"default_environment,omitempty"`
+}
+
+// ProjectRequest is the payload to create or update an awx project.
+type ProjectRequest struct {
+	Name                  *string        `json:"name,omitempty"`
+	Description           *string        `json:"description,omitempty"`
+	Organization          *Nullable[int] `json:"organization,omitempty"`
+	Credential            *Nullable[int] `json:"credential,omitempty"`
+	LocalPath             *string        `json:"local_path,omitempty"`
+	ScmType               *string        `json:"scm_type,omitempty"`
+	ScmURL                *string        `json:"scm_url,omitempty"`
+	ScmBranch             *string        `json:"scm_branch,omitempty"`
+	ScmClean              *bool          `json:"scm_clean,omitempty"`
+	ScmDeleteOnUpdate     *bool          `json:"scm_delete_on_update,omitempty"`
+	ScmUpdateOnLaunch     *bool          `json:"scm_update_on_launch,omitempty"`
+	ScmUpdateCacheTimeout *int           `json:"scm_update_cache_timeout,omitempty"`
+	AllowOverride         *bool          `json:"allow_override,omitempty"`
+}
+
+// TeamRequest is the payload to create or update an awx team.
+type TeamRequest struct {
+	Name         *string        `json:"name,omitempty"`
+	Description  *string        `json:"description,omitempty"`
+	Organization *Nullable[int] `json:"organization,omitempty"`
+}
+
+// UserRequest is the payload to create or update an awx user.
+type UserRequest struct {
+	Username        *string `json:"username,omitempty"`
+	Password        *string `json:"password,omitempty"`
+	FirstName       *string `json:"first_name,omitempty"`
+	LastName        *string `json:"last_name,omitempty"`
+	Email           *string `json:"email,omitempty"`
+	IsSuperuser     *bool   `json:"is_superuser,omitempty"`
+	IsSystemAuditor *bool   `json:"is_system_auditor,omitempty"`
+}
+
+// WorkflowJobTemplateNodeRequest is the payload to create or update an awx workflow job template node.
+type WorkflowJobTemplateNodeRequest struct {
+	WorkflowJobTemplate    *Nullable[int] `json:"workflow_job_template,omitempty"`
+	UnifiedJobTemplate     *Nullable[int] `json:"unified_job_template,omitempty"`
+	Identifier             *string        `json:"identifier,omitempty"`
+	Inventory              *Nullable[int] `json:"inventory,omitempty"`
+	ExtraData              interface{}    `json:"extra_data,omitempty"`
+	ScmBranch              *string        `json:"scm_branch,omitempty"`
+	JobType                *string        `json:"job_type,omitempty"`
+	JobTags                *string        `json:"job_tags,omitempty"`
+	SkipTags               *string        `json:"skip_tags,omitempty"`
+	Limit                  *string        `json:"limit,omitempty"`
+	DiffMode               *bool          `json:"diff_mode,omitempty"`
+	Verbosity              *int           `json:"verbosity,omitempty"`
+	AllParentsMustConverge *bool          `json:"all_parents_must_converge,omitempty"`
+}
@@ -0,0 +1,133 @@
+package awx_test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/goawx/awxtest"
+)
+
+func TestNullable(t *testing.T) {
+	cases := []struct {
+		name    string
+		req     *awx.TeamRequest
+		want    map[string]interface{}
+		wantSet bool
+	}{
+		{
+			name: "unset",
+			req:  &awx.TeamRequest{Name: awx.Ptr("ops")},
+			want: map[string]interface{}{"name": "ops"},
+		},
+		{
+			name: "null",
+			req:  &awx.TeamRequest{Organization: awx.Null[int]()},
+			want: map[string]interface{}{"organization": nil},
+		},
+		{
+			name:    "value",
+			req:     &awx.TeamRequest{Organization: awx.NewNullable(3)},
+			want:    map[string]interface{}{"organization": json.Number("3")},
+			wantSet: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := awx.RequestPayload(tc.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Expecting %v but got %v", tc.want, got)
+			}
+			if tc.req.Organization == nil {
+				return
+			}
+			if _, set := tc.req.Organization.Get(); set != tc.wantSet {
+				t.Errorf("Expecting Get() to report set=%t", tc.wantSet)
+			}
+		})
+	}
+}
+
+func TestRequestPayload(t *testing.T) {
+	cases := []struct {
+		name string
+		req  interface{}
+		want map[string]interface{}
+	}{
+		{
+			name: "project",
+			req: &awx.ProjectRequest{
+				Name:              awx.Ptr("playbooks"),
+				Organization:      awx.NewNullable(1),
+				Credential:        awx.Null[int](),
+				ScmType:           awx.Ptr("git"),
+				ScmUpdateOnLaunch: awx.Ptr(false),
+			},
+			want: map[string]interface{}{
+				"name":                 "playbooks",
+				"organization":         json.Number("1"),
+				"credential":           nil,
+				"scm_type":             "git",
+				"scm_update_on_launch": false,
+			},
+		},
+		{
+			name: "host",
+			req:  &awx.HostRequest{Name: awx.Ptr("web"), Enabled: awx.Ptr(true), Variables: awx.Ptr("")},
+			want: map[string]interface{}{"name": "web", "enabled": true, "variables": ""},
+		},
+		{
+			name: "credential without inputs",
+			req:  &awx.CredentialRequest{Name: awx.Ptr("machine"), Inputs: map[string]interface{}{}},
+			want: map[string]interface{}{"name": "machine", "inputs": map[string]interface{}{}},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := awx.RequestPayload(tc.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Expecting %v but got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestCredentialsService_ClearInputs(t *testing.T) {
+	srv := awxtest.NewServer()
+	defer srv.Close()
+	ctx := context.Background()
+
+	client, err := awx.NewAWX(ctx, srv.URL, srv.Username, srv.Password, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cred, err := client.CredentialsService.CreateCredentialsFromRequest(ctx, &awx.CredentialRequest{
+		Name:           awx.Ptr("machine"),
+		Organization:   awx.NewNullable(1),
+		CredentialType: awx.NewNullable(1),
+		Inputs:         map[string]interface{}{"username": "deploy"},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := srv.CredentialInput(cred.ID, "username"); !ok {
+		t.Fatal("Expecting the username input to be stored")
+	}
+
+	if _, err := client.CredentialsService.UpdateCredentialsByIDFromRequest(ctx, cred.ID, &awx.CredentialRequest{
+		Inputs: map[string]interface{}{},
+	}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if value, ok := srv.CredentialInput(cred.ID, "username"); ok {
+		t.Errorf("Expecting the inputs to be cleared, got username %v", value)
+	}
+}
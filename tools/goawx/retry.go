@@ -0,0 +1,150 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// Default values used by the provider when no retry settings are configured.
+const (
+	DefaultMaxRetries   = 3
+	DefaultRetryWaitMin = 1 * time.Second
+	DefaultRetryWaitMax = 30 * time.Second
+)
+
+// RetryPolicy controls how the Requester retries transient AWX API failures.
+// The zero value disables retries.
+type RetryPolicy struct {
+	// MaxRetries is the number of additional attempts after the first one.
+	MaxRetries int
+	// WaitMin is the base wait before the first retry, doubled on every attempt.
+	WaitMin time.Duration
+	// WaitMax caps the wait between two attempts, including Retry-After values.
+	WaitMax time.Duration
+}
+
+// RequesterOption customizes a Requester created by NewAWX or NewAWXToken.
+type RequesterOption func(*Requester)
+
+// WithRetry enables retries with exponential backoff and jitter on the Requester.
+func WithRetry(maxRetries int, waitMin, waitMax time.Duration) RequesterOption {
+	return func(r *Requester) {
+		r.Retry = RetryPolicy{MaxRetries: maxRetries, WaitMin: waitMin, WaitMax: waitMax}
+	}
+}
+
+// isIdempotent reports whether a request with the given method may safely be sent twice.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isConnectionError reports whether err was raised before AWX could process the request
+// (refused dial) or because the connection was dropped mid-flight (reset, unexpected EOF).
+func isConnectionError(err error) (refused bool, reset bool) {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true, false
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return false, true
+	}
+	return false, false
+}
+
+// shouldRetry decides whether the attempt that produced resp/err must be retried,
+// and how long to wait before the next attempt.
+func (p RetryPolicy) shouldRetry(method string, attempt int, resp *http.Response, err error) (time.Duration, bool) {
+	if attempt >= p.MaxRetries {
+		return 0, false
+	}
+
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return 0, false
+		}
+		refused, reset := isConnectionError(err)
+		if refused || (reset && isIdempotent(method)) {
+			return p.backoff(attempt), true
+		}
+		return 0, false
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		// AWX (or the gateway in front of it) rejected the request before processing it,
+		// so it is safe to send it again whatever the method.
+		return p.wait(attempt, resp), true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		if isIdempotent(method) {
+			return p.wait(attempt, resp), true
+		}
+	}
+	return 0, false
+}
+
+// wait honours the Retry-After header when present, and falls back to backoff.
+func (p RetryPolicy) wait(attempt int, resp *http.Response) time.Duration {
+	if d, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+		if p.WaitMax > 0 && d > p.WaitMax {
+			return p.WaitMax
+		}
+		return d
+	}
+	return p.backoff(attempt)
+}
+
+// backoff computes an exponential wait, capped at WaitMax, with jitter in [wait/2, wait].
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	wait := time.Duration(float64(p.WaitMin) * math.Pow(2, float64(attempt)))
+	if p.WaitMax > 0 && (wait > p.WaitMax || wait <= 0) {
+		wait = p.WaitMax
+	}
+	if wait <= 0 {
+		return 0
+	}
+	half := int64(wait / 2)
+	//nolint:gosec
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// parseRetryAfter parses the Retry-After header, in either delay-seconds or HTTP-date form.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		d := time.Until(at)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// sleepContext waits for d, returning early with the context error if ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
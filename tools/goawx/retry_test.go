@@ -0,0 +1,259 @@
+package awx_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+func TestRetryPolicy_ShouldRetry(t *testing.T) {
+	policy := awx.RetryPolicy{MaxRetries: 3, WaitMin: time.Millisecond, WaitMax: 10 * time.Millisecond}
+	refused := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	cases := []struct {
+		name    string
+		method  string
+		attempt int
+		status  int
+		err     error
+		want    bool
+	}{
+		{name: "GET 429", method: http.MethodGet, status: http.StatusTooManyRequests, want: true},
+		{name: "POST 429", method: http.MethodPost, status: http.StatusTooManyRequests, want: true},
+		{name: "GET 502", method: http.MethodGet, status: http.StatusBadGateway, want: true},
+		{name: "GET 503", method: http.MethodGet, status: http.StatusServiceUnavailable, want: true},
+		{name: "PUT 504", method: http.MethodPut, status: http.StatusGatewayTimeout, want: true},
+		{name: "DELETE 503", method: http.MethodDelete, status: http.StatusServiceUnavailable, want: true},
+		{name: "POST 502", method: http.MethodPost, status: http.StatusBadGateway},
+		{name: "POST 503", method: http.MethodPost, status: http.StatusServiceUnavailable},
+		{name: "PATCH 503", method: http.MethodPatch, status: http.StatusServiceUnavailable},
+		{name: "GET 500", method: http.MethodGet, status: http.StatusInternalServerError},
+		{name: "GET 404", method: http.MethodGet, status: http.StatusNotFound},
+		{name: "GET 200", method: http.MethodGet, status: http.StatusOK},
+		{name: "last attempt", method: http.MethodGet, attempt: 3, status: http.StatusServiceUnavailable},
+		{name: "POST refused", method: http.MethodPost, err: refused, want: true},
+		{name: "GET reset", method: http.MethodGet, err: io.ErrUnexpectedEOF, want: true},
+		{name: "POST reset", method: http.MethodPost, err: io.ErrUnexpectedEOF},
+		{name: "canceled", method: http.MethodGet, err: context.Canceled},
+		{name: "deadline exceeded", method: http.MethodGet, err: context.DeadlineExceeded},
+		{name: "other error", method: http.MethodGet, err: errors.New("x509: certificate signed by unknown authority")},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var resp *http.Response
+			if tc.err == nil {
+				resp = &http.Response{StatusCode: tc.status, Header: make(http.Header)}
+			}
+			wait, retry := policy.ShouldRetry(tc.method, tc.attempt, resp, tc.err)
+			if retry != tc.want {
+				t.Errorf("Expecting retry %t but got %t", tc.want, retry)
+			}
+			if retry && (wait < 0 || wait > policy.WaitMax) {
+				t.Errorf("Expecting a wait within [0, %s] but got %s", policy.WaitMax, wait)
+			}
+		})
+	}
+
+	if _, retry := (awx.RetryPolicy{}).ShouldRetry(http.MethodGet, 0, &http.Response{StatusCode: http.StatusServiceUnavailable}, nil); retry {
+		t.Error("Expecting the zero policy not to retry")
+	}
+}
+
+func TestRetryPolicy_ShouldRetryAfter(t *testing.T) {
+	policy := awx.RetryPolicy{MaxRetries: 3, WaitMin: time.Millisecond, WaitMax: 10 * time.Second}
+	cases := []struct {
+		retryAfter string
+		want       time.Duration
+	}{
+		{retryAfter: "2", want: 2 * time.Second},
+		{retryAfter: "0", want: 0},
+		{retryAfter: "120", want: policy.WaitMax},
+		{retryAfter: time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), want: 0},
+	}
+	for _, tc := range cases {
+		resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {tc.retryAfter}}}
+		if wait, _ := policy.ShouldRetry(http.MethodGet, 0, resp, nil); wait != tc.want {
+			t.Errorf("Retry-After %q: expecting a wait of %s but got %s", tc.retryAfter, tc.want, wait)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	cases := []struct {
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{value: "", wantOK: false},
+		{value: "3", want: 3 * time.Second, wantOK: true},
+		{value: "0", want: 0, wantOK: true},
+		{value: "-1", wantOK: false},
+		{value: "soon", wantOK: false},
+		{value: time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat), want: 0, wantOK: true},
+	}
+	for _, tc := range cases {
+		got, ok := awx.ParseRetryAfter(tc.value)
+		if ok != tc.wantOK || got != tc.want {
+			t.Errorf("ParseRetryAfter(%q): expecting %s, %t but got %s, %t", tc.value, tc.want, tc.wantOK, got, ok)
+		}
+	}
+
+	// An HTTP date in the future is a wait until then, up to the second it is truncated to.
+	got, ok := awx.ParseRetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	if !ok || got <= 58*time.Second || got > time.Minute {
+		t.Errorf("Expecting a wait of about a minute but got %s, %t", got, ok)
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := awx.RetryPolicy{MaxRetries: 10, WaitMin: 100 * time.Millisecond, WaitMax: time.Second}
+	for attempt := 0; attempt < 70; attempt++ {
+		wait := policy.WaitMin << attempt
+		if attempt >= 4 {
+			wait = policy.WaitMax
+		}
+		// The jitter draws the wait at random, sample it.
+		for i := 0; i < 20; i++ {
+			if got := policy.Backoff(attempt); got < wait/2 || got > wait {
+				t.Fatalf("Attempt %d: expecting a wait within [%s, %s] but got %s", attempt, wait/2, wait, got)
+			}
+		}
+	}
+
+	if got := (awx.RetryPolicy{}).Backoff(0); got != 0 {
+		t.Errorf("Expecting no wait without WaitMin but got %s", got)
+	}
+}
+
+// failingServer answers the first failures requests with status, then succeeds.
+type failingServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	failures int
+	posts    int
+}
+
+func newFailingServer(t *testing.T, failures, status int, retryAfter string) *failingServer {
+	t.Helper()
+	f := &failingServer{failures: failures}
+	f.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if f.failures > 0 {
+			f.failures--
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"detail": "unavailable"}`))
+			return
+		}
+		if r.Method == http.MethodPost {
+			f.posts++
+			w.WriteHeader(http.StatusCreated)
+		}
+		_, _ = w.Write([]byte(`{"id": 1, "name": "Default"}`))
+	}))
+	t.Cleanup(f.Close)
+	return f
+}
+
+// pending returns the number of failures not served yet.
+func (f *failingServer) pending() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.failures
+}
+
+// created returns the number of objects created once the failures were served.
+func (f *failingServer) created() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.posts
+}
+
+func (f *failingServer) requester(opts ...awx.RequesterOption) *awx.Requester {
+	r := &awx.Requester{Base: f.URL, Authenticator: &awx.BasicAuth{}, Client: f.Client()}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+func TestWithRetry(t *testing.T) {
+	cases := []struct {
+		name       string
+		method     string
+		failures   int
+		status     int
+		retryAfter string
+		wantErr    bool
+		// wantPending is the number of failures not served, as the request gave up.
+		wantPending int
+	}{
+		{name: "GET 429", method: http.MethodGet, failures: 2, status: http.StatusTooManyRequests},
+		{name: "GET 429 with Retry-After", method: http.MethodGet, failures: 1, status: http.StatusTooManyRequests, retryAfter: "60"},
+		{name: "GET 502", method: http.MethodGet, failures: 2, status: http.StatusBadGateway},
+		{name: "GET 503", method: http.MethodGet, failures: 3, status: http.StatusServiceUnavailable},
+		{name: "GET 503 past the retries", method: http.MethodGet, failures: 5, status: http.StatusServiceUnavailable, wantErr: true, wantPending: 1},
+		{name: "POST 429", method: http.MethodPost, failures: 2, status: http.StatusTooManyRequests},
+		{name: "POST 502", method: http.MethodPost, failures: 2, status: http.StatusBadGateway, wantErr: true, wantPending: 1},
+		{name: "POST 503", method: http.MethodPost, failures: 2, status: http.StatusServiceUnavailable, wantErr: true, wantPending: 1},
+		{name: "GET 500", method: http.MethodGet, failures: 2, status: http.StatusInternalServerError, wantErr: true, wantPending: 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := newFailingServer(t, tc.failures, tc.status, tc.retryAfter)
+			ctx := context.Background()
+			// Retry-After is capped by WaitMax.
+			r := srv.requester(awx.WithRetry(3, time.Millisecond, 5*time.Millisecond))
+
+			var resp *http.Response
+			var err error
+			if tc.method == http.MethodGet {
+				resp, err = r.GetJSON(ctx, "/api/v2/organizations/1/", nil, nil)
+			} else {
+				resp, err = r.PostJSON(ctx, "/api/v2/organizations/", strings.NewReader(`{"name": "retried"}`), nil, nil)
+			}
+			if err == nil {
+				err = awx.CheckResponse(resp)
+			}
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Expecting an error: %t, got %v", tc.wantErr, err)
+			}
+			if pending := srv.pending(); pending != tc.wantPending {
+				t.Errorf("Expecting %d failures not to be served but got %d", tc.wantPending, pending)
+			}
+			if tc.method == http.MethodPost && !tc.wantErr && srv.created() != 1 {
+				t.Errorf("Expecting the organization to be created once, got %d", srv.created())
+			}
+		})
+	}
+}
+
+func TestWithRetry_Canceled(t *testing.T) {
+	srv := newFailingServer(t, 10, http.StatusServiceUnavailable, "")
+	r := srv.requester(awx.WithRetry(10, 50*time.Millisecond, time.Second))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := r.GetJSON(ctx, "/api/v2/organizations/1/", nil, nil); err == nil {
+		t.Fatal("Expecting the canceled request to fail")
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expecting the cancellation to stop the retries, took %s", elapsed)
+	}
+	if pending := srv.pending(); pending < 8 {
+		t.Errorf("Expecting the retries to stop with the context, %d failures were served", 10-pending)
+	}
+}
@@ -0,0 +1,193 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Product identifies the flavour of the AWX API server.
+type Product string
+
+// Products reported by ServerInfo.
+const (
+	ProductUnknown    Product = ""
+	ProductAWX        Product = "AWX"
+	ProductController Product = "Automation Controller"
+)
+
+// firstAWXMajorVersion is the lowest major version that identifies AWX rather than Tower or
+// Automation Controller, whose versions are numbered 3.x and 4.x.
+const firstAWXMajorVersion = 9
+
+// Feature describes an API capability only available from a given server version.
+type Feature struct {
+	Name                 string
+	MinAWXVersion        string
+	MinControllerVersion string
+}
+
+// Features gated on the version of the connected server.
+//
+//nolint:gochecknoglobals
+var (
+	// FeatureExecutionEnvironments covers the execution_environments endpoint and fields.
+	FeatureExecutionEnvironments = Feature{
+		Name:                 "execution environments",
+		MinAWXVersion:        "18.0.0",
+		MinControllerVersion: "4.0.0",
+	}
+	// FeatureExtendedLaunchPrompts covers the labels, forks, job slice count, timeout,
+	// instance groups and execution environment prompts on launch.
+	FeatureExtendedLaunchPrompts = Feature{
+		Name:                 "prompting for labels, tags, forks, job slice count, timeout, instance groups and execution environment on launch",
+		MinAWXVersion:        "21.11.0",
+		MinControllerVersion: "4.4.0",
+	}
+	// FeatureBulkHosts covers the bulk/host_create and bulk/host_delete endpoints.
+	FeatureBulkHosts = Feature{
+		Name:                 "bulk host creation and deletion",
+		MinAWXVersion:        "22.7.0",
+		MinControllerVersion: "4.5.0",
+	}
+	// FeatureBulkJobLaunch covers the bulk/job_launch endpoint.
+	FeatureBulkJobLaunch = Feature{
+		Name:                 "bulk job launch",
+		MinAWXVersion:        "22.0.0",
+		MinControllerVersion: "4.4.0",
+	}
+)
+
+// ServerInfo describes the AWX server the client is connected to, as detected from
+// /api/v2/ping/, /api/v2/config/ and /api/v2/. Fields are left empty when detection failed,
+// in which case every feature is assumed to be supported.
+type ServerInfo struct {
+	Product Product
+	Version string
+	// Endpoints maps the name of every top level API endpoint to its path.
+	Endpoints map[string]string
+}
+
+// String implements fmt.Stringer.
+func (s *ServerInfo) String() string {
+	if s.Version == "" {
+		return "an AWX server of unknown version"
+	}
+	if s.Product == ProductUnknown {
+		return fmt.Sprintf("version %s", s.Version)
+	}
+	return fmt.Sprintf("%s %s", s.Product, s.Version)
+}
+
+// HasEndpoint reports whether the server publishes the top level endpoint name, e.g. "execution_environments".
+func (s *ServerInfo) HasEndpoint(name string) bool {
+	if s.Endpoints == nil {
+		return true
+	}
+	_, ok := s.Endpoints[name]
+	return ok
+}
+
+// Supports reports whether the server is recent enough to provide feature.
+func (s *ServerInfo) Supports(feature Feature) bool {
+	switch s.Product {
+	case ProductAWX:
+		return versionAtLeast(s.Version, feature.MinAWXVersion)
+	case ProductController:
+		return versionAtLeast(s.Version, feature.MinControllerVersion)
+	}
+	return true
+}
+
+// Requirement describes the server versions that provide feature, for error messages.
+func (feature Feature) Requirement() string {
+	return fmt.Sprintf("AWX %s or Automation Controller %s", feature.MinAWXVersion, feature.MinControllerVersion)
+}
+
+// detectServerInfo collects the server version and endpoints. Failures are logged and leave
+// the matching fields empty, as older servers or restricted users may not reach every endpoint.
+func detectServerInfo(ctx context.Context, a *AWX, ping *Ping) *ServerInfo {
+	info := &ServerInfo{Version: ping.Version}
+
+	config, err := a.ConfigService.GetConfig(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to read the AWX configuration, assuming every feature is supported", map[string]interface{}{
+			"error": err,
+		})
+	} else if config.Version != "" {
+		info.Version = config.Version
+	}
+	info.Product = detectProduct(info.Version, config)
+
+	endpoints, err := a.ConfigService.GetAPIEndpoints(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to list the AWX API endpoints", map[string]interface{}{
+			"error": err,
+		})
+	} else {
+		info.Endpoints = endpoints
+	}
+
+	tflog.Debug(ctx, "Detected AWX server", map[string]interface{}{
+		"product": string(info.Product),
+		"version": info.Version,
+	})
+	return info
+}
+
+// detectProduct tells AWX and Automation Controller apart, from the license when available
+// and from the version numbering otherwise.
+func detectProduct(version string, config *Config) Product {
+	if config != nil && config.LicenseInfo != nil {
+		if licenseType, ok := config.LicenseInfo["license_type"].(string); ok && licenseType != "" {
+			if licenseType == "open" {
+				return ProductAWX
+			}
+			return ProductController
+		}
+	}
+
+	parts := parseVersion(version)
+	if len(parts) == 0 {
+		return ProductUnknown
+	}
+	if parts[0] >= firstAWXMajorVersion {
+		return ProductAWX
+	}
+	return ProductController
+}
+
+// parseVersion returns the leading numeric components of version, so that development
+// versions such as "23.5.1.dev12+g5a8ee8b" compare as 23.5.1.
+func parseVersion(version string) []int {
+	var parts []int
+	for _, field := range strings.Split(version, ".") {
+		n, err := strconv.Atoi(field)
+		if err != nil {
+			break
+		}
+		parts = append(parts, n)
+	}
+	return parts
+}
+
+// versionAtLeast reports whether version >= minimum. Unparsable versions are assumed recent enough.
+func versionAtLeast(version, minimum string) bool {
+	v, m := parseVersion(version), parseVersion(minimum)
+	if len(v) == 0 || len(m) == 0 {
+		return true
+	}
+	for i := 0; i < len(m); i++ {
+		var current int
+		if i < len(v) {
+			current = v[i]
+		}
+		if current != m[i] {
+			return current > m[i]
+		}
+	}
+	return true
+}
@@ -0,0 +1,98 @@
+package awx_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/goawx/awxtest"
+)
+
+func TestSessionAuth_Login(t *testing.T) {
+	srv := awxtest.NewServer()
+	defer srv.Close()
+	ctx := context.Background()
+
+	client, err := awx.NewAWXWithAuthenticator(ctx, srv.URL, &awx.SessionAuth{Username: srv.Username, Password: srv.Password}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := srv.Logins(); got != 1 {
+		t.Errorf("Expecting a single login, got %d", got)
+	}
+
+	// The fake server rejects unsafe requests without the X-CSRFToken and Referer headers.
+	org, err := client.OrganizationsService.CreateOrganization(ctx, map[string]interface{}{"name": "session"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if org.Name != "session" {
+		t.Errorf("Unexpected organization %+v", org)
+	}
+}
+
+func TestSessionAuth_LoginWithoutSessionCookie(t *testing.T) {
+	srv := awxtest.NewServer()
+	defer srv.Close()
+
+	// AWX renders the login form again on invalid credentials, without a session cookie.
+	_, err := awx.NewAWXWithAuthenticator(context.Background(), srv.URL, &awx.SessionAuth{Username: srv.Username, Password: "wrong"}, nil)
+	if err == nil || !strings.Contains(err.Error(), "no session cookie returned") {
+		t.Errorf("Expecting the login to fail without a session cookie, got %v", err)
+	}
+	if got := srv.Sessions(); got != 0 {
+		t.Errorf("Expecting no session, got %d", got)
+	}
+}
+
+func TestSessionAuth_RenewExpiredSession(t *testing.T) {
+	srv := awxtest.NewServer()
+	defer srv.Close()
+	ctx := context.Background()
+
+	client, err := awx.NewAWXWithAuthenticator(ctx, srv.URL, &awx.SessionAuth{Username: srv.Username, Password: srv.Password}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv.ExpireSessions()
+	if _, err := client.OrganizationsService.CreateOrganization(ctx, map[string]interface{}{"name": "renewed"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := srv.Logins(); got != 2 {
+		t.Errorf("Expecting the expired session to be renewed once, got %d logins", got)
+	}
+	if got := len(srv.Objects("organizations")); got != 2 {
+		t.Errorf("Expecting the request to be replayed once, got %d organizations", got)
+	}
+}
+
+func TestSessionAuth_Logout(t *testing.T) {
+	srv := awxtest.NewServer()
+	defer srv.Close()
+	ctx := context.Background()
+
+	client, err := awx.NewAWXWithAuthenticator(ctx, srv.URL, &awx.SessionAuth{Username: srv.Username, Password: srv.Password}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := srv.Sessions(); got != 1 {
+		t.Fatalf("Expecting an open session, got %d", got)
+	}
+
+	if err := client.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := srv.Sessions(); got != 0 {
+		t.Errorf("Expecting the session to be closed, got %d open sessions", got)
+	}
+
+	// The session cookie is gone, closing again is a no-op.
+	if err := client.Close(ctx); err != nil {
+		t.Error(err)
+	}
+	if got := srv.Logins(); got != 1 {
+		t.Errorf("Expecting no new login, got %d logins", got)
+	}
+}
@@ -2,6 +2,7 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -20,36 +21,24 @@ type ListSettingsResponse struct {
 const settingsAPIEndpoint = "/api/v2/settings/"
 
 // ListSettings shows list of awx settings.
-func (p *SettingService) ListSettings(params map[string]string) ([]*SettingSummary, *ListSettingsResponse, error) {
-	result := new(ListSettingsResponse)
-	resp, err := p.client.Requester.GetJSON(settingsAPIEndpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
+func (p *SettingService) ListSettings(ctx context.Context, params map[string]string) ([]*SettingSummary, *ListSettingsResponse, error) {
+	results, pagination, err := listAll[*SettingSummary](ctx, p.client.Requester, settingsAPIEndpoint, params)
 	if err != nil {
-		return nil, result, err
-	}
-
-	if err := CheckResponse(resp); err != nil {
-		return nil, result, err
+		return nil, nil, err
 	}
 
-	return result.Results, result, nil
+	return results, &ListSettingsResponse{Pagination: pagination, Results: results}, nil
 }
 
 // GetSettingsBySlug shows the details of a setting.
-func (p *SettingService) GetSettingsBySlug(slug string, params map[string]string) (*Setting, error) {
+func (p *SettingService) GetSettingsBySlug(ctx context.Context, slug string, params map[string]string) (*Setting, error) {
 	result := new(Setting)
 	endpoint := fmt.Sprintf("%s%s/", settingsAPIEndpoint, slug)
-	resp, err := p.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := p.client.Requester.GetJSON(ctx, endpoint, result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -65,18 +54,18 @@ func (p *SettingService) GetSettingsBySlug(slug string, params map[string]string
 }
 
 // UpdateSettings update an awx Setting.
-func (p *SettingService) UpdateSettings(slug string, data map[string]interface{}, _ map[string]string) (*Setting, error) {
+func (p *SettingService) UpdateSettings(ctx context.Context, slug string, data map[string]interface{}, _ map[string]string) (*Setting, error) {
 	result := new(Setting)
 	endpoint := fmt.Sprintf("%s%s", settingsAPIEndpoint, slug)
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := p.client.Requester.PatchJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := p.client.Requester.PatchJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -92,15 +81,15 @@ func (p *SettingService) UpdateSettings(slug string, data map[string]interface{}
 }
 
 // DeleteSettings delete an awx Setting.
-func (p *SettingService) DeleteSettings(slug string) (*Setting, error) {
+func (p *SettingService) DeleteSettings(ctx context.Context, slug string) (*Setting, error) {
 	result := new(Setting)
 	endpoint := fmt.Sprintf("%s%s", settingsAPIEndpoint, slug)
 
-	resp, err := p.client.Requester.Delete(endpoint, result, nil)
+	resp, err := p.client.Requester.Delete(ctx, endpoint, result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
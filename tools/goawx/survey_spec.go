@@ -2,6 +2,7 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -21,14 +22,14 @@ func computeEndpoint(isWorkflow bool, jobTemplateID int) string {
 	return fmt.Sprintf(surveySpecAPIEndpoint, "", jobTemplateID)
 }
 
-func (jts *SurveySpecService) GetSurveySpec(isWorkflow bool, jobTemplateID int, params map[string]string) (*SurveySpec, error) {
+func (jts *SurveySpecService) GetSurveySpec(ctx context.Context, isWorkflow bool, jobTemplateID int, params map[string]string) (*SurveySpec, error) {
 	endpoint := computeEndpoint(isWorkflow, jobTemplateID)
 	result := new(SurveySpec)
-	resp, err := jts.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := jts.client.Requester.GetJSON(ctx, endpoint, result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -43,7 +44,7 @@ func (jts *SurveySpecService) GetSurveySpec(isWorkflow bool, jobTemplateID int,
 	return result, nil
 }
 
-func (jts *SurveySpecService) CreateSurveySpec(isWorkflow bool, jobTemplateID int, data map[string]interface{}) (*SurveySpec, error) {
+func (jts *SurveySpecService) CreateSurveySpec(ctx context.Context, isWorkflow bool, jobTemplateID int, data map[string]interface{}) (*SurveySpec, error) {
 	endpoint := computeEndpoint(isWorkflow, jobTemplateID)
 	result := new(SurveySpec)
 	mandatoryFields = []string{"name", "description", "spec"}
@@ -57,11 +58,11 @@ func (jts *SurveySpecService) CreateSurveySpec(isWorkflow bool, jobTemplateID in
 	if err != nil {
 		return nil, err
 	}
-	resp, err := jts.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, map[string]string{})
+	resp, err := jts.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, map[string]string{})
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -75,13 +76,13 @@ func (jts *SurveySpecService) CreateSurveySpec(isWorkflow bool, jobTemplateID in
 	return result, nil
 }
 
-func (jts *SurveySpecService) DeleteSurveySpec(isWorkflow bool, jobTemplateID int) error {
+func (jts *SurveySpecService) DeleteSurveySpec(ctx context.Context, isWorkflow bool, jobTemplateID int) error {
 	endpoint := computeEndpoint(isWorkflow, jobTemplateID)
-	resp, err := jts.client.Requester.Delete(endpoint, nil, map[string]string{})
+	resp, err := jts.client.Requester.Delete(ctx, endpoint, nil, map[string]string{})
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -1,11 +1,15 @@
 package awx_test
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"os"
+	"strconv"
 	"testing"
 
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/goawx/awxtest"
 )
 
 type TestRow struct {
@@ -35,14 +39,22 @@ var (
 	}
 )
 
+// TestMain runs the tests against the AWX given by the GOAWX_* environment variables, or
+// against an in-memory fake AWX when GOAWX_HOSTNAME is unset.
 func TestMain(m *testing.M) {
+	os.Exit(runTests(m))
+}
+
+func runTests(m *testing.M) int {
 	var err error
 	awxHostname = os.Getenv("GOAWX_HOSTNAME")
 	awxUsername = os.Getenv("GOAWX_USERNAME")
 	awxPassword = os.Getenv("GOAWX_PASSWORD")
 
 	if awxHostname == "" {
-		log.Fatal("no AWX hostname provided")
+		srv := awxtest.NewServer()
+		defer srv.Close()
+		awxHostname, awxUsername, awxPassword = srv.URL, srv.Username, srv.Password
 	}
 
 	if awxUsername == "" {
@@ -53,21 +65,22 @@ func TestMain(m *testing.M) {
 		log.Fatal("no AWX password provided")
 	}
 
-	awxClient, err = awx.NewAWX(awxHostname, awxUsername, awxPassword, nil)
+	awxClient, err = awx.NewAWX(context.Background(), awxHostname, awxUsername, awxPassword, nil)
 	if err != nil {
 		panic(err)
 	}
 
-	os.Exit(m.Run())
+	return m.Run()
 }
 
 func TestCredentialsService(t *testing.T) {
+	ctx := context.Background()
 	var createResponse *awx.Credential
 
 	for _, tt := range credentialsServiceTestTable {
 		t.Run("Create", func(t *testing.T) {
 			var err error
-			createResponse, err = awxClient.CredentialsService.CreateCredentials(tt.data, tt.params)
+			createResponse, err = awxClient.CredentialsService.CreateCredentials(ctx, tt.data, tt.params)
 			if err != nil {
 				t.Error(err)
 			}
@@ -78,7 +91,7 @@ func TestCredentialsService(t *testing.T) {
 		})
 
 		t.Run("Fetch", func(t *testing.T) {
-			fetchResponse, err := awxClient.CredentialsService.GetCredentialsByID(createResponse.ID, map[string]string{})
+			fetchResponse, err := awxClient.CredentialsService.GetCredentialsByID(ctx, createResponse.ID, map[string]string{})
 			if err != nil {
 				t.Error(err)
 			}
@@ -91,7 +104,7 @@ func TestCredentialsService(t *testing.T) {
 		t.Run("Update", func(t *testing.T) {
 			tt.data["name"] = "credential_x"
 
-			updateResponse, err := awxClient.CredentialsService.UpdateCredentialsByID(createResponse.ID, tt.data,
+			updateResponse, err := awxClient.CredentialsService.UpdateCredentialsByID(ctx, createResponse.ID, tt.data,
 				map[string]string{})
 			if err != nil {
 				t.Error(err)
@@ -103,7 +116,7 @@ func TestCredentialsService(t *testing.T) {
 		})
 
 		t.Run("Delete", func(t *testing.T) {
-			err := awxClient.CredentialsService.DeleteCredentialsByID(createResponse.ID,
+			err := awxClient.CredentialsService.DeleteCredentialsByID(ctx, createResponse.ID,
 				map[string]string{})
 			if err != nil {
 				t.Error(err)
@@ -111,3 +124,124 @@ func TestCredentialsService(t *testing.T) {
 		})
 	}
 }
+
+func TestNamedURLService(t *testing.T) {
+	ctx := context.Background()
+
+	inventory, err := awxClient.InventoriesService.CreateInventory(ctx, map[string]interface{}{
+		"name":         "named+url/inventory",
+		"organization": 1,
+	}, map[string]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if _, err := awxClient.InventoriesService.DeleteInventory(ctx, inventory.ID); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	host, err := awxClient.HostService.CreateHost(ctx, map[string]interface{}{
+		"name":      "web-01.example.com",
+		"inventory": inventory.ID,
+	}, map[string]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if _, err := awxClient.HostService.DeleteHost(ctx, host.ID); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	named := awx.NamedURL([]string{host.Name}, []string{inventory.Name}, []string{"Default"})
+	if want := "web-01.example.com++named[+]url/inventory++Default"; named != want {
+		t.Fatalf("NamedURL() = %q, want %q", named, want)
+	}
+
+	t.Run("ResolveID", func(t *testing.T) {
+		id, err := awxClient.NamedURLService.ResolveID(ctx, "hosts", named)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id != host.ID {
+			t.Errorf("Expecting host %d but got %d", host.ID, id)
+		}
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		var fetched awx.Inventory
+		if err := awxClient.NamedURLService.Get(ctx, "inventories", awx.NamedURL([]string{inventory.Name}, []string{"Default"}), &fetched); err != nil {
+			t.Fatal(err)
+		}
+		if fetched.ID != inventory.ID {
+			t.Errorf("Expecting inventory %d but got %d", inventory.ID, fetched.ID)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := awxClient.NamedURLService.ResolveID(ctx, "hosts", awx.NamedURL([]string{"missing"}, []string{inventory.Name}, []string{"Default"}))
+		if !awx.IsNotFound(err) {
+			t.Errorf("Expecting a not found error but got %v", err)
+		}
+	})
+}
+
+func TestBulkService(t *testing.T) {
+	ctx := context.Background()
+
+	inventory, err := awxClient.InventoriesService.CreateInventory(ctx, map[string]interface{}{
+		"name":         "bulk_inventory",
+		"organization": 1,
+	}, map[string]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if _, err := awxClient.InventoriesService.DeleteInventory(ctx, inventory.ID); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	// More hosts than a single request accepts.
+	hosts := make([]*awx.BulkHost, awx.BulkHostCreateLimit+20)
+	for i := range hosts {
+		hosts[i] = &awx.BulkHost{Name: fmt.Sprintf("bulk-%03d.example.com", i), Variables: awx.Ptr("ansible_connection: local")}
+	}
+
+	var ids []int
+	t.Run("CreateHosts", func(t *testing.T) {
+		created, err := awxClient.BulkService.CreateHosts(ctx, inventory.ID, hosts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(created) != len(hosts) {
+			t.Fatalf("Expecting %d hosts but got %d", len(hosts), len(created))
+		}
+		for i, host := range created {
+			if host.Name != hosts[i].Name || host.ID == 0 {
+				t.Errorf("Expecting host %s but got %s (%d)", hosts[i].Name, host.Name, host.ID)
+			}
+			ids = append(ids, host.ID)
+		}
+	})
+
+	t.Run("CreateHosts duplicate", func(t *testing.T) {
+		if _, err := awxClient.BulkService.CreateHosts(ctx, inventory.ID, hosts[:1]); err == nil {
+			t.Error("Expecting an error creating a duplicate host")
+		}
+	})
+
+	t.Run("DeleteHosts", func(t *testing.T) {
+		if err := awxClient.BulkService.DeleteHosts(ctx, ids); err != nil {
+			t.Fatal(err)
+		}
+		remaining, _, err := awxClient.HostService.ListHosts(ctx, map[string]string{"inventory": strconv.Itoa(inventory.ID)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(remaining) != 0 {
+			t.Errorf("Expecting no hosts left but got %d", len(remaining))
+		}
+	})
+}
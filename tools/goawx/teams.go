@@ -2,9 +2,9 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
 )
 
 // TeamService implements awx teams apis.
@@ -39,131 +39,75 @@ type ListTeamUsersResponse struct {
 const teamsAPIEndpoint = "/api/v2/teams/"
 
 // ListTeams shows list of awx teams.
-func (t *TeamService) ListTeams(params map[string]string) ([]*Team, *ListTeamsResponse, error) {
-	result := new(ListTeamsResponse)
-	resp, err := t.client.Requester.GetJSON(teamsAPIEndpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
+func (t *TeamService) ListTeams(ctx context.Context, params map[string]string) ([]*Team, *ListTeamsResponse, error) {
+	results, pagination, err := listAll[*Team](ctx, t.client.Requester, teamsAPIEndpoint, params)
 	if err != nil {
-		return nil, result, err
-	}
-
-	if err := CheckResponse(resp); err != nil {
-		return nil, result, err
+		return nil, nil, err
 	}
 
-	return result.Results, result, nil
+	return results, &ListTeamsResponse{Pagination: pagination, Results: results}, nil
 }
 
 // ListTeamRoleEntitlements shows list of awx team role entitlements.
-func (t *TeamService) ListTeamRoleEntitlements(id int, params map[string]string) ([]*ApplyRole, *ListTeamRolesResponse, error) {
-	result := new(ListTeamRolesResponse)
+func (t *TeamService) ListTeamRoleEntitlements(ctx context.Context, id int, params map[string]string) ([]*ApplyRole, *ListTeamRolesResponse, error) {
 	endpoint := fmt.Sprintf("%s%d/roles/", teamsAPIEndpoint, id)
-	resp, err := t.client.Requester.GetJSON(endpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
+	results, pagination, err := listAll[*ApplyRole](ctx, t.client.Requester, endpoint, params)
 	if err != nil {
-		return nil, result, err
+		return nil, nil, err
 	}
 
-	if err := CheckResponse(resp); err != nil {
-		return nil, result, err
-	}
-	return result.Results, result, nil
+	return results, &ListTeamRolesResponse{Pagination: pagination, Results: results}, nil
 }
 
 // GetTeamObjectRoles shows a list of object roles for a team.
-func (t *TeamService) GetTeamObjectRoles(id int, params map[string]string, _ *PaginationRequest) ([]*ApplyRole, *ListTeamRolesResponse, error) {
-	result := new(ListTeamRolesResponse)
+func (t *TeamService) GetTeamObjectRoles(ctx context.Context, id int, params map[string]string, _ *PaginationRequest) ([]*ApplyRole, *ListTeamRolesResponse, error) {
 	endpoint := fmt.Sprintf("%s%d/object_roles/", teamsAPIEndpoint, id)
-	resp, err := t.client.Requester.GetJSON(endpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
+	results, pagination, err := listAll[*ApplyRole](ctx, t.client.Requester, endpoint, params)
 	if err != nil {
-		return nil, result, err
+		return nil, nil, err
 	}
 
-	if err := CheckResponse(resp); err != nil {
-		return nil, result, err
-	}
-	return result.Results, result, nil
+	return results, &ListTeamRolesResponse{Pagination: pagination, Results: results}, nil
 }
 
 // GetTeamUsers shows a list of users for a team.
-func (t *TeamService) GetTeamUsers(id int, params map[string]string, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error) {
+func (t *TeamService) GetTeamUsers(ctx context.Context, id int, params map[string]string, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error) {
 	endpoint := fmt.Sprintf("%s%d/users/", teamsAPIEndpoint, id)
 	if *pagination.AllPages {
-		users, err := t.getAllTeamUsersPages(endpoint, params)
+		users, pagination, err := listAll[*User](ctx, t.client.Requester, endpoint, params)
 		if err != nil {
 			return nil, nil, err
 		}
-		return users, nil, nil
-	}
-	result := new(ListTeamUsersResponse)
-	resp, err := t.client.Requester.GetJSON(endpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
+		return users, &ListTeamUsersResponse{Pagination: pagination, Results: users}, nil
 	}
+	paginator := NewPaginator[*User](t.client.Requester, endpoint, params)
+	users, err := paginator.NextPage(ctx)
 	if err != nil {
-		return nil, result, err
+		return nil, nil, err
 	}
-
-	if err := CheckResponse(resp); err != nil {
-		return nil, result, err
-	}
-	return result.Results, result, nil
+	return users, &ListTeamUsersResponse{Pagination: Pagination{Count: paginator.Count()}, Results: users}, nil
 }
 
 // GetTeamAccessList shows a list of users for a team.
-func (t *TeamService) GetTeamAccessList(id int, params map[string]string, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error) {
+func (t *TeamService) GetTeamAccessList(ctx context.Context, id int, params map[string]string, pagination *PaginationRequest) ([]*User, *ListTeamUsersResponse, error) {
 	endpoint := fmt.Sprintf("%s%d/access_list/", teamsAPIEndpoint, id)
 	if *pagination.AllPages {
-		users, err := t.getAllTeamUsersPages(endpoint, params)
+		users, pagination, err := listAll[*User](ctx, t.client.Requester, endpoint, params)
 		if err != nil {
 			return nil, nil, err
 		}
-		return users, nil, nil
-	}
-	result := new(ListTeamUsersResponse)
-	resp, err := t.client.Requester.GetJSON(endpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
+		return users, &ListTeamUsersResponse{Pagination: pagination, Results: users}, nil
 	}
+	paginator := NewPaginator[*User](t.client.Requester, endpoint, params)
+	users, err := paginator.NextPage(ctx)
 	if err != nil {
-		return nil, result, err
+		return nil, nil, err
 	}
-
-	if err := CheckResponse(resp); err != nil {
-		return nil, result, err
-	}
-	return result.Results, result, nil
+	return users, &ListTeamUsersResponse{Pagination: Pagination{Count: paginator.Count()}, Results: users}, nil
 }
 
 // AddTeamUser will add the user as member in destination team.
-func (t *TeamService) AddTeamUser(id int, data map[string]interface{}) error {
+func (t *TeamService) AddTeamUser(ctx context.Context, id int, data map[string]interface{}) error {
 	endpoint := fmt.Sprintf("%s%d/users/", teamsAPIEndpoint, id)
 	data["associate"] = true
 	mandatoryFields = []string{"id", "associate"}
@@ -177,11 +121,11 @@ func (t *TeamService) AddTeamUser(id int, data map[string]interface{}) error {
 	if err != nil {
 		return err
 	}
-	resp, err := t.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), nil, nil)
+	resp, err := t.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), nil, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -193,7 +137,7 @@ func (t *TeamService) AddTeamUser(id int, data map[string]interface{}) error {
 }
 
 // RemoveTeamUser will remove the user from destination team without deleting the user.
-func (t *TeamService) RemoveTeamUser(id int, data map[string]interface{}) error {
+func (t *TeamService) RemoveTeamUser(ctx context.Context, id int, data map[string]interface{}) error {
 	endpoint := fmt.Sprintf("%s%d/users/", teamsAPIEndpoint, id)
 	data["disassociate"] = true
 	mandatoryFields = []string{"id", "disassociate"}
@@ -207,11 +151,11 @@ func (t *TeamService) RemoveTeamUser(id int, data map[string]interface{}) error
 	if err != nil {
 		return err
 	}
-	resp, err := t.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), nil, nil)
+	resp, err := t.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), nil, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -223,14 +167,14 @@ func (t *TeamService) RemoveTeamUser(id int, data map[string]interface{}) error
 }
 
 // GetTeamByID shows the details of a team.
-func (t *TeamService) GetTeamByID(id int, params map[string]string) (*Team, error) {
+func (t *TeamService) GetTeamByID(ctx context.Context, id int, params map[string]string) (*Team, error) {
 	result := new(Team)
 	endpoint := fmt.Sprintf("%s%d/", teamsAPIEndpoint, id)
-	resp, err := t.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := t.client.Requester.GetJSON(ctx, endpoint, result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -246,7 +190,7 @@ func (t *TeamService) GetTeamByID(id int, params map[string]string) (*Team, erro
 }
 
 // CreateTeam creates an awx team.
-func (t *TeamService) CreateTeam(data map[string]interface{}, params map[string]string) (*Team, error) {
+func (t *TeamService) CreateTeam(ctx context.Context, data map[string]interface{}, params map[string]string) (*Team, error) {
 	mandatoryFields = []string{"name", "organization"}
 	validate, status := ValidateParams(data, mandatoryFields)
 
@@ -263,11 +207,11 @@ func (t *TeamService) CreateTeam(data map[string]interface{}, params map[string]
 
 	// Add check if team exists and return proper error
 
-	resp, err := t.client.Requester.PostJSON(teamsAPIEndpoint, bytes.NewReader(payload), result, params)
+	resp, err := t.client.Requester.PostJSON(ctx, teamsAPIEndpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -282,19 +226,28 @@ func (t *TeamService) CreateTeam(data map[string]interface{}, params map[string]
 	return result, nil
 }
 
+// CreateTeamFromRequest is CreateTeam with a typed TeamRequest payload.
+func (t *TeamService) CreateTeamFromRequest(ctx context.Context, req *TeamRequest, params map[string]string) (*Team, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return t.CreateTeam(ctx, data, params)
+}
+
 // UpdateTeam update an awx Team.
-func (t *TeamService) UpdateTeam(id int, data map[string]interface{}, _ map[string]string) (*Team, error) {
+func (t *TeamService) UpdateTeam(ctx context.Context, id int, data map[string]interface{}, _ map[string]string) (*Team, error) {
 	result := new(Team)
 	endpoint := fmt.Sprintf("%s%d/", teamsAPIEndpoint, id)
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := t.client.Requester.PatchJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := t.client.Requester.PatchJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -309,8 +262,17 @@ func (t *TeamService) UpdateTeam(id int, data map[string]interface{}, _ map[stri
 	return result, nil
 }
 
+// UpdateTeamFromRequest is UpdateTeam with a typed TeamRequest payload.
+func (t *TeamService) UpdateTeamFromRequest(ctx context.Context, id int, req *TeamRequest, params map[string]string) (*Team, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return t.UpdateTeam(ctx, id, data, params)
+}
+
 // UpdateTeamRoleEntitlement updates the role entitlements for a team.
-func (t *TeamService) UpdateTeamRoleEntitlement(id int, data map[string]interface{}, _ map[string]string) (interface{}, error) {
+func (t *TeamService) UpdateTeamRoleEntitlement(ctx context.Context, id int, data map[string]interface{}, _ map[string]string) (interface{}, error) {
 	result := new(interface{})
 	endpoint := fmt.Sprintf("%s%d/roles/", teamsAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -318,11 +280,11 @@ func (t *TeamService) UpdateTeamRoleEntitlement(id int, data map[string]interfac
 		return nil, fmt.Errorf("UpdateTeamRoleEntitlement:json.Marshal error in payload. %s", err)
 	}
 
-	resp, err := t.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := t.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -338,15 +300,15 @@ func (t *TeamService) UpdateTeamRoleEntitlement(id int, data map[string]interfac
 }
 
 // DeleteTeam delete an awx Team.
-func (t *TeamService) DeleteTeam(id int) (*Team, error) {
+func (t *TeamService) DeleteTeam(ctx context.Context, id int) (*Team, error) {
 	result := new(Team)
 	endpoint := fmt.Sprintf("%s%d", teamsAPIEndpoint, id)
 
-	resp, err := t.client.Requester.Delete(endpoint, result, nil)
+	resp, err := t.client.Requester.Delete(ctx, endpoint, result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -360,52 +322,3 @@ func (t *TeamService) DeleteTeam(id int) (*Team, error) {
 
 	return result, nil
 }
-
-// Must be replaced by a generic function.
-// But upgrade to version go 1.18 before.
-func (t *TeamService) getAllTeamUsersPages(firstURL string, params map[string]string) ([]*User, error) {
-	results := make([]*User, 0)
-	nextURL := firstURL
-	for {
-		nextURLParsed, err := url.Parse(nextURL)
-		if err != nil {
-			return nil, err
-		}
-
-		nextURLQueryParams := make(map[string]string)
-		for paramName, paramValues := range nextURLParsed.Query() {
-			if len(paramValues) > 0 {
-				nextURLQueryParams[paramName] = paramValues[0]
-			}
-		}
-
-		for paramName, paramValue := range params {
-			nextURLQueryParams[paramName] = paramValue
-		}
-
-		result := new(ListTeamUsersResponse)
-		resp, err := t.client.Requester.GetJSON(nextURLParsed.Path, result, nextURLQueryParams)
-		if resp != nil {
-			func() {
-				if err := resp.Body.Close(); err != nil {
-					fmt.Println(err)
-				}
-			}()
-		}
-		if err != nil {
-			return nil, err
-		}
-
-		if err := CheckResponse(resp); err != nil {
-			return nil, err
-		}
-
-		results = append(results, result.Results...)
-
-		if result.Next == nil || result.Next.(string) == "" {
-			break
-		}
-		nextURL = result.Next.(string)
-	}
-	return results, nil
-}
@@ -0,0 +1,83 @@
+package awx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// TokenService implements awx OAuth2 access token api endpoints. On AAP 2.5, the tokens are
+// issued by the platform gateway.
+type TokenService struct {
+	client *Client
+}
+
+// CreateToken creates an OAuth2 access token for the authenticated user: a personal access token,
+// or a token of the application set in data.
+func (t *TokenService) CreateToken(ctx context.Context, data map[string]interface{}, params map[string]string) (*Token, error) {
+	result := new(Token)
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.client.Requester.PostJSON(ctx, t.client.Requester.tokensEndpoint(), bytes.NewReader(payload), result, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				logCloseError(ctx, err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetToken shows an awx OAuth2 access token by its ID. The token itself is only returned on creation.
+func (t *TokenService) GetToken(ctx context.Context, id int, params map[string]string) (*Token, error) {
+	result := new(Token)
+	endpoint := fmt.Sprintf("%s%d/", t.client.Requester.tokensEndpoint(), id)
+	resp, err := t.client.Requester.GetJSON(ctx, endpoint, result, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				logCloseError(ctx, err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DeleteToken revokes an awx OAuth2 access token.
+func (t *TokenService) DeleteToken(ctx context.Context, id int) error {
+	endpoint := fmt.Sprintf("%s%d/", t.client.Requester.tokensEndpoint(), id)
+	resp, err := t.client.Requester.Delete(ctx, endpoint, nil, nil)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				logCloseError(ctx, err)
+			}
+		}()
+	}
+	if err != nil {
+		return err
+	}
+
+	return CheckResponse(resp)
+}
@@ -0,0 +1,79 @@
+package awx_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/goawx/awxtest"
+)
+
+func TestTokenService(t *testing.T) {
+	srv := awxtest.NewServer(awxtest.WithTokenLifetime(time.Hour))
+	defer srv.Close()
+	ctx := context.Background()
+
+	client, err := awx.NewAWX(ctx, srv.URL, srv.Username, srv.Password, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := client.TokenService.CreateToken(ctx, map[string]interface{}{"description": "ci", "scope": "read"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.Token == "" || token.Scope != "read" {
+		t.Errorf("Unexpected token %+v", token)
+	}
+	if until := time.Until(token.Expires); until <= 0 || until > time.Hour {
+		t.Errorf("Expecting the token to expire within an hour, got %s", token.Expires)
+	}
+
+	// The token authenticates on its own.
+	tokenClient, err := awx.NewAWXWithAuthenticator(ctx, srv.URL, &awx.TokenAuth{Token: token.Token}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tokenClient.TokenService.GetToken(ctx, token.ID, nil); err != nil {
+		t.Error(err)
+	}
+
+	if err := client.TokenService.DeleteToken(ctx, token.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.TokenService.GetToken(ctx, token.ID, nil); !awx.IsNotFound(err) {
+		t.Errorf("Expecting a deleted token to be not found, got %v", err)
+	}
+}
+
+func TestApplicationService_CreateApplicationToken(t *testing.T) {
+	srv := awxtest.NewServer()
+	defer srv.Close()
+	ctx := context.Background()
+
+	client, err := awx.NewAWX(ctx, srv.URL, srv.Username, srv.Password, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	app, err := client.ApplicationService.CreateApplication(ctx, map[string]interface{}{
+		"name":                     "ci",
+		"organization":             1,
+		"authorization_grant_type": "password",
+		"client_type":              "confidential",
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := client.ApplicationService.CreateApplicationToken(ctx, app.ID, map[string]interface{}{"scope": "write"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.Application == nil || *token.Application != app.ID {
+		t.Errorf("Expecting a token of application %d, got %+v", app.ID, token.Application)
+	}
+	if token.Token == "" {
+		t.Error("Expecting the token to be returned on creation")
+	}
+}
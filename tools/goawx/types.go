@@ -38,6 +38,20 @@ type Application struct {
 	OrganizationID         int      `json:"organization"`
 }
 
+// Token represents the awx api OAuth2 access token.
+type Token struct {
+	ID           int       `json:"id"`
+	Type         string    `json:"type"`
+	URL          string    `json:"url"`
+	Description  string    `json:"description"`
+	User         int       `json:"user"`
+	Application  *int      `json:"application"`
+	Token        string    `json:"token"`
+	RefreshToken string    `json:"refresh_token"`
+	Expires      time.Time `json:"expires"`
+	Scope        string    `json:"scope"`
+}
+
 // ProjectUpdateCancel represents the awx project update cancel api response.
 type ProjectUpdateCancel struct {
 	CanCancel bool `json:"can_cancel"`
@@ -341,16 +355,6 @@ type UnifiedJobTemplate struct {
 	UnifiedJobType string `json:"unified_job_type"`
 }
 
-// InstanceGroup represents the awx api instance group.
-type InstanceGroup struct {
-	ID               int    `json:"id"`
-	Capacity         int    `json:"capacity"`
-	CredentialID     int    `json:"credential"` //nolint:golint,stylecheck
-	Name             string `json:"name"`
-	IsContainerGroup bool   `json:"is_container_group"`
-	PodSpecOverride  string `json:"pod_spec_override"`
-}
-
 // Result data type.
 type Result struct {
 	ID   int    `json:"id"`
@@ -371,13 +375,32 @@ type Instance struct {
 	Capacity  int       `json:"capacity"`
 }
 
+// PingInstanceGroup represents an instance group in the awx api ping.
+type PingInstanceGroup struct {
+	Name      string   `json:"name"`
+	Capacity  int      `json:"capacity"`
+	Instances []string `json:"instances"`
+}
+
 // Ping represents the awx api ping.
 type Ping struct {
-	Instances      []Instance      `json:"instances"`
-	InstanceGroups []InstanceGroup `json:"instance_groups"`
-	Ha             bool            `json:"ha"`
-	Version        string          `json:"version"`
-	ActiveNode     string          `json:"active_node"`
+	Instances      []Instance          `json:"instances"`
+	InstanceGroups []PingInstanceGroup `json:"instance_groups"`
+	Ha             bool                `json:"ha"`
+	Version        string              `json:"version"`
+	ActiveNode     string              `json:"active_node"`
+}
+
+// Config represents the awx api config.
+type Config struct {
+	Version           string                 `json:"version"`
+	TimeZone          string                 `json:"time_zone"`
+	LicenseInfo       map[string]interface{} `json:"license_info"`
+	AnalyticsStatus   string                 `json:"analytics_status"`
+	BecomeMethods     [][]string             `json:"become_methods"`
+	ProjectBaseDir    string                 `json:"project_base_dir"`
+	ProjectLocalPaths []string               `json:"project_local_paths"`
+	CustomVirtualenvs []string               `json:"custom_virtualenvs"`
 }
 
 // JobTemplate represents the awx api job template.
@@ -857,38 +880,6 @@ type InventorySource struct {
 	Verbosity             int         `json:"verbosity"`
 }
 
-// WorkflowJobTemplate : represents a workflow job template in AWX.
-//
-//nolint:maligned
-type WorkflowJobTemplate struct {
-	ID                   int         `json:"id"`
-	Type                 string      `json:"type"`
-	URL                  string      `json:"url"`
-	Related              *Related    `json:"related"`
-	SummaryFields        *Summary    `json:"summary_fields"`
-	Created              time.Time   `json:"created"`
-	Modified             time.Time   `json:"modified"`
-	Name                 string      `json:"name"`
-	Description          string      `json:"description"`
-	LastJobRun           interface{} `json:"last_job_run"`
-	LastJobFailed        bool        `json:"last_job_failed"`
-	NextJobRun           interface{} `json:"next_job_run"`
-	Status               string      `json:"status"`
-	ExtraVars            string      `json:"extra_vars"`
-	Organization         int         `json:"organization"`
-	SurveyEnabled        bool        `json:"survey_enabled"`
-	AllowSimultaneous    bool        `json:"allow_simultaneous"`
-	AskVariablesOnLaunch bool        `json:"ask_variables_on_launch"`
-	Inventory            *int        `json:"inventory"`
-	Limit                interface{} `json:"limit"`
-	ScmBranch            interface{} `json:"scm_branch"`
-	AskInventoryOnLaunch bool        `json:"ask_inventory_on_launch"`
-	AskScmBranchOnLaunch bool        `json:"ask_scm_branch_on_launch"`
-	AskLimitOnLaunch     bool        `json:"ask_limit_on_launch"`
-	WebhookService       string      `json:"webhook_service"`
-	WebhookCredential    interface{} `json:"webhook_credential"`
-}
-
 // WorkflowJobTemplateNode represents the awx api workflow job template node.
 type WorkflowJobTemplateNode struct {
 	ID                     int                    `json:"id"`
@@ -916,18 +907,6 @@ type WorkflowJobTemplateNode struct {
 	Identifier             string                 `json:"identifier"`
 }
 
-// Schedule : represents the awx api schedule.
-type Schedule struct {
-	ID                 int                    `json:"id"`
-	Name               string                 `json:"name"`
-	Description        string                 `json:"description"`
-	Rrule              string                 `json:"rrule"`
-	Enabled            bool                   `json:"enabled"`
-	UnifiedJobTemplate int                    `json:"unified_job_template"`
-	Inventory          int                    `json:"inventory"`
-	ExtraData          map[string]interface{} `json:"extra_data"`
-}
-
 // NotificationTemplate : represents the awx api notification template.
 type NotificationTemplate struct {
 	ID                        int                    `json:"id"`
@@ -939,24 +918,6 @@ type NotificationTemplate struct {
 	Messages                  interface{}            `json:"messages"`
 }
 
-// ExecutionEnvironment represents the awx api execution environment summary fields.
-type ExecutionEnvironment struct {
-	ID            int       `json:"id"`
-	Type          string    `json:"type"`
-	URL           string    `json:"url"`
-	Related       *Related  `json:"related"`
-	SummaryFields *Summary  `json:"summary_fields"`
-	Created       time.Time `json:"created"`
-	Modified      time.Time `json:"modified"`
-	Name          string    `json:"name"`
-	Description   string    `json:"description"`
-	Organization  int       `json:"organization"`
-	Image         string    `json:"image"`
-	Managed       bool      `json:"managed"`
-	Credential    int       `json:"credential"`
-	Pull          string    `json:"pull"`
-}
-
 type SurveySpec struct {
 	Name        string  `json:"name"`
 	Description string  `json:"description"`
@@ -2,6 +2,7 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -26,29 +27,17 @@ type ListUsersEntitlementsResponse struct {
 const usersAPIEndpoint = "/api/v2/users/"
 
 // ListUsers shows list of awx Users.
-func (u *UserService) ListUsers(params map[string]string) ([]*User, *ListUsersResponse, error) {
-	result := new(ListUsersResponse)
-	resp, err := u.client.Requester.GetJSON(usersAPIEndpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
+func (u *UserService) ListUsers(ctx context.Context, params map[string]string) ([]*User, *ListUsersResponse, error) {
+	results, pagination, err := listAll[*User](ctx, u.client.Requester, usersAPIEndpoint, params)
 	if err != nil {
-		return nil, result, err
-	}
-
-	if err := CheckResponse(resp); err != nil {
-		return nil, result, err
+		return nil, nil, err
 	}
 
-	return result.Results, result, nil
+	return results, &ListUsersResponse{Pagination: pagination, Results: results}, nil
 }
 
 // CreateUser creates an awx User.
-func (u *UserService) CreateUser(data map[string]interface{}, params map[string]string) (*User, error) {
+func (u *UserService) CreateUser(ctx context.Context, data map[string]interface{}, params map[string]string) (*User, error) {
 	mandatoryFields = []string{"username", "password", "first_name", "last_name", "email"}
 	validate, status := ValidateParams(data, mandatoryFields)
 
@@ -65,11 +54,11 @@ func (u *UserService) CreateUser(data map[string]interface{}, params map[string]
 
 	// Add check if User exists and return proper error
 
-	resp, err := u.client.Requester.PostJSON(usersAPIEndpoint, bytes.NewReader(payload), result, params)
+	resp, err := u.client.Requester.PostJSON(ctx, usersAPIEndpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -84,8 +73,17 @@ func (u *UserService) CreateUser(data map[string]interface{}, params map[string]
 	return result, nil
 }
 
+// CreateUserFromRequest is CreateUser with a typed UserRequest payload.
+func (u *UserService) CreateUserFromRequest(ctx context.Context, req *UserRequest, params map[string]string) (*User, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return u.CreateUser(ctx, data, params)
+}
+
 // UpdateUser update an awx user.
-func (u *UserService) UpdateUser(id int, data map[string]interface{}, _ map[string]string) (*User, error) {
+func (u *UserService) UpdateUser(ctx context.Context, id int, data map[string]interface{}, _ map[string]string) (*User, error) {
 	result := new(User)
 	endpoint := fmt.Sprintf("%s%d", usersAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -93,11 +91,11 @@ func (u *UserService) UpdateUser(id int, data map[string]interface{}, _ map[stri
 		return nil, err
 	}
 
-	resp, err := u.client.Requester.PutJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := u.client.Requester.PutJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -112,16 +110,25 @@ func (u *UserService) UpdateUser(id int, data map[string]interface{}, _ map[stri
 	return result, nil
 }
 
+// UpdateUserFromRequest is UpdateUser with a typed UserRequest payload.
+func (u *UserService) UpdateUserFromRequest(ctx context.Context, id int, req *UserRequest, params map[string]string) (*User, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return u.UpdateUser(ctx, id, data, params)
+}
+
 // DeleteUser delete an awx User.
-func (u *UserService) DeleteUser(id int) (*User, error) {
+func (u *UserService) DeleteUser(ctx context.Context, id int) (*User, error) {
 	result := new(User)
 	endpoint := fmt.Sprintf("%s%d", usersAPIEndpoint, id)
 
-	resp, err := u.client.Requester.Delete(endpoint, result, nil)
+	resp, err := u.client.Requester.Delete(ctx, endpoint, result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -137,14 +144,14 @@ func (u *UserService) DeleteUser(id int) (*User, error) {
 }
 
 // GetUserByID read an awx User.
-func (u *UserService) GetUserByID(id int, params map[string]string) (*User, error) {
+func (u *UserService) GetUserByID(ctx context.Context, id int, params map[string]string) (*User, error) {
 	result := new(User)
 	endpoint := fmt.Sprintf("%s%d", usersAPIEndpoint, id)
-	resp, err := u.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := u.client.Requester.GetJSON(ctx, endpoint, result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -161,29 +168,18 @@ func (u *UserService) GetUserByID(id int, params map[string]string) (*User, erro
 }
 
 // ListUserRoleEntitlements shows list of awx User Role Entitlements.
-func (u *UserService) ListUserRoleEntitlements(id int, params map[string]string) ([]*ApplyRole, *ListUsersEntitlementsResponse, error) {
-	result := new(ListUsersEntitlementsResponse)
+func (u *UserService) ListUserRoleEntitlements(ctx context.Context, id int, params map[string]string) ([]*ApplyRole, *ListUsersEntitlementsResponse, error) {
 	endpoint := fmt.Sprintf("%s%d/roles/", usersAPIEndpoint, id)
-	resp, err := u.client.Requester.GetJSON(endpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
+	results, pagination, err := listAll[*ApplyRole](ctx, u.client.Requester, endpoint, params)
 	if err != nil {
-		return nil, result, err
+		return nil, nil, err
 	}
 
-	if err := CheckResponse(resp); err != nil {
-		return nil, result, err
-	}
-	return result.Results, result, nil
+	return results, &ListUsersEntitlementsResponse{Pagination: pagination, Results: results}, nil
 }
 
 // UpdateUserRoleEntitlement updates an awx user role entitlement.
-func (u *UserService) UpdateUserRoleEntitlement(id int, data map[string]interface{}, _ map[string]string) (interface{}, error) {
+func (u *UserService) UpdateUserRoleEntitlement(ctx context.Context, id int, data map[string]interface{}, _ map[string]string) (interface{}, error) {
 	result := new(interface{})
 	endpoint := fmt.Sprintf("%s%d/roles/", usersAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -191,11 +187,11 @@ func (u *UserService) UpdateUserRoleEntitlement(id int, data map[string]interfac
 		return nil, err
 	}
 
-	resp, err := u.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := u.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -0,0 +1,375 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+// WebSocket channel of AWX, which pushes the status changes of jobs to the groups a client
+// subscribed to. AAP 2.5 serves the channel of the controller behind the platform gateway.
+const (
+	webSocketEndpoint        = "/websocket/"
+	gatewayWebSocketEndpoint = "/api/controller/websocket/"
+)
+
+// webSocketHandshakeTimeout bounds the connection to the channel and the subscription, after which
+// callers poll the API instead.
+const webSocketHandshakeTimeout = 30 * time.Second
+
+// Groups of the WebSocket channel.
+const (
+	webSocketGroupJobs    = "jobs"
+	webSocketGroupControl = "control"
+)
+
+// ErrWebSocketUnavailable is returned when the WebSocket channel cannot be used, e.g. with token
+// authentication, which the channel does not accept, or through a SOCKS proxy. Callers should
+// poll the API instead.
+var ErrWebSocketUnavailable = errors.New("AWX WebSocket channel unavailable") //nolint:gochecknoglobals
+
+// ErrWebSocketSessionLimit is returned by JobStatusStream when AWX ends the session of the
+// stream because the user opened more sessions than SESSIONS_PER_USER allows.
+var ErrWebSocketSessionLimit = errors.New("AWX session limit reached, the WebSocket channel was closed") //nolint:gochecknoglobals
+
+// WebSocketService subscribes to the notifications of the AWX WebSocket channel.
+type WebSocketService struct {
+	client *Client
+}
+
+// JobStatusChange represents a `status_changed` notification of the `jobs` group, sent for
+// every kind of unified job: jobs, project updates, workflow jobs, etc.
+type JobStatusChange struct {
+	UnifiedJobID         int    `json:"unified_job_id"`
+	UnifiedJobTemplateID int    `json:"unified_job_template_id"`
+	Status               string `json:"status"`
+	Type                 string `json:"type"`
+}
+
+// IsFinished reports whether the job ended in the notified status.
+func (c *JobStatusChange) IsFinished() bool {
+	return isFinishedStatus(c.Status)
+}
+
+// webSocketMessage is any message of the channel: the connection acceptance, the subscription
+// acknowledgement and the notifications of the groups.
+type webSocketMessage struct {
+	Accept        bool     `json:"accept"`
+	User          int      `json:"user"`
+	GroupsCurrent []string `json:"groups_current"`
+	GroupName     string   `json:"group_name"`
+	Reason        string   `json:"reason"`
+	Error         string   `json:"error"`
+	JobStatusChange
+}
+
+// JobStatusStream yields the status changes of the jobs visible to the user, until it is closed
+// or the channel fails.
+//
+//	stream, err := client.WebSocketService.SubscribeJobStatus(ctx)
+//	if err != nil { ... }
+//	defer stream.Close(ctx)
+//	for stream.Next(ctx) {
+//		change := stream.Value()
+//		...
+//	}
+//	if err := stream.Err(); err != nil { ... }
+type JobStatusStream struct {
+	conn     *websocket.Conn
+	messages chan *JobStatusChange
+	release  func(ctx context.Context) error
+
+	mu        sync.Mutex
+	err       error
+	current   *JobStatusChange
+	closeOnce sync.Once
+}
+
+// SubscribeJobStatus connects to the WebSocket channel and subscribes to the status changes of jobs,
+// and to the control group telling when the session of the channel ends. The channel authenticates
+// with a session: SessionAuth uses its own, while BasicAuth and PersonalTokenAuth open one for the
+// stream, closed along with it. Errors wrapping ErrWebSocketUnavailable mean that the channel cannot
+// be used with this client. The stream must be closed when done.
+func (w *WebSocketService) SubscribeJobStatus(ctx context.Context) (*JobStatusStream, error) {
+	r := w.client.Requester
+	location, err := w.location()
+	if err != nil {
+		return nil, err
+	}
+	dialer, err := newWebSocketDialer(r.Client, location)
+	if err != nil {
+		return nil, err
+	}
+
+	session, release, err := w.session(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := w.subscribe(ctx, location, session, dialer)
+	if err != nil {
+		if releaseErr := release(ctx); releaseErr != nil {
+			logCloseError(ctx, releaseErr)
+		}
+		return nil, err
+	}
+	stream.release = release
+	go stream.read()
+	return stream, nil
+}
+
+// location returns the http(s) URL of the channel, whose cookies authenticate the handshake.
+func (w *WebSocketService) location() (*url.URL, error) {
+	r := w.client.Requester
+	endpoint := webSocketEndpoint
+	if r.behindGateway() {
+		endpoint = gatewayWebSocketEndpoint
+	}
+	location, err := url.Parse(strings.TrimSuffix(r.Base, "/") + endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if location.Scheme != "http" && location.Scheme != "https" {
+		return nil, fmt.Errorf("%w: unsupported URL scheme %q", ErrWebSocketUnavailable, location.Scheme)
+	}
+	return location, nil
+}
+
+// session returns the session authenticating the channel, and the function releasing it.
+func (w *WebSocketService) session(ctx context.Context) (*SessionAuth, func(ctx context.Context) error, error) {
+	r := w.client.Requester
+	keep := func(context.Context) error { return nil }
+
+	var username, password string
+	switch auth := r.Authenticator.(type) {
+	case *SessionAuth:
+		return auth, keep, nil
+	case *BasicAuth:
+		username, password = auth.Username, auth.Password
+	case *PersonalTokenAuth:
+		username, password = auth.Username, auth.Password
+	default:
+		return nil, nil, fmt.Errorf("%w: the channel only accepts session or password authentication", ErrWebSocketUnavailable)
+	}
+
+	// Log in on a copy of the requester, the session must not replace the authentication of the client.
+	requester := *r
+	session := &SessionAuth{Username: username, Password: password}
+	if err := session.login(ctx, &requester); err != nil {
+		return nil, nil, fmt.Errorf("%w: %s", ErrWebSocketUnavailable, err)
+	}
+	return session, func(ctx context.Context) error { return session.logout(ctx, &requester) }, nil
+}
+
+// subscribe dials the channel with the session cookies, waits for the server to accept the
+// connection and subscribes to the groups, proving the session with its CSRF token.
+func (w *WebSocketService) subscribe(ctx context.Context, location *url.URL, session *SessionAuth, dialer *webSocketDialer) (*JobStatusStream, error) {
+	session.mu.Lock()
+	if session.jar == nil {
+		session.mu.Unlock()
+		return nil, fmt.Errorf("%w: the session is not logged in", ErrWebSocketUnavailable)
+	}
+	cookies := session.jar.Cookies(location)
+	xrfToken := session.csrfToken(location)
+	session.mu.Unlock()
+
+	origin := &url.URL{Scheme: location.Scheme, Host: location.Host}
+	config, err := websocket.NewConfig(webSocketURL(location), origin.String())
+	if err != nil {
+		return nil, err
+	}
+	cookie := make([]string, 0, len(cookies))
+	for _, c := range cookies {
+		cookie = append(cookie, c.String())
+	}
+	config.Header = dialer.header.Clone()
+	config.Header.Set("Cookie", strings.Join(cookie, "; "))
+	config.TlsConfig = dialer.tlsConfig
+
+	// Bound the handshake, the stream reads without deadline afterwards.
+	handshakeCtx, cancel := context.WithTimeout(ctx, webSocketHandshakeTimeout)
+	defer cancel()
+	raw, err := dialer.dial(handshakeCtx, config.Location)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, fmt.Errorf("%w: %s", ErrWebSocketUnavailable, err)
+	}
+	if deadline, ok := handshakeCtx.Deadline(); ok {
+		_ = raw.SetDeadline(deadline)
+	}
+	stop := context.AfterFunc(handshakeCtx, func() { _ = raw.SetDeadline(time.Now()) })
+	defer stop()
+
+	conn, err := websocket.NewClient(config, raw)
+	if err != nil {
+		_ = raw.Close()
+		return nil, fmt.Errorf("%w: %s", ErrWebSocketUnavailable, err)
+	}
+	fail := func(err error) (*JobStatusStream, error) {
+		_ = conn.Close()
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+
+	var accept webSocketMessage
+	if err := websocket.JSON.Receive(conn, &accept); err != nil {
+		return fail(fmt.Errorf("%w: %s", ErrWebSocketUnavailable, err))
+	}
+	if !accept.Accept {
+		return fail(fmt.Errorf("%w: the connection was refused", ErrWebSocketUnavailable))
+	}
+
+	if err := websocket.JSON.Send(conn, map[string]interface{}{
+		"groups": map[string][]string{
+			webSocketGroupJobs:    {"status_changed"},
+			webSocketGroupControl: {fmt.Sprintf("limit_reached_%d", accept.User)},
+		},
+		"xrftoken": xrfToken,
+	}); err != nil {
+		return fail(fmt.Errorf("%w: %s", ErrWebSocketUnavailable, err))
+	}
+	// Wait for the acknowledgement, so that no status change happening after SubscribeJobStatus
+	// returns is missed.
+	for {
+		var ack webSocketMessage
+		if err := websocket.JSON.Receive(conn, &ack); err != nil {
+			return fail(fmt.Errorf("%w: %s", ErrWebSocketUnavailable, err))
+		}
+		if ack.Error != "" {
+			return fail(fmt.Errorf("%w: %s", ErrWebSocketUnavailable, ack.Error))
+		}
+		if ack.GroupsCurrent != nil {
+			break
+		}
+	}
+
+	_ = raw.SetDeadline(time.Time{})
+	return &JobStatusStream{conn: conn, messages: make(chan *JobStatusChange)}, nil
+}
+
+// webSocketURL returns the ws(s) URL of the http(s) location.
+func webSocketURL(location *url.URL) string {
+	u := *location
+	u.Scheme = "ws"
+	if location.Scheme == "https" {
+		u.Scheme = "wss"
+	}
+	return u.String()
+}
+
+// read forwards the status changes of the channel until it fails or the stream is closed.
+func (s *JobStatusStream) read() {
+	defer close(s.messages)
+	for {
+		var msg webSocketMessage
+		if err := websocket.JSON.Receive(s.conn, &msg); err != nil {
+			s.fail(err)
+			return
+		}
+		switch msg.GroupName {
+		case webSocketGroupControl:
+			if strings.HasPrefix(msg.Reason, "limit_reached") {
+				s.fail(ErrWebSocketSessionLimit)
+				return
+			}
+		case webSocketGroupJobs:
+			change := msg.JobStatusChange
+			s.messages <- &change
+		}
+	}
+}
+
+// fail records the error ending the stream, unless the stream was closed.
+func (s *JobStatusStream) fail(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+// Next waits for the next status change. It returns false when the context is done, the stream
+// is closed or the channel failed, see Err.
+func (s *JobStatusStream) Next(ctx context.Context) bool {
+	select {
+	case change, ok := <-s.messages:
+		if !ok {
+			return false
+		}
+		s.current = change
+		return true
+	case <-ctx.Done():
+		s.fail(ctx.Err())
+		return false
+	}
+}
+
+// Value returns the status change read by the last call to Next.
+func (s *JobStatusStream) Value() *JobStatusChange {
+	return s.current
+}
+
+// Err returns the error that ended the stream, if any.
+func (s *JobStatusStream) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if errors.Is(s.err, net.ErrClosed) {
+		return nil
+	}
+	return s.err
+}
+
+// Close closes the connection, and the session opened for the stream.
+func (s *JobStatusStream) Close(ctx context.Context) error {
+	var err error
+	s.closeOnce.Do(func() {
+		s.fail(net.ErrClosed)
+		err = s.conn.Close()
+		// Unblock the reader waiting for Next to take a status change.
+		go func() {
+			for range s.messages { //nolint:revive
+			}
+		}()
+		if s.release != nil {
+			if releaseErr := s.release(ctx); releaseErr != nil && err == nil {
+				err = releaseErr
+			}
+		}
+	})
+	return err
+}
+
+// WaitForJob waits for the job id to finish, notified by the stream, and returns it. The job is
+// read once after subscribing, in case it finished before.
+func (j *JobService) WaitForJob(ctx context.Context, stream *JobStatusStream, id int) (*Job, error) {
+	job, err := j.GetJob(ctx, id, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+	for !isFinishedStatus(job.Status) {
+		if !stream.Next(ctx) {
+			if err := stream.Err(); err != nil {
+				return nil, err
+			}
+			return nil, fmt.Errorf("the WebSocket channel was closed")
+		}
+		change := stream.Value()
+		if change.Type != "job" || change.UnifiedJobID != id || !change.IsFinished() {
+			continue
+		}
+		if job, err = j.GetJob(ctx, id, map[string]string{}); err != nil {
+			return nil, err
+		}
+	}
+	return job, nil
+}
@@ -0,0 +1,170 @@
+package awx
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// webSocketDialer opens the connections to the WebSocket channel the way the HTTP client of the
+// Requester reaches AWX: with the TLS configuration, the proxy and the headers of its transport.
+type webSocketDialer struct {
+	tlsConfig *tls.Config
+	proxy     *url.URL
+	// proxyHeader is sent along with the CONNECT request to the proxy.
+	proxyHeader http.Header
+	// header is sent along with the handshake.
+	header http.Header
+}
+
+// newWebSocketDialer returns the dialer of the channel at location for client. The round trippers
+// wrapping the transport of client, such as the one adding the http_headers of the provider, expose
+// the round tripper they wrap with Unwrap, and the headers they add with Headers.
+func newWebSocketDialer(client *http.Client, location *url.URL) (*webSocketDialer, error) {
+	d := &webSocketDialer{header: make(http.Header)}
+	rt := client.Transport
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	for rt != nil {
+		if h, ok := rt.(interface{ Headers() http.Header }); ok {
+			for name, values := range h.Headers() {
+				if _, set := d.header[name]; !set {
+					d.header[name] = values
+				}
+			}
+		}
+		switch t := rt.(type) {
+		case *http.Transport:
+			return d, d.useTransport(t, location)
+		case interface{ Unwrap() http.RoundTripper }:
+			rt = t.Unwrap()
+		default:
+			rt = nil
+		}
+	}
+	return d, nil
+}
+
+// useTransport takes the TLS configuration and the proxy of transport.
+func (d *webSocketDialer) useTransport(transport *http.Transport, location *url.URL) error {
+	if transport.TLSClientConfig != nil {
+		d.tlsConfig = transport.TLSClientConfig.Clone()
+	}
+	d.proxyHeader = transport.ProxyConnectHeader
+	if transport.Proxy == nil {
+		return nil
+	}
+	proxy, err := transport.Proxy(&http.Request{URL: location, Header: make(http.Header)})
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrWebSocketUnavailable, err)
+	}
+	if proxy != nil && proxy.Scheme != "http" && proxy.Scheme != "https" {
+		return fmt.Errorf("%w: connections through the %s proxy %s are not supported", ErrWebSocketUnavailable, proxy.Scheme, proxy.Redacted())
+	}
+	d.proxy = proxy
+	return nil
+}
+
+// dial opens the connection the handshake with the ws(s) location is sent over, tunneled through
+// the proxy if any.
+func (d *webSocketDialer) dial(ctx context.Context, location *url.URL) (net.Conn, error) {
+	address := hostPort(location, "wss")
+	if d.proxy == nil {
+		if location.Scheme == "wss" {
+			tlsDialer := &tls.Dialer{Config: d.tlsConfig}
+			return tlsDialer.DialContext(ctx, "tcp", address)
+		}
+		return (&net.Dialer{}).DialContext(ctx, "tcp", address)
+	}
+
+	conn, err := d.connect(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	if location.Scheme != "wss" {
+		return conn, nil
+	}
+	config := &tls.Config{MinVersion: tls.VersionTLS12} //nolint:gosec // the settings come from the transport when set
+	if d.tlsConfig != nil {
+		config = d.tlsConfig.Clone()
+	}
+	if config.ServerName == "" {
+		config.ServerName = location.Hostname()
+	}
+	tlsConn := tls.Client(conn, config)
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return tlsConn, nil
+}
+
+// connect opens a tunnel to address through the proxy, with a CONNECT request.
+func (d *webSocketDialer) connect(ctx context.Context, address string) (net.Conn, error) {
+	proxyAddress := hostPort(d.proxy, "https")
+	var conn net.Conn
+	var err error
+	if d.proxy.Scheme == "https" {
+		conn, err = (&tls.Dialer{Config: &tls.Config{MinVersion: tls.VersionTLS12, ServerName: d.proxy.Hostname()}}).DialContext(ctx, "tcp", proxyAddress)
+	} else {
+		conn, err = (&net.Dialer{}).DialContext(ctx, "tcp", proxyAddress)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("connecting to the proxy %s: %w", d.proxy.Redacted(), err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: address},
+		Host:   address,
+		Header: d.proxyHeader.Clone(),
+	}
+	if req.Header == nil {
+		req.Header = make(http.Header)
+	}
+	if user := d.proxy.User; user != nil {
+		password, _ := user.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(user.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+	if err := req.Write(conn); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("connecting through the proxy %s: %w", d.proxy.Redacted(), err)
+	}
+	// The proxy sends nothing past its response before the client speaks, so the reader buffers
+	// no byte of the tunnel.
+	resp, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("connecting through the proxy %s: %w", d.proxy.Redacted(), err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		_ = conn.Close()
+		return nil, fmt.Errorf("the proxy %s refused the connection: %s", d.proxy.Redacted(), resp.Status)
+	}
+	_ = conn.SetDeadline(time.Time{})
+	return conn, nil
+}
+
+// hostPort returns the address of u, with the default port of its scheme.
+func hostPort(u *url.URL, tlsScheme string) string {
+	if u.Port() != "" {
+		return u.Host
+	}
+	port := "80"
+	if u.Scheme == tlsScheme {
+		port = "443"
+	}
+	return net.JoinHostPort(u.Hostname(), port)
+}
@@ -0,0 +1,201 @@
+package awx_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/goawx/awxtest"
+)
+
+func TestWebSocketService_WaitForJob(t *testing.T) {
+	client, jobID := launchFakeJob(t, "pending", "waiting", "running", "failed")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stream, err := client.WebSocketService.SubscribeJobStatus(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := stream.Close(ctx); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	job, err := client.JobService.WaitForJob(ctx, stream, jobID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Status != awx.JobStatusFailed {
+		t.Errorf("Expecting the job to be %s but got %s", awx.JobStatusFailed, job.Status)
+	}
+}
+
+func TestWebSocketService_SessionLimit(t *testing.T) {
+	srv := awxtest.NewServer(awxtest.WithJobStatuses("pending", "running"))
+	defer srv.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	client, err := awx.NewAWX(ctx, srv.URL, srv.Username, srv.Password, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stream, err := client.WebSocketService.SubscribeJobStatus(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stream.Close(ctx) //nolint:errcheck
+
+	srv.ExpireSessions()
+	for stream.Next(ctx) { //nolint:revive // drain the status changes preceding the end of the session
+	}
+	if err := stream.Err(); !errors.Is(err, awx.ErrWebSocketSessionLimit) {
+		t.Errorf("Expecting the stream to end with %v but got %v", awx.ErrWebSocketSessionLimit, err)
+	}
+}
+
+func TestWebSocketService_TokenUnavailable(t *testing.T) {
+	srv := awxtest.NewServer()
+	defer srv.Close()
+	ctx := context.Background()
+	client, err := awx.NewAWXToken(ctx, srv.URL, "token", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.WebSocketService.SubscribeJobStatus(ctx); !errors.Is(err, awx.ErrWebSocketUnavailable) {
+		t.Errorf("Expecting %v with token authentication but got %v", awx.ErrWebSocketUnavailable, err)
+	}
+}
+
+// gatewayTransport adds a header to the requests, as the provider does with http_headers.
+type gatewayTransport struct {
+	rt     http.RoundTripper
+	header http.Header
+}
+
+func (g gatewayTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	for name, values := range g.header {
+		r.Header[name] = values
+	}
+	return g.rt.RoundTrip(r)
+}
+
+func (g gatewayTransport) Unwrap() http.RoundTripper { return g.rt }
+
+func (g gatewayTransport) Headers() http.Header { return g.header }
+
+// newConnectProxy starts an HTTP proxy tunneling the CONNECT requests, and counts them.
+func newConnectProxy(t *testing.T) (*url.URL, *atomic.Int32) {
+	t.Helper()
+	var tunnels atomic.Int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			http.Error(w, "only CONNECT is supported", http.StatusMethodNotAllowed)
+			return
+		}
+		tunnels.Add(1)
+		upstream, err := net.Dial("tcp", r.Host)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			_ = upstream.Close()
+			return
+		}
+		_, _ = conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+		go func() {
+			_, _ = io.Copy(upstream, conn)
+			_ = upstream.Close()
+		}()
+		go func() {
+			_, _ = io.Copy(conn, upstream)
+			_ = conn.Close()
+		}()
+	}))
+	t.Cleanup(proxy.Close)
+	proxyURL, err := url.Parse(proxy.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return proxyURL, &tunnels
+}
+
+func TestWebSocketService_TransportSettings(t *testing.T) {
+	srv := awxtest.NewServer(awxtest.WithTLS(), awxtest.WithRequiredHeader("X-Gateway-Key", "secret"),
+		awxtest.WithJobStatuses("pending", "running", "successful"))
+	defer srv.Close()
+	proxyURL, tunnels := newConnectProxy(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// The certificate of the server is only trusted through the custom CA.
+	ca := x509.NewCertPool()
+	ca.AddCert(srv.Certificate())
+	transport := &http.Transport{
+		TLSClientConfig: &tls.Config{RootCAs: ca, MinVersion: tls.VersionTLS12},
+		Proxy:           http.ProxyURL(proxyURL),
+	}
+	httpClient := &http.Client{Transport: gatewayTransport{rt: transport, header: http.Header{"X-Gateway-Key": {"secret"}}}}
+	client, err := awx.NewAWX(ctx, srv.URL, srv.Username, srv.Password, httpClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inventory, err := srv.Create("inventories", awxtest.Object{"name": "targets", "organization": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	template, err := srv.Create("job_templates", awxtest.Object{"name": "deploy", "inventory": inventory, "playbook": "site.yml"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	launch, err := client.JobTemplateService.Launch(ctx, template, map[string]interface{}{}, map[string]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tunnelsBefore := tunnels.Load()
+	stream, err := client.WebSocketService.SubscribeJobStatus(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stream.Close(ctx) //nolint:errcheck
+	if tunnels.Load() == tunnelsBefore {
+		t.Error("Expecting the WebSocket channel to be dialed through the proxy")
+	}
+
+	job, err := client.JobService.WaitForJob(ctx, stream, launch.Job)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Status != awx.JobStatusSuccessful {
+		t.Errorf("Expecting the job to be %s but got %s", awx.JobStatusSuccessful, job.Status)
+	}
+}
+
+func TestWebSocketService_SOCKSProxyUnavailable(t *testing.T) {
+	httpClient := &http.Client{Transport: &http.Transport{
+		Proxy: http.ProxyURL(&url.URL{Scheme: "socks5", Host: "127.0.0.1:1080"}),
+	}}
+	client := awx.NewAWXDeferred("https://awx.example.com", &awx.BasicAuth{Username: "admin", Password: "password"}, httpClient,
+		awx.WithAPIBasePath(awx.DefaultAPIBasePath))
+
+	if _, err := client.WebSocketService.SubscribeJobStatus(context.Background()); !errors.Is(err, awx.ErrWebSocketUnavailable) {
+		t.Errorf("Expecting %v through a SOCKS proxy but got %v", awx.ErrWebSocketUnavailable, err)
+	}
+}
@@ -2,158 +2,21 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 )
 
-// WorkflowJobTemplateService implements awx workflow job template apis.
-type WorkflowJobTemplateService struct {
-	client *Client
-}
-
-// ListWorkflowJobTemplatesResponse represents `ListWorkflowJobTemplate` endpoint response.
-type ListWorkflowJobTemplatesResponse struct {
-	Pagination
-	Results []*WorkflowJobTemplate `json:"results"`
-}
-
-const workflowJobTemplateAPIEndpoint = "/api/v2/workflow_job_templates/"
-
-// GetWorkflowJobTemplateByID shows the details of a workflow job template.
-func (jt *WorkflowJobTemplateService) GetWorkflowJobTemplateByID(id int, params map[string]string) (*WorkflowJobTemplate, error) {
-	result := new(WorkflowJobTemplate)
-	endpoint := fmt.Sprintf("%s%d/", workflowJobTemplateAPIEndpoint, id)
-	resp, err := jt.client.Requester.GetJSON(endpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
-	if err != nil {
-		return nil, err
-	}
-
-	if err := CheckResponse(resp); err != nil {
-		return nil, err
-	}
-
-	return result, nil
-}
-
-// ListWorkflowJobTemplates shows a list of workflow job templates.
-func (jt *WorkflowJobTemplateService) ListWorkflowJobTemplates(params map[string]string) ([]*WorkflowJobTemplate, *ListWorkflowJobTemplatesResponse, error) {
-	result := new(ListWorkflowJobTemplatesResponse)
-	resp, err := jt.client.Requester.GetJSON(workflowJobTemplateAPIEndpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
-	if err != nil {
-		return nil, result, err
-	}
-
-	if err := CheckResponse(resp); err != nil {
-		return nil, result, err
-	}
-
-	return result.Results, result, nil
-}
-
-// CreateWorkflowJobTemplate creates a workflow job template.
-func (jt *WorkflowJobTemplateService) CreateWorkflowJobTemplate(data map[string]interface{}, params map[string]string) (*WorkflowJobTemplate, error) {
-	result := new(WorkflowJobTemplate)
-	mandatoryFields = []string{"name"}
-	validate, status := ValidateParams(data, mandatoryFields)
-	if !status {
-		err := fmt.Errorf("mandatory input arguments are absent: %s", validate)
-		return nil, err
-	}
-	payload, err := json.Marshal(data)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := jt.client.Requester.PostJSON(workflowJobTemplateAPIEndpoint, bytes.NewReader(payload), result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
-	if err != nil {
-		return nil, err
-	}
-	if err := CheckResponse(resp); err != nil {
-		return nil, err
-	}
-	return result, nil
-}
-
-// UpdateWorkflowJobTemplate updates a workflow job template.
-func (jt *WorkflowJobTemplateService) UpdateWorkflowJobTemplate(id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplate, error) {
-	result := new(WorkflowJobTemplate)
-	endpoint := fmt.Sprintf("%s%d", workflowJobTemplateAPIEndpoint, id)
-	payload, err := json.Marshal(data)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := jt.client.Requester.PatchJSON(endpoint, bytes.NewReader(payload), result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
-	if err != nil {
-		return nil, err
-	}
-	if err := CheckResponse(resp); err != nil {
-		return nil, err
-	}
-	return result, nil
-}
-
-// DeleteWorkflowJobTemplate deletes a workflow job template.
-func (jt *WorkflowJobTemplateService) DeleteWorkflowJobTemplate(id int) (*WorkflowJobTemplate, error) {
-	result := new(WorkflowJobTemplate)
-	endpoint := fmt.Sprintf("%s%d", workflowJobTemplateAPIEndpoint, id)
-
-	resp, err := jt.client.Requester.Delete(endpoint, result, nil)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
-	if err != nil {
-		return nil, err
-	}
-
-	if err := CheckResponse(resp); err != nil {
-		return nil, err
-	}
-
-	return result, nil
-}
-
 // ListWorkflowJobTemplateLabels returns all labels associated with a workflow job template.
-func (jt *WorkflowJobTemplateService) ListWorkflowJobTemplateLabels(id int) ([]*Label, error) {
+func (jt *WorkflowJobTemplateService) ListWorkflowJobTemplateLabels(ctx context.Context, id int) ([]*Label, error) {
 	endpoint := fmt.Sprintf("%s%d/labels/", workflowJobTemplateAPIEndpoint, id)
-	return getAllLabelPages(jt.client.Requester, endpoint, map[string]string{})
+	results, _, err := listAll[*Label](ctx, jt.client.Requester, endpoint, map[string]string{})
+	return results, err
 }
 
 // AssociateLabel creates (or finds) a label by name+organization and associates it
 // with the workflow job template. It returns the Label object produced by AWX.
-func (jt *WorkflowJobTemplateService) AssociateLabel(id int, data map[string]interface{}) (*Label, error) {
+func (jt *WorkflowJobTemplateService) AssociateLabel(ctx context.Context, id int, data map[string]interface{}) (*Label, error) {
 	result := new(Label)
 	endpoint := fmt.Sprintf("%s%d/labels/", workflowJobTemplateAPIEndpoint, id)
 	mandatory := []string{"name", "organization"}
@@ -165,11 +28,11 @@ func (jt *WorkflowJobTemplateService) AssociateLabel(id int, data map[string]int
 	if err != nil {
 		return nil, err
 	}
-	resp, err := jt.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := jt.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -183,7 +46,7 @@ func (jt *WorkflowJobTemplateService) AssociateLabel(id int, data map[string]int
 }
 
 // DisassociateLabel removes a label association from a workflow job template.
-func (jt *WorkflowJobTemplateService) DisAssociateLabel(id int, labelID int) error {
+func (jt *WorkflowJobTemplateService) DisAssociateLabel(ctx context.Context, id int, labelID int) error {
 	result := new(Label)
 	endpoint := fmt.Sprintf("%s%d/labels/", workflowJobTemplateAPIEndpoint, id)
 	data := map[string]interface{}{
@@ -194,11 +57,11 @@ func (jt *WorkflowJobTemplateService) DisAssociateLabel(id int, labelID int) err
 	if err != nil {
 		return err
 	}
-	resp, err := jt.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := jt.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -209,7 +72,7 @@ func (jt *WorkflowJobTemplateService) DisAssociateLabel(id int, labelID int) err
 }
 
 // Launch a job with the workflow job template.
-func (jt *WorkflowJobTemplateService) Launch(id int, data map[string]interface{}, params map[string]string) (*JobLaunch, error) {
+func (jt *WorkflowJobTemplateService) Launch(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*JobLaunch, error) {
 	result := new(JobLaunch)
 	endpoint := fmt.Sprintf("%s%d/launch/", workflowJobTemplateAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -217,11 +80,11 @@ func (jt *WorkflowJobTemplateService) Launch(id int, data map[string]interface{}
 		return nil, err
 	}
 
-	resp, err := jt.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, params)
+	resp, err := jt.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
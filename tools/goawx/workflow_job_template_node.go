@@ -2,6 +2,7 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -20,14 +21,14 @@ type ListWorkflowJobTemplateNodesResponse struct {
 const workflowJobTemplateNodeAPIEndpoint = "/api/v2/workflow_job_template_nodes/"
 
 // GetWorkflowJobTemplateNodeByID shows the details of a job template node.
-func (jt *WorkflowJobTemplateNodeService) GetWorkflowJobTemplateNodeByID(id int, params map[string]string) (*WorkflowJobTemplateNode, error) {
+func (jt *WorkflowJobTemplateNodeService) GetWorkflowJobTemplateNodeByID(ctx context.Context, id int, params map[string]string) (*WorkflowJobTemplateNode, error) {
 	result := new(WorkflowJobTemplateNode)
 	endpoint := fmt.Sprintf("%s%d/", workflowJobTemplateNodeAPIEndpoint, id)
-	resp, err := jt.client.Requester.GetJSON(endpoint, result, params)
+	resp, err := jt.client.Requester.GetJSON(ctx, endpoint, result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -43,30 +44,18 @@ func (jt *WorkflowJobTemplateNodeService) GetWorkflowJobTemplateNodeByID(id int,
 }
 
 // ListWorkflowJobTemplateNodes shows a list of job templates nodes.
-func (jt *WorkflowJobTemplateNodeService) ListWorkflowJobTemplateNodes(params map[string]string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
-	result := new(ListWorkflowJobTemplateNodesResponse)
+func (jt *WorkflowJobTemplateNodeService) ListWorkflowJobTemplateNodes(ctx context.Context, params map[string]string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
 
-	resp, err := jt.client.Requester.GetJSON(workflowJobTemplateNodeAPIEndpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
+	results, pagination, err := listAll[*WorkflowJobTemplateNode](ctx, jt.client.Requester, workflowJobTemplateNodeAPIEndpoint, params)
 	if err != nil {
-		return nil, result, err
+		return nil, nil, err
 	}
 
-	if err := CheckResponse(resp); err != nil {
-		return nil, result, err
-	}
-
-	return result.Results, result, nil
+	return results, &ListWorkflowJobTemplateNodesResponse{Pagination: pagination, Results: results}, nil
 }
 
 // CreateWorkflowJobTemplateNode creates a job template node, without any pe exisiting nodes.
-func (jt *WorkflowJobTemplateNodeService) CreateWorkflowJobTemplateNode(data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error) {
+func (jt *WorkflowJobTemplateNodeService) CreateWorkflowJobTemplateNode(ctx context.Context, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error) {
 	result := new(WorkflowJobTemplateNode)
 	mandatoryFields = []string{"workflow_job_template", "unified_job_template", "identifier"}
 	validate, status := ValidateParams(data, mandatoryFields)
@@ -78,11 +67,11 @@ func (jt *WorkflowJobTemplateNodeService) CreateWorkflowJobTemplateNode(data map
 	if err != nil {
 		return nil, err
 	}
-	resp, err := jt.client.Requester.PostJSON(workflowJobTemplateNodeAPIEndpoint, bytes.NewReader(payload), result, params)
+	resp, err := jt.client.Requester.PostJSON(ctx, workflowJobTemplateNodeAPIEndpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -95,8 +84,17 @@ func (jt *WorkflowJobTemplateNodeService) CreateWorkflowJobTemplateNode(data map
 	return result, nil
 }
 
+// CreateWorkflowJobTemplateNodeFromRequest is CreateWorkflowJobTemplateNode with a typed WorkflowJobTemplateNodeRequest payload.
+func (jt *WorkflowJobTemplateNodeService) CreateWorkflowJobTemplateNodeFromRequest(ctx context.Context, req *WorkflowJobTemplateNodeRequest, params map[string]string) (*WorkflowJobTemplateNode, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return jt.CreateWorkflowJobTemplateNode(ctx, data, params)
+}
+
 // UpdateWorkflowJobTemplateNode updates a job template node.
-func (jt *WorkflowJobTemplateNodeService) UpdateWorkflowJobTemplateNode(id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error) {
+func (jt *WorkflowJobTemplateNodeService) UpdateWorkflowJobTemplateNode(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error) {
 	result := new(WorkflowJobTemplateNode)
 	endpoint := fmt.Sprintf("%s%d", workflowJobTemplateNodeAPIEndpoint, id)
 	payload, err := json.Marshal(data)
@@ -104,11 +102,11 @@ func (jt *WorkflowJobTemplateNodeService) UpdateWorkflowJobTemplateNode(id int,
 		return nil, err
 	}
 
-	resp, err := jt.client.Requester.PatchJSON(endpoint, bytes.NewReader(payload), result, params)
+	resp, err := jt.client.Requester.PatchJSON(ctx, endpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -121,16 +119,25 @@ func (jt *WorkflowJobTemplateNodeService) UpdateWorkflowJobTemplateNode(id int,
 	return result, nil
 }
 
+// UpdateWorkflowJobTemplateNodeFromRequest is UpdateWorkflowJobTemplateNode with a typed WorkflowJobTemplateNodeRequest payload.
+func (jt *WorkflowJobTemplateNodeService) UpdateWorkflowJobTemplateNodeFromRequest(ctx context.Context, id int, req *WorkflowJobTemplateNodeRequest, params map[string]string) (*WorkflowJobTemplateNode, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return jt.UpdateWorkflowJobTemplateNode(ctx, id, data, params)
+}
+
 // DeleteWorkflowJobTemplateNode deletes a job template node.
-func (jt *WorkflowJobTemplateNodeService) DeleteWorkflowJobTemplateNode(id int) (*WorkflowJobTemplateNode, error) {
+func (jt *WorkflowJobTemplateNodeService) DeleteWorkflowJobTemplateNode(ctx context.Context, id int) (*WorkflowJobTemplateNode, error) {
 	result := new(WorkflowJobTemplateNode)
 	endpoint := fmt.Sprintf("%s%d", workflowJobTemplateNodeAPIEndpoint, id)
 
-	resp, err := jt.client.Requester.Delete(endpoint, result, nil)
+	resp, err := jt.client.Requester.Delete(ctx, endpoint, result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -146,34 +153,34 @@ func (jt *WorkflowJobTemplateNodeService) DeleteWorkflowJobTemplateNode(id int)
 }
 
 // AssociateNode associate a node to another node, with a link of
-func (jt *WorkflowJobTemplateNodeService) AssociateNode(originNodeID int, nextNodeID int, linkType string) error {
+func (jt *WorkflowJobTemplateNodeService) AssociateNode(ctx context.Context, originNodeID int, nextNodeID int, linkType string) error {
 	endpoint := fmt.Sprintf("%s/%d/%s_nodes/", workflowJobTemplateNodeAPIEndpoint, originNodeID, linkType)
 
-	return associateOrDisassociate(nextNodeID, jt, endpoint, true)
+	return associateOrDisassociate(ctx, nextNodeID, jt, endpoint, true)
 }
 
 // DisassociateNode disassociate a node to another node, with a link of type "success", "failure", or "always"
-func (jt *WorkflowJobTemplateNodeService) DisassociateNode(originNodeID int, nextNodeID int, linkType string) error {
+func (jt *WorkflowJobTemplateNodeService) DisassociateNode(ctx context.Context, originNodeID int, nextNodeID int, linkType string) error {
 	endpoint := fmt.Sprintf("%s/%d/%s_nodes/", workflowJobTemplateNodeAPIEndpoint, originNodeID, linkType)
 
-	return associateOrDisassociate(nextNodeID, jt, endpoint, false)
+	return associateOrDisassociate(ctx, nextNodeID, jt, endpoint, false)
 }
 
 // AssociateCredential associate a credential to a node
-func (jt *WorkflowJobTemplateNodeService) AssociateCredential(nodeID int, credentialID int) error {
+func (jt *WorkflowJobTemplateNodeService) AssociateCredential(ctx context.Context, nodeID int, credentialID int) error {
 	endpoint := fmt.Sprintf("%s/%d/credentials", workflowJobTemplateNodeAPIEndpoint, nodeID)
 
-	return associateOrDisassociate(credentialID, jt, endpoint, true)
+	return associateOrDisassociate(ctx, credentialID, jt, endpoint, true)
 }
 
 // DisassociateCredential disassociate a credential from a node
-func (jt *WorkflowJobTemplateNodeService) DisassociateCredential(nodeID int, credentialID int) error {
+func (jt *WorkflowJobTemplateNodeService) DisassociateCredential(ctx context.Context, nodeID int, credentialID int) error {
 	endpoint := fmt.Sprintf("%s/%d/credentials", workflowJobTemplateNodeAPIEndpoint, nodeID)
 
-	return associateOrDisassociate(credentialID, jt, endpoint, false)
+	return associateOrDisassociate(ctx, credentialID, jt, endpoint, false)
 }
 
-func associateOrDisassociate(id int, jt *WorkflowJobTemplateNodeService, endpoint string, associate bool) error {
+func associateOrDisassociate(ctx context.Context, id int, jt *WorkflowJobTemplateNodeService, endpoint string, associate bool) error {
 	associateOrDisassociate := ""
 
 	if associate {
@@ -199,11 +206,11 @@ func associateOrDisassociate(id int, jt *WorkflowJobTemplateNodeService, endpoin
 	if err != nil {
 		return err
 	}
-	resp, err := jt.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), nil, nil)
+	resp, err := jt.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), nil, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -2,9 +2,9 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 )
 
 // WorkflowJobTemplateNodeStepService implements awx job template nodes apis.
@@ -14,33 +14,21 @@ type WorkflowJobTemplateNodeStepService struct {
 }
 
 // ListWorkflowJobTemplateNodes shows a list of job templates nodes.
-func (jt *WorkflowJobTemplateNodeStepService) ListWorkflowJobTemplateNodes(id int, params map[string]string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
+func (jt *WorkflowJobTemplateNodeStepService) ListWorkflowJobTemplateNodes(ctx context.Context, id int, params map[string]string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
 	workflowJobTemplateNodesActionEndpoint := fmt.Sprintf(jt.endpoint, id)
-	return fetchWorkflowJobTemplateNode(jt.client, params, workflowJobTemplateNodesActionEndpoint)
+	return fetchWorkflowJobTemplateNode(ctx, jt.client, params, workflowJobTemplateNodesActionEndpoint)
 }
 
-func fetchWorkflowJobTemplateNode(client *Client, params map[string]string, workflowJobTemplateNodesActionEndpoint string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
-	result := new(ListWorkflowJobTemplateNodesResponse)
-	resp, err := client.Requester.GetJSON(workflowJobTemplateNodesActionEndpoint, result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
+func fetchWorkflowJobTemplateNode(ctx context.Context, client *Client, params map[string]string, workflowJobTemplateNodesActionEndpoint string) ([]*WorkflowJobTemplateNode, *ListWorkflowJobTemplateNodesResponse, error) {
+	results, pagination, err := listAll[*WorkflowJobTemplateNode](ctx, client.Requester, workflowJobTemplateNodesActionEndpoint, params)
 	if err != nil {
-		return nil, result, err
-	}
-
-	if err := CheckResponse(resp); err != nil {
-		return nil, result, err
+		return nil, nil, err
 	}
 
-	return result.Results, result, nil
+	return results, &ListWorkflowJobTemplateNodesResponse{Pagination: pagination, Results: results}, nil
 }
 
-func createWorkflowJobTemplateNode(client *Client, data map[string]interface{}, params map[string]string, workflowJobTemplateNodesActionEndpoint string) (*WorkflowJobTemplateNode, error) {
+func createWorkflowJobTemplateNode(ctx context.Context, client *Client, data map[string]interface{}, params map[string]string, workflowJobTemplateNodesActionEndpoint string) (*WorkflowJobTemplateNode, error) {
 	result := new(WorkflowJobTemplateNode)
 	mandatoryFields = []string{"unified_job_template", "identifier"}
 	validate, status := ValidateParams(data, mandatoryFields)
@@ -52,11 +40,11 @@ func createWorkflowJobTemplateNode(client *Client, data map[string]interface{},
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Requester.PostJSON(workflowJobTemplateNodesActionEndpoint, bytes.NewReader(payload), result, params)
+	resp, err := client.Requester.PostJSON(ctx, workflowJobTemplateNodesActionEndpoint, bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -66,12 +54,20 @@ func createWorkflowJobTemplateNode(client *Client, data map[string]interface{},
 	if err := CheckResponse(resp); err != nil {
 		return nil, err
 	}
-	log.Printf("Created ID %v", result.ID)
 	return result, nil
 }
 
 // CreateWorkflowJobTemplateNodeStep will be creating a template node for an existing node.
-func (jt *WorkflowJobTemplateNodeStepService) CreateWorkflowJobTemplateNodeStep(id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error) {
+func (jt *WorkflowJobTemplateNodeStepService) CreateWorkflowJobTemplateNodeStep(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*WorkflowJobTemplateNode, error) {
 	workflowJobTemplateNodesActionEndpoint := fmt.Sprintf(jt.endpoint, id)
-	return createWorkflowJobTemplateNode(jt.client, data, params, workflowJobTemplateNodesActionEndpoint)
+	return createWorkflowJobTemplateNode(ctx, jt.client, data, params, workflowJobTemplateNodesActionEndpoint)
+}
+
+// CreateWorkflowJobTemplateNodeStepFromRequest is CreateWorkflowJobTemplateNodeStep with a typed WorkflowJobTemplateNodeRequest payload.
+func (jt *WorkflowJobTemplateNodeStepService) CreateWorkflowJobTemplateNodeStepFromRequest(ctx context.Context, id int, req *WorkflowJobTemplateNodeRequest, params map[string]string) (*WorkflowJobTemplateNode, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return jt.CreateWorkflowJobTemplateNodeStep(ctx, id, data, params)
 }
@@ -2,6 +2,7 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -13,7 +14,7 @@ type WorkflowJobTemplateNotificationTemplatesService struct {
 	client *Client
 }
 
-func (s *WorkflowJobTemplateNotificationTemplatesService) associateWorkflowJobTemplateNotificationTemplatesForType(jobTemplateID int, notificationTemplateID int, typ string) (*NotificationTemplate, error) {
+func (s *WorkflowJobTemplateNotificationTemplatesService) associateWorkflowJobTemplateNotificationTemplatesForType(ctx context.Context, jobTemplateID int, notificationTemplateID int, typ string) (*NotificationTemplate, error) {
 	result := new(NotificationTemplate)
 
 	data := map[string]interface{}{
@@ -33,11 +34,11 @@ func (s *WorkflowJobTemplateNotificationTemplatesService) associateWorkflowJobTe
 	if err != nil {
 		return nil, err
 	}
-	resp, err := s.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := s.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -53,26 +54,26 @@ func (s *WorkflowJobTemplateNotificationTemplatesService) associateWorkflowJobTe
 }
 
 // AssociateWorkflowJobTemplateNotificationTemplatesError will associate an error notification_template for a job_template.
-func (s *WorkflowJobTemplateNotificationTemplatesService) AssociateWorkflowJobTemplateNotificationTemplatesError(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return s.associateWorkflowJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "error")
+func (s *WorkflowJobTemplateNotificationTemplatesService) AssociateWorkflowJobTemplateNotificationTemplatesError(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return s.associateWorkflowJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "error")
 }
 
 // AssociateWorkflowJobTemplateNotificationTemplatesSuccess will associate a success notification_template for a job_template.
-func (s *WorkflowJobTemplateNotificationTemplatesService) AssociateWorkflowJobTemplateNotificationTemplatesSuccess(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return s.associateWorkflowJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "success")
+func (s *WorkflowJobTemplateNotificationTemplatesService) AssociateWorkflowJobTemplateNotificationTemplatesSuccess(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return s.associateWorkflowJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "success")
 }
 
 // AssociateWorkflowJobTemplateNotificationTemplatesStarted will associate a started notification_template for a job_template.
-func (s *WorkflowJobTemplateNotificationTemplatesService) AssociateWorkflowJobTemplateNotificationTemplatesStarted(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return s.associateWorkflowJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "started")
+func (s *WorkflowJobTemplateNotificationTemplatesService) AssociateWorkflowJobTemplateNotificationTemplatesStarted(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return s.associateWorkflowJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "started")
 }
 
 // AssociateWorkflowJobTemplateNotificationTemplatesApprovals will associate an approval notification_template for a job_template.
-func (s *WorkflowJobTemplateNotificationTemplatesService) AssociateWorkflowJobTemplateNotificationTemplatesApprovals(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return s.associateWorkflowJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "approvals")
+func (s *WorkflowJobTemplateNotificationTemplatesService) AssociateWorkflowJobTemplateNotificationTemplatesApprovals(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return s.associateWorkflowJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "approvals")
 }
 
-func (s *WorkflowJobTemplateNotificationTemplatesService) disassociateWorkflowJobTemplateNotificationTemplatesForType(jobTemplateID int, notificationTemplateID int, typ string) (*NotificationTemplate, error) {
+func (s *WorkflowJobTemplateNotificationTemplatesService) disassociateWorkflowJobTemplateNotificationTemplatesForType(ctx context.Context, jobTemplateID int, notificationTemplateID int, typ string) (*NotificationTemplate, error) {
 	result := new(NotificationTemplate)
 
 	data := map[string]interface{}{
@@ -92,11 +93,11 @@ func (s *WorkflowJobTemplateNotificationTemplatesService) disassociateWorkflowJo
 	if err != nil {
 		return nil, err
 	}
-	resp, err := s.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	resp, err := s.client.Requester.PostJSON(ctx, endpoint, bytes.NewReader(payload), result, nil)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -112,21 +113,21 @@ func (s *WorkflowJobTemplateNotificationTemplatesService) disassociateWorkflowJo
 }
 
 // DisassociateWorkflowJobTemplateNotificationTemplatesError will disassociate an error notification_template for a job_template.
-func (s *WorkflowJobTemplateNotificationTemplatesService) DisassociateWorkflowJobTemplateNotificationTemplatesError(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return s.disassociateWorkflowJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "error")
+func (s *WorkflowJobTemplateNotificationTemplatesService) DisassociateWorkflowJobTemplateNotificationTemplatesError(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return s.disassociateWorkflowJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "error")
 }
 
 // DisassociateWorkflowJobTemplateNotificationTemplatesSuccess will disassociate a success notification_template for a job_template.
-func (s *WorkflowJobTemplateNotificationTemplatesService) DisassociateWorkflowJobTemplateNotificationTemplatesSuccess(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return s.disassociateWorkflowJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "success")
+func (s *WorkflowJobTemplateNotificationTemplatesService) DisassociateWorkflowJobTemplateNotificationTemplatesSuccess(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return s.disassociateWorkflowJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "success")
 }
 
 // DisassociateWorkflowJobTemplateNotificationTemplatesStarted will disassociate a started notification_template for a job_template.
-func (s *WorkflowJobTemplateNotificationTemplatesService) DisassociateWorkflowJobTemplateNotificationTemplatesStarted(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return s.disassociateWorkflowJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "started")
+func (s *WorkflowJobTemplateNotificationTemplatesService) DisassociateWorkflowJobTemplateNotificationTemplatesStarted(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return s.disassociateWorkflowJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "started")
 }
 
 // DisassociateWorkflowJobTemplateNotificationTemplatesApprovals will disassociate an approval notification_template for a job_template.
-func (s *WorkflowJobTemplateNotificationTemplatesService) DisassociateWorkflowJobTemplateNotificationTemplatesApprovals(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
-	return s.disassociateWorkflowJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "approvals")
+func (s *WorkflowJobTemplateNotificationTemplatesService) DisassociateWorkflowJobTemplateNotificationTemplatesApprovals(ctx context.Context, jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
+	return s.disassociateWorkflowJobTemplateNotificationTemplatesForType(ctx, jobTemplateID, notificationTemplateID, "approvals")
 }
@@ -2,6 +2,7 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -14,31 +15,17 @@ type WorkflowJobTemplateScheduleService struct {
 }
 
 // ListWorkflowJobTemplateSchedules shows a list of schedules for a given workflow_job_template.
-func (jt *WorkflowJobTemplateScheduleService) ListWorkflowJobTemplateSchedules(id int, params map[string]string) ([]*Schedule, *ListSchedulesResponse, error) {
-	result := new(ListSchedulesResponse)
-	resp, err := jt.client.Requester.GetJSON(
-		fmt.Sprintf(workflowJobTemplateSchedulesAPIEndpoint, id),
-		result, params)
-	if resp != nil {
-		func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}()
-	}
+func (jt *WorkflowJobTemplateScheduleService) ListWorkflowJobTemplateSchedules(ctx context.Context, id int, params map[string]string) ([]*Schedule, *ListSchedulesResponse, error) {
+	results, pagination, err := listAll[*Schedule](ctx, jt.client.Requester, fmt.Sprintf(workflowJobTemplateSchedulesAPIEndpoint, id), params)
 	if err != nil {
-		return nil, result, err
+		return nil, nil, err
 	}
 
-	if err := CheckResponse(resp); err != nil {
-		return nil, result, err
-	}
-
-	return result.Results, result, nil
+	return results, &ListSchedulesResponse{Pagination: pagination, Results: results}, nil
 }
 
 // CreateWorkflowJobTemplateSchedule will create a schedule for an existing workflow_job_template.
-func (jt *WorkflowJobTemplateScheduleService) CreateWorkflowJobTemplateSchedule(id int, data map[string]interface{}, params map[string]string) (*Schedule, error) {
+func (jt *WorkflowJobTemplateScheduleService) CreateWorkflowJobTemplateSchedule(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Schedule, error) {
 	mandatoryFields = []string{"name", "rrule"}
 	validate, status := ValidateParams(data, mandatoryFields)
 	if !status {
@@ -52,13 +39,13 @@ func (jt *WorkflowJobTemplateScheduleService) CreateWorkflowJobTemplateSchedule(
 		return nil, err
 	}
 
-	resp, err := jt.client.Requester.PostJSON(
+	resp, err := jt.client.Requester.PostJSON(ctx,
 		fmt.Sprintf(workflowJobTemplateSchedulesAPIEndpoint, id),
 		bytes.NewReader(payload), result, params)
 	if resp != nil {
 		func() {
 			if err := resp.Body.Close(); err != nil {
-				fmt.Println(err)
+				logCloseError(ctx, err)
 			}
 		}()
 	}
@@ -72,3 +59,12 @@ func (jt *WorkflowJobTemplateScheduleService) CreateWorkflowJobTemplateSchedule(
 
 	return result, nil
 }
+
+// CreateWorkflowJobTemplateScheduleFromRequest is CreateWorkflowJobTemplateSchedule with a typed ScheduleRequest payload.
+func (jt *WorkflowJobTemplateScheduleService) CreateWorkflowJobTemplateScheduleFromRequest(ctx context.Context, id int, req *ScheduleRequest, params map[string]string) (*Schedule, error) {
+	data, err := requestPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	return jt.CreateWorkflowJobTemplateSchedule(ctx, id, data, params)
+}
@@ -2,11 +2,17 @@ package utils
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"sort"
 	"strconv"
+	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
 	"gopkg.in/yaml.v2"
 )
 
@@ -22,11 +28,15 @@ func DiagFetch(method string, id interface{}, err error) diag.Diagnostics {
 	)
 }
 
-// DiagCreate : Return the message for the create method
-func DiagCreate(method string, err error) diag.Diagnostics {
+// DiagCreate : Return the message for the create method. The validation errors of the payload
+// are reported on the attributes their fields are set from, see AttributeMap.
+func DiagCreate(method string, err error, fields ...AttributeMap) diag.Diagnostics {
 	if err == nil {
 		err = fmt.Errorf("create failed")
 	}
+	if diags := DiagValidation(fmt.Sprintf("Unable to create %s", method), err, fields...); diags != nil {
+		return diags
+	}
 	return Diagf(
 		fmt.Sprintf("Unable to create %s", method),
 		"Unable to create %s got %s",
@@ -34,11 +44,15 @@ func DiagCreate(method string, err error) diag.Diagnostics {
 	)
 }
 
-// DiagUpdate : Return the message for the update method
-func DiagUpdate(method string, id interface{}, err error) diag.Diagnostics {
+// DiagUpdate : Return the message for the update method. The validation errors of the payload
+// are reported on the attributes their fields are set from, see AttributeMap.
+func DiagUpdate(method string, id interface{}, err error, fields ...AttributeMap) diag.Diagnostics {
 	if err == nil {
 		err = fmt.Errorf("update failed")
 	}
+	if diags := DiagValidation(fmt.Sprintf("Unable to update %s", method), err, fields...); diags != nil {
+		return diags
+	}
 	return Diagf(
 		fmt.Sprintf("Unable to update %s", method),
 		"Unable to update %s with id %v: got %s",
@@ -70,6 +84,84 @@ func DiagDelete(method string, id interface{}, err error) diag.Diagnostics {
 	)
 }
 
+// AttributeMap maps the fields of an AWX payload to the schema attributes they are set from. Fields
+// missing from the map are set from the attribute of the same name, so that only the renamed fields
+// are listed, e.g. "inventory": "inventory_id". Attributes are written in dot notation, e.g.
+// "host.0.name", and an empty attribute reports the errors of the field on the whole resource.
+type AttributeMap map[string]string
+
+// attributePath returns the path of the attribute field is set from. A nested field, e.g.
+// `inputs.password`, uses the mapping of its closest mapped parent field, if any.
+func (m AttributeMap) attributePath(field string) cty.Path {
+	attribute := field
+	for prefix := field; prefix != ""; {
+		if mapped, ok := m[prefix]; ok {
+			attribute = mapped
+			break
+		}
+		i := strings.LastIndex(prefix, ".")
+		if i < 0 {
+			break
+		}
+		prefix = prefix[:i]
+	}
+	if attribute == "" {
+		return nil
+	}
+
+	var path cty.Path
+	for _, step := range strings.Split(attribute, ".") {
+		if index, err := strconv.Atoi(step); err == nil && len(path) > 0 {
+			path = path.IndexInt(index)
+			continue
+		}
+		path = path.GetAttr(step)
+	}
+	return path
+}
+
+// DiagValidation returns a diagnostic per field rejected by AWX when err is a validation error,
+// pointing at the attribute the field is set from, and nil otherwise. The errors that are not
+// about a field are reported on the whole resource.
+func DiagValidation(summary string, err error, fields ...AttributeMap) diag.Diagnostics {
+	var apiErr *awx.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest || len(apiErr.FieldErrors) == 0 {
+		return nil
+	}
+	attributes := AttributeMap{}
+	for _, m := range fields {
+		for field, attribute := range m {
+			attributes[field] = attribute
+		}
+	}
+
+	names := make([]string, 0, len(apiErr.FieldErrors))
+	for field := range apiErr.FieldErrors {
+		names = append(names, field)
+	}
+	sort.Strings(names)
+
+	var diags diag.Diagnostics
+	for _, field := range names {
+		messages := strings.Join(apiErr.FieldErrors[field], " ")
+		if field == awx.NonFieldErrors {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  summary,
+				Detail:   fmt.Sprintf("AWX rejected the request: %s", messages),
+			})
+			continue
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       summary,
+			Detail:        fmt.Sprintf("AWX rejected the value of the %s field: %s", field, messages),
+			AttributePath: attributes.attributePath(field),
+		})
+	}
+	return diags
+}
+
 // StateIDToInt : Convert the ID from the state to an integer
 func StateIDToInt(tfElement string, d *schema.ResourceData) (int, diag.Diagnostics) {
 	var diags diag.Diagnostics